@@ -0,0 +1,324 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/mitchellh/go-homedir"
+	"github.com/oklog/ulid"
+	"github.com/peterbourgon/ff/v3/ffcli"
+	"go.etcd.io/bbolt"
+
+	"github.com/dstotijn/hetty/pkg/db/bolt"
+	"github.com/dstotijn/hetty/pkg/filter"
+	"github.com/dstotijn/hetty/pkg/har"
+	"github.com/dstotijn/hetty/pkg/proj"
+	"github.com/dstotijn/hetty/pkg/reqlog"
+	"github.com/dstotijn/hetty/pkg/scope"
+)
+
+var harUsage = `
+Usage:
+    hetty har <subcommand> [flags]
+
+HAR (HTTP Archive) import and export of request logs. The database can't be
+used while Hetty is running with it, so stop Hetty first.
+
+Options:
+    --help, -h  Output this usage text.
+
+Subcommands:
+    - export  Exports request logs of a project to a HAR file.
+    - import  Imports a HAR file into a project.
+
+Run ` + "`hetty har <subcommand> --help`" + ` for subcommand specific usage instructions.
+
+Visit https://hetty.xyz to learn more about Hetty.
+`
+
+var harExportUsage = `
+Usage:
+    hetty har export [flags] [<request log ID>...]
+
+Exports request logs of a project to a HAR file. When no request log IDs are
+given, the request logs that match the project's request log filter (search
+expression and "only in scope" setting) are exported. Use --filter to search
+with another expression, or --all to export all request logs.
+
+Options:
+    --db        Database file path. (Default: "~/.hetty/hetty.db")
+    --project   Project name or ID. (Required)
+    --filter    Search expression to filter request logs, e.g. 'req.method = "POST"'.
+                (Default: the project's search expression)
+    --all       Ignore the project's request log filter.
+    --out, -o   Output file path. (Default: stdout)
+    --help, -h  Output this usage text.
+
+Visit https://hetty.xyz to learn more about Hetty.
+`
+
+var harImportUsage = `
+Usage:
+    hetty har import [flags] <file>
+
+Imports a HAR file into a project, creating a request log for each entry. Use
+"-" as file to read from stdin.
+
+Options:
+    --db        Database file path. (Default: "~/.hetty/hetty.db")
+    --project   Project name or ID. (Required)
+    --help, -h  Output this usage text.
+
+Visit https://hetty.xyz to learn more about Hetty.
+`
+
+type HARExportCommand struct {
+	config  *Config
+	db      string
+	project string
+	filter  string
+	all     bool
+	out     string
+}
+
+type HARImportCommand struct {
+	config  *Config
+	db      string
+	project string
+}
+
+func NewHARCommand(rootConfig *Config) *ffcli.Command {
+	return &ffcli.Command{
+		Name: "har",
+		Subcommands: []*ffcli.Command{
+			NewHARExportCommand(rootConfig),
+			NewHARImportCommand(rootConfig),
+		},
+		Exec: func(context.Context, []string) error {
+			return flag.ErrHelp
+		},
+		UsageFunc: func(*ffcli.Command) string {
+			return harUsage
+		},
+	}
+}
+
+func NewHARExportCommand(rootConfig *Config) *ffcli.Command {
+	cmd := HARExportCommand{
+		config: rootConfig,
+	}
+	fs := flag.NewFlagSet("hetty har export", flag.ExitOnError)
+
+	fs.StringVar(&cmd.db, "db", "~/.hetty/hetty.db", "Database file path.")
+	fs.StringVar(&cmd.project, "project", "", "Project name or ID.")
+	fs.StringVar(&cmd.filter, "filter", "", "Search expression to filter request logs.")
+	fs.BoolVar(&cmd.all, "all", false, "Ignore the project's request log filter.")
+	fs.StringVar(&cmd.out, "out", "", "Output file path.")
+	fs.StringVar(&cmd.out, "o", "", "Output file path.")
+
+	cmd.config.RegisterFlags(fs)
+
+	return &ffcli.Command{
+		Name:    "export",
+		FlagSet: fs,
+		Exec:    cmd.Exec,
+		UsageFunc: func(*ffcli.Command) string {
+			return harExportUsage
+		},
+	}
+}
+
+func (cmd *HARExportCommand) Exec(ctx context.Context, args []string) error {
+	ids := make([]ulid.ULID, len(args))
+
+	for i, arg := range args {
+		id, err := ulid.Parse(arg)
+		if err != nil {
+			return fmt.Errorf("invalid request log ID %q: %w", arg, err)
+		}
+
+		ids[i] = id
+	}
+
+	db, project, err := openHARProject(ctx, cmd.db, cmd.project)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	reqLogService := harReqLogService(db, project)
+	findReqsFilter := reqLogService.FindReqsFilter()
+
+	if cmd.all {
+		findReqsFilter = reqlog.FindRequestsFilter{ProjectID: project.ID}
+	}
+
+	if cmd.filter != "" {
+		expr, err := filter.ParseQuery(cmd.filter)
+		if err != nil {
+			return fmt.Errorf("failed to parse filter: %w", err)
+		}
+
+		findReqsFilter.SearchExpr = expr
+	}
+
+	reqLogService.SetFindReqsFilter(findReqsFilter)
+
+	h, err := reqLogService.ExportHAR(ctx, ids, har.Creator{Name: "Hetty", Version: version})
+	if err != nil {
+		return err
+	}
+
+	var w io.Writer = os.Stdout
+
+	if cmd.out != "" {
+		f, err := os.Create(cmd.out)
+		if err != nil {
+			return fmt.Errorf("failed to create output file: %w", err)
+		}
+		defer f.Close()
+
+		w = f
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+
+	if err := enc.Encode(h); err != nil {
+		return fmt.Errorf("failed to write HAR: %w", err)
+	}
+
+	if cmd.out != "" {
+		cmd.config.logger.Sugar().Infow("Exported request logs.",
+			"count", len(h.Log.Entries),
+			"file", cmd.out)
+	}
+
+	return nil
+}
+
+func NewHARImportCommand(rootConfig *Config) *ffcli.Command {
+	cmd := HARImportCommand{
+		config: rootConfig,
+	}
+	fs := flag.NewFlagSet("hetty har import", flag.ExitOnError)
+
+	fs.StringVar(&cmd.db, "db", "~/.hetty/hetty.db", "Database file path.")
+	fs.StringVar(&cmd.project, "project", "", "Project name or ID.")
+
+	cmd.config.RegisterFlags(fs)
+
+	return &ffcli.Command{
+		Name:    "import",
+		FlagSet: fs,
+		Exec:    cmd.Exec,
+		UsageFunc: func(*ffcli.Command) string {
+			return harImportUsage
+		},
+	}
+}
+
+func (cmd *HARImportCommand) Exec(ctx context.Context, args []string) error {
+	if len(args) != 1 {
+		return errors.New("expected a HAR file")
+	}
+
+	var r io.Reader = os.Stdin
+
+	if args[0] != "-" {
+		f, err := os.Open(args[0])
+		if err != nil {
+			return fmt.Errorf("failed to open HAR file: %w", err)
+		}
+		defer f.Close()
+
+		r = f
+	}
+
+	h, err := har.Decode(r)
+	if err != nil {
+		return err
+	}
+
+	db, project, err := openHARProject(ctx, cmd.db, cmd.project)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	reqLogs, err := harReqLogService(db, project).ImportHAR(ctx, h)
+	if err != nil {
+		return err
+	}
+
+	cmd.config.logger.Sugar().Infow("Imported request logs.",
+		"count", len(reqLogs),
+		"project", project.Name)
+
+	return nil
+}
+
+// openHARProject opens the database, and finds a project by name or ID.
+func openHARProject(ctx context.Context, dbPath, nameOrID string) (*bolt.Database, proj.Project, error) {
+	if nameOrID == "" {
+		return nil, proj.Project{}, errors.New("expected a project name or ID (--project)")
+	}
+
+	dbPath, err := homedir.Expand(dbPath)
+	if err != nil {
+		return nil, proj.Project{}, fmt.Errorf("failed to parse database path: %w", err)
+	}
+
+	// The database is locked while Hetty is running, so don't wait for long.
+	boltOpts := *bbolt.DefaultOptions
+	boltOpts.Timeout = time.Second
+
+	db, err := bolt.OpenDatabase(dbPath, &boltOpts)
+	if err != nil {
+		return nil, proj.Project{}, fmt.Errorf("failed to open database (is Hetty running?): %w", err)
+	}
+
+	projects, err := db.Projects(ctx)
+	if err != nil {
+		db.Close()
+		return nil, proj.Project{}, fmt.Errorf("failed to get projects: %w", err)
+	}
+
+	for _, project := range projects {
+		if project.ID.String() == strings.ToUpper(nameOrID) || project.Name == nameOrID {
+			return db, project, nil
+		}
+	}
+
+	db.Close()
+
+	return nil, proj.Project{}, fmt.Errorf("project not found: %q", nameOrID)
+}
+
+// harReqLogService returns a request log service for a project, with the
+// project's scope and request log filter, like when the project is opened.
+func harReqLogService(db *bolt.Database, project proj.Project) *reqlog.Service {
+	projScope := &scope.Scope{}
+	projScope.SetRules(project.Settings.ScopeRules)
+
+	svc := reqlog.NewService(reqlog.Config{
+		ActiveProjectID: project.ID,
+		Scope:           projScope,
+		Repository:      db,
+	})
+	svc.SetFindReqsFilter(reqlog.FindRequestsFilter{
+		ProjectID:   project.ID,
+		OnlyInScope: project.Settings.ReqLogOnlyFindInScope,
+		SearchExpr:  project.Settings.ReqLogSearchExpr,
+	})
+	svc.SetMaxBodySize(project.Settings.ReqLogMaxBodySize)
+
+	return svc
+}
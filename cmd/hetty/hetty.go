@@ -27,7 +27,14 @@ import (
 	"github.com/dstotijn/hetty/pkg/db/bolt"
 	"github.com/dstotijn/hetty/pkg/proj"
 	"github.com/dstotijn/hetty/pkg/proxy"
+	"github.com/dstotijn/hetty/pkg/proxy/clientcert"
 	"github.com/dstotijn/hetty/pkg/proxy/intercept"
+	"github.com/dstotijn/hetty/pkg/proxy/maprule"
+	"github.com/dstotijn/hetty/pkg/proxy/matchreplace"
+	"github.com/dstotijn/hetty/pkg/proxy/plugin"
+	"github.com/dstotijn/hetty/pkg/proxy/script"
+	"github.com/dstotijn/hetty/pkg/proxy/throttle"
+	"github.com/dstotijn/hetty/pkg/proxy/upstream"
 	"github.com/dstotijn/hetty/pkg/reqlog"
 	"github.com/dstotijn/hetty/pkg/scope"
 	"github.com/dstotijn/hetty/pkg/sender"
@@ -48,18 +55,23 @@ Usage:
 Runs an HTTP server with (MITM) proxy, GraphQL service, and a web based admin interface.
 
 Options:
-    --cert         Path to root CA certificate. Creates file if it doesn't exist. (Default: "~/.hetty/hetty_cert.pem")
-    --key          Path to root CA private key. Creates file if it doesn't exist. (Default: "~/.hetty/hetty_key.pem")
-    --db           Database file path. Creates file if it doesn't exist. (Default: "~/.hetty/hetty.db")
-    --addr         TCP address for HTTP server to listen on, in the form \"host:port\". (Default: ":8080")
-    --chrome       Launch Chrome with proxy settings applied and certificate errors ignored. (Default: false)
-    --verbose      Enable verbose logging.
-    --json         Encode logs as JSON, instead of pretty/human readable output.
-    --version, -v  Output version.
-    --help, -h     Output this usage text.
+    --cert              Path to root CA certificate. Creates file if it doesn't exist. (Default: "~/.hetty/hetty_cert.pem")
+    --key               Path to root CA private key. Creates file if it doesn't exist. (Default: "~/.hetty/hetty_key.pem")
+    --db                Database file path. Creates file if it doesn't exist. (Default: "~/.hetty/hetty.db")
+    --addr              TCP address for HTTP server to listen on, in the form \"host:port\". (Default: ":8080")
+    --socks5-addr       TCP address for an optional SOCKS5 proxy server to listen on, in the form \"host:port\". (Default: disabled)
+    --transparent-addr  TCP address for an optional transparent proxy server to listen on, for redirected TLS and HTTP traffic. Connections without SNI or Host header are routed to their original destination (Linux only). (Default: disabled)
+    --plugins           Plugins directory, enabled plugins are started with the proxy. (Default: "~/.hetty/plugins")
+    --chrome            Launch Chrome with proxy settings applied and certificate errors ignored. (Default: false)
+    --verbose           Enable verbose logging.
+    --json              Encode logs as JSON, instead of pretty/human readable output.
+    --version, -v       Output version.
+    --help, -h          Output this usage text.
 
 Subcommands:
-    - cert  Certificate management
+    - cert    Certificate management
+    - plugin  Plugin management
+    - har     HAR import and export of request logs
 
 Run ` + "`hetty <subcommand> --help`" + ` for subcommand specific usage instructions.
 
@@ -73,6 +85,9 @@ type HettyCommand struct {
 	key     string
 	db      string
 	addr    string
+	socks5  string
+	transp  string
+	plugins string
 	chrome  bool
 	version bool
 }
@@ -90,6 +105,12 @@ func NewHettyCommand() (*ffcli.Command, *Config) {
 		"Path to root CA private key. Creates a new private key if file doesn't exist.")
 	fs.StringVar(&cmd.db, "db", "~/.hetty/hetty.db", "Database file path. Creates file if it doesn't exist.")
 	fs.StringVar(&cmd.addr, "addr", ":8080", "TCP address to listen on, in the form \"host:port\".")
+	fs.StringVar(&cmd.socks5, "socks5-addr", "",
+		"TCP address for an optional SOCKS5 proxy server to listen on, in the form \"host:port\".")
+	fs.StringVar(&cmd.transp, "transparent-addr", "",
+		"TCP address for an optional transparent proxy server to listen on, in the form \"host:port\".")
+	fs.StringVar(&cmd.plugins, "plugins", "~/.hetty/plugins",
+		"Plugins directory, enabled plugins are started with the proxy.")
 	fs.BoolVar(&cmd.chrome, "chrome", false, "Launch Chrome with proxy settings applied and certificate errors ignored.")
 	fs.BoolVar(&cmd.version, "version", false, "Output version.")
 	fs.BoolVar(&cmd.version, "v", false, "Output version.")
@@ -101,6 +122,8 @@ func NewHettyCommand() (*ffcli.Command, *Config) {
 		FlagSet: fs,
 		Subcommands: []*ffcli.Command{
 			NewCertCommand(cmd.config),
+			NewPluginCommand(cmd.config),
+			NewHARCommand(cmd.config),
 		},
 		Exec: cmd.Exec,
 		UsageFunc: func(*ffcli.Command) string {
@@ -146,6 +169,11 @@ func (cmd *HettyCommand) Exec(ctx context.Context, _ []string) error {
 		cmd.config.logger.Fatal("Failed to parse database path.", zap.Error(err))
 	}
 
+	pluginsDir, err := homedir.Expand(cmd.plugins)
+	if err != nil {
+		cmd.config.logger.Fatal("Failed to parse plugins directory.", zap.Error(err))
+	}
+
 	// Load existing CA certificate and key from disk, or generate and write
 	// to disk if no files exist yet.
 	caCert, caKey, err := proxy.LoadOrCreateCA(caKeyFile, caCertFile)
@@ -175,35 +203,95 @@ func (cmd *HettyCommand) Exec(ctx context.Context, _ []string) error {
 		Logger: cmd.config.logger.Named("intercept").Sugar(),
 	})
 
+	upstreamDialer := upstream.NewDialer()
+	clientCerts := &clientcert.Store{}
+	mapRules := &maprule.Engine{}
+	matchReplaceService := matchreplace.NewService(matchreplace.Config{
+		Logger: cmd.config.logger.Named("matchreplace").Sugar(),
+	})
+	scriptService := script.NewService(script.Config{
+		Logger: cmd.config.logger.Named("script").Sugar(),
+	})
+	throttleService := throttle.NewService(throttle.Config{
+		Logger: cmd.config.logger.Named("throttle").Sugar(),
+	})
+
+	pluginService, err := plugin.NewService(plugin.Config{
+		Dir:     pluginsDir,
+		Version: version,
+		Logger:  cmd.config.logger.Named("plugin").Sugar(),
+	})
+	if err != nil {
+		cmd.config.logger.Fatal("Failed to load plugins.", zap.Error(err))
+	}
+	defer pluginService.Close()
+
 	senderService := sender.NewService(sender.Config{
 		Repository:    boltDB,
 		ReqLogService: reqLogService,
+		Upstream:      upstreamDialer,
+		ClientCerts:   clientCerts,
 	})
 
+	proxy, err := proxy.NewProxy(proxy.Config{
+		CACert:      caCert,
+		CAKey:       caKey,
+		Logger:      cmd.config.logger.Named("proxy").Sugar(),
+		Upstream:    upstreamDialer,
+		ClientCerts: clientCerts,
+	})
+	if err != nil {
+		cmd.config.logger.Fatal("Failed to create new proxy.", zap.Error(err))
+	}
+
 	projService, err := proj.NewService(proj.Config{
 		Repository:       boltDB,
 		InterceptService: interceptService,
 		ReqLogService:    reqLogService,
 		SenderService:    senderService,
+		Proxy:            proxy,
+		Upstream:         upstreamDialer,
+		ClientCerts:      clientCerts,
+		MapRules:         mapRules,
+		MatchReplace:     matchReplaceService,
+		Scripts:          scriptService,
+		Throttle:         throttleService,
 		Scope:            scope,
 	})
 	if err != nil {
 		cmd.config.logger.Fatal("Failed to create new projects service.", zap.Error(err))
 	}
 
-	proxy, err := proxy.NewProxy(proxy.Config{
-		CACert: caCert,
-		CAKey:  caKey,
-		Logger: cmd.config.logger.Named("proxy").Sugar(),
-	})
+	// Modifiers are named, so they can be reordered, and enabled or disabled
+	// (per project) at runtime.
+	err = errors.Join(
+		proxy.UseRequestModifier("reqlog", reqLogService.RequestModifier),
+		proxy.UseRequestModifier("intercept", interceptService.RequestModifier),
+		proxy.UseRequestModifier("matchreplace", matchReplaceService.RequestModifier),
+		proxy.UseRequestModifier("script", scriptService.RequestModifier),
+		proxy.UseRequestModifier("plugin", pluginService.RequestModifier),
+		proxy.UseRequestModifier("maprule", mapRules.RequestModifier),
+		// Network conditions are simulated last, right before requests are
+		// sent, and first for responses, as these are sent to the client.
+		proxy.UseRequestModifier("throttle", throttleService.RequestModifier),
+		proxy.UseResponseModifier("throttle", throttleService.ResponseModifier),
+		// Match and replace rules, scripts and plugins are applied to
+		// responses before these are intercepted, like requests are rewritten
+		// after being intercepted.
+		proxy.UseResponseModifier("reqlog", reqLogService.ResponseModifier),
+		proxy.UseResponseModifier("matchreplace", matchReplaceService.ResponseModifier),
+		proxy.UseResponseModifier("script", scriptService.ResponseModifier),
+		proxy.UseResponseModifier("plugin", pluginService.ResponseModifier),
+		proxy.UseResponseModifier("intercept", interceptService.ResponseModifier),
+		proxy.UseWebSocketModifier("reqlog", reqLogService.WebSocketModifier),
+		proxy.UseWebSocketModifier("intercept", interceptService.WebSocketModifier),
+	)
 	if err != nil {
-		cmd.config.logger.Fatal("Failed to create new proxy.", zap.Error(err))
+		cmd.config.logger.Fatal("Failed to add proxy modifiers.", zap.Error(err))
 	}
 
-	proxy.UseRequestModifier(reqLogService.RequestModifier)
-	proxy.UseResponseModifier(reqLogService.ResponseModifier)
-	proxy.UseRequestModifier(interceptService.RequestModifier)
-	proxy.UseResponseModifier(interceptService.ResponseModifier)
+	proxy.OnPassthroughTunnel(reqLogService.PassthroughTunnelLogger)
+	proxy.OnErrorResponse(reqLogService.ErrorResponseLogger)
 
 	fsSub, err := fs.Sub(adminContent, "admin")
 	if err != nil {
@@ -236,6 +324,8 @@ func (cmd *HettyCommand) Exec(ctx context.Context, _ []string) error {
 		RequestLogService: reqLogService,
 		InterceptService:  interceptService,
 		SenderService:     senderService,
+		Proxy:             proxy,
+		Version:           version,
 	}, gqlEndpoint))
 
 	// Admin interface.
@@ -261,6 +351,42 @@ func (cmd *HettyCommand) Exec(ctx context.Context, _ []string) error {
 		}
 	}()
 
+	var socks5Listener net.Listener
+
+	if cmd.socks5 != "" {
+		socks5Listener, err = net.Listen("tcp", cmd.socks5)
+		if err != nil {
+			mainLogger.Fatal("Failed to listen for SOCKS5 connections.", zap.Error(err))
+		}
+
+		go func() {
+			mainLogger.Info(fmt.Sprintf("SOCKS5 proxy is running on %v ...", cmd.socks5))
+
+			err := proxy.ServeSOCKS5(socks5Listener)
+			if !errors.Is(err, net.ErrClosed) {
+				mainLogger.Fatal("SOCKS5 server closed unexpected.", zap.Error(err))
+			}
+		}()
+	}
+
+	var transpListener net.Listener
+
+	if cmd.transp != "" {
+		transpListener, err = net.Listen("tcp", cmd.transp)
+		if err != nil {
+			mainLogger.Fatal("Failed to listen for transparent proxy connections.", zap.Error(err))
+		}
+
+		go func() {
+			mainLogger.Info(fmt.Sprintf("Transparent proxy is running on %v ...", cmd.transp))
+
+			err := proxy.ServeTransparent(transpListener)
+			if !errors.Is(err, net.ErrClosed) {
+				mainLogger.Fatal("Transparent proxy server closed unexpected.", zap.Error(err))
+			}
+		}()
+	}
+
 	if cmd.chrome {
 		ctx, cancel := chrome.NewExecAllocator(ctx, chrome.Config{
 			ProxyServer:      url,
@@ -290,6 +416,14 @@ func (cmd *HettyCommand) Exec(ctx context.Context, _ []string) error {
 
 	mainLogger.Info("Shutting down HTTP server. Press Ctrl+C to force quit.")
 
+	if socks5Listener != nil {
+		socks5Listener.Close()
+	}
+
+	if transpListener != nil {
+		transpListener.Close()
+	}
+
 	// Note: We expect httpServer.Handler to handle timeouts, thus, we don't
 	// need a context value with deadline here.
 	//nolint:contextcheck
@@ -0,0 +1,207 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/mitchellh/go-homedir"
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/dstotijn/hetty/pkg/proxy/plugin"
+)
+
+var pluginUsage = `
+Usage:
+    hetty plugin <subcommand> [flags]
+
+Plugin management tools. Plugins are external processes that receive proxied
+requests and responses, and can modify, drop, annotate or report findings for
+them. Each plugin has its own subdirectory in the plugins directory, with a
+"plugin.json" manifest.
+
+Options:
+    --help, -h  Output this usage text.
+
+Subcommands:
+    - list     Lists plugins in the plugins directory.
+    - enable   Enables a plugin.
+    - disable  Disables a plugin.
+
+Run ` + "`hetty plugin <subcommand> --help`" + ` for subcommand specific usage instructions.
+
+Visit https://hetty.xyz to learn more about Hetty.
+`
+
+var pluginListUsage = `
+Usage:
+    hetty plugin list [flags]
+
+Lists plugins in the plugins directory.
+
+Options:
+    --plugins  Plugins directory. (Default: "~/.hetty/plugins")
+    --help, -h Output this usage text.
+
+Visit https://hetty.xyz to learn more about Hetty.
+`
+
+var pluginEnableUsage = `
+Usage:
+    hetty plugin enable [flags] <name>
+
+Enables a plugin. Changes apply the next time Hetty is started.
+
+Options:
+    --plugins  Plugins directory. (Default: "~/.hetty/plugins")
+    --help, -h Output this usage text.
+
+Visit https://hetty.xyz to learn more about Hetty.
+`
+
+var pluginDisableUsage = `
+Usage:
+    hetty plugin disable [flags] <name>
+
+Disables a plugin. Changes apply the next time Hetty is started.
+
+Options:
+    --plugins  Plugins directory. (Default: "~/.hetty/plugins")
+    --help, -h Output this usage text.
+
+Visit https://hetty.xyz to learn more about Hetty.
+`
+
+type PluginListCommand struct {
+	config  *Config
+	plugins string
+}
+
+type PluginSetEnabledCommand struct {
+	config  *Config
+	plugins string
+	enabled bool
+}
+
+func NewPluginCommand(rootConfig *Config) *ffcli.Command {
+	return &ffcli.Command{
+		Name: "plugin",
+		Subcommands: []*ffcli.Command{
+			NewPluginListCommand(rootConfig),
+			NewPluginSetEnabledCommand(rootConfig, true),
+			NewPluginSetEnabledCommand(rootConfig, false),
+		},
+		Exec: func(context.Context, []string) error {
+			return flag.ErrHelp
+		},
+		UsageFunc: func(*ffcli.Command) string {
+			return pluginUsage
+		},
+	}
+}
+
+func NewPluginListCommand(rootConfig *Config) *ffcli.Command {
+	cmd := PluginListCommand{
+		config: rootConfig,
+	}
+	fs := flag.NewFlagSet("hetty plugin list", flag.ExitOnError)
+
+	fs.StringVar(&cmd.plugins, "plugins", "~/.hetty/plugins", "Plugins directory.")
+
+	cmd.config.RegisterFlags(fs)
+
+	return &ffcli.Command{
+		Name:    "list",
+		FlagSet: fs,
+		Exec:    cmd.Exec,
+		UsageFunc: func(*ffcli.Command) string {
+			return pluginListUsage
+		},
+	}
+}
+
+func (cmd *PluginListCommand) Exec(_ context.Context, _ []string) error {
+	dir, err := homedir.Expand(cmd.plugins)
+	if err != nil {
+		return fmt.Errorf("failed to parse plugins directory: %w", err)
+	}
+
+	plugins, err := plugin.List(dir)
+	if err != nil {
+		return err
+	}
+
+	if len(plugins) == 0 {
+		fmt.Fprintf(os.Stdout, "No plugins found in %v.\n", dir)
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "NAME\tENABLED\tHOOKS\tDESCRIPTION")
+
+	for _, p := range plugins {
+		hooks := make([]string, len(p.Manifest.Hooks))
+		for i, hook := range p.Manifest.Hooks {
+			hooks[i] = string(hook)
+		}
+
+		fmt.Fprintf(w, "%v\t%v\t%v\t%v\n",
+			p.Manifest.Name, p.Enabled, strings.Join(hooks, ","), p.Manifest.Description)
+	}
+
+	return w.Flush()
+}
+
+func NewPluginSetEnabledCommand(rootConfig *Config, enabled bool) *ffcli.Command {
+	cmd := PluginSetEnabledCommand{
+		config:  rootConfig,
+		enabled: enabled,
+	}
+
+	name, usage := "enable", pluginEnableUsage
+	if !enabled {
+		name, usage = "disable", pluginDisableUsage
+	}
+
+	fs := flag.NewFlagSet("hetty plugin "+name, flag.ExitOnError)
+
+	fs.StringVar(&cmd.plugins, "plugins", "~/.hetty/plugins", "Plugins directory.")
+
+	cmd.config.RegisterFlags(fs)
+
+	return &ffcli.Command{
+		Name:    name,
+		FlagSet: fs,
+		Exec:    cmd.Exec,
+		UsageFunc: func(*ffcli.Command) string {
+			return usage
+		},
+	}
+}
+
+func (cmd *PluginSetEnabledCommand) Exec(_ context.Context, args []string) error {
+	if len(args) != 1 {
+		return errors.New("expected a plugin name")
+	}
+
+	dir, err := homedir.Expand(cmd.plugins)
+	if err != nil {
+		return fmt.Errorf("failed to parse plugins directory: %w", err)
+	}
+
+	if err := plugin.SetEnabled(dir, args[0], cmd.enabled); err != nil {
+		return err
+	}
+
+	if cmd.enabled {
+		cmd.config.logger.Sugar().Infow("Enabled plugin. Restart Hetty to apply.", "plugin", args[0])
+	} else {
+		cmd.config.logger.Sugar().Infow("Disabled plugin. Restart Hetty to apply.", "plugin", args[0])
+	}
+
+	return nil
+}
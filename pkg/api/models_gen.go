@@ -12,6 +12,20 @@ import (
 	"github.com/oklog/ulid"
 )
 
+// Either `certPEM` (optionally with `keyPEM`) or `pkcs12` must be set.
+type AddClientCertificateInput struct {
+	Name string `json:"name"`
+	// Host patterns, e.g. `api.example.com` or `*.example.com`.
+	Hosts []string `json:"hosts"`
+	// PEM encoded certificate (chain), which may include the private key.
+	CertPem *string `json:"certPEM"`
+	// PEM encoded private key, if it's not included in `certPEM`.
+	KeyPem *string `json:"keyPEM"`
+	// Base64 encoded PKCS#12 data.
+	Pkcs12         *string `json:"pkcs12"`
+	Pkcs12Password *string `json:"pkcs12Password"`
+}
+
 type CancelRequestResult struct {
 	Success bool `json:"success"`
 }
@@ -20,14 +34,32 @@ type CancelResponseResult struct {
 	Success bool `json:"success"`
 }
 
+type CancelWebSocketMessageResult struct {
+	Success bool `json:"success"`
+}
+
 type ClearHTTPRequestLogResult struct {
 	Success bool `json:"success"`
 }
 
+// Client certificate that is presented to servers matching any of its hosts.
+type ClientCertificate struct {
+	ID       ulid.ULID `json:"id"`
+	Name     string    `json:"name"`
+	Hosts    []string  `json:"hosts"`
+	Subject  string    `json:"subject"`
+	Issuer   string    `json:"issuer"`
+	NotAfter time.Time `json:"notAfter"`
+}
+
 type CloseProjectResult struct {
 	Success bool `json:"success"`
 }
 
+type DeleteClientCertificateResult struct {
+	Success bool `json:"success"`
+}
+
 type DeleteProjectResult struct {
 	Success bool `json:"success"`
 }
@@ -36,6 +68,14 @@ type DeleteSenderRequestsResult struct {
 	Success bool `json:"success"`
 }
 
+type Finding struct {
+	// Name of the plugin that reported the finding.
+	Plugin      string          `json:"plugin"`
+	Title       string          `json:"title"`
+	Severity    FindingSeverity `json:"severity"`
+	Description *string         `json:"description"`
+}
+
 type HTTPHeader struct {
 	Key   string `json:"key"`
 	Value string `json:"value"`
@@ -46,6 +86,26 @@ type HTTPHeaderInput struct {
 	Value string `json:"value"`
 }
 
+type HTTPOriginalRequest struct {
+	URL     string       `json:"url"`
+	Method  HTTPMethod   `json:"method"`
+	Proto   string       `json:"proto"`
+	Headers []HTTPHeader `json:"headers"`
+	Body    *string      `json:"body"`
+	// IDs of the match and replace rules that modified the request.
+	RuleIDs []ulid.ULID `json:"ruleIDs"`
+}
+
+type HTTPOriginalResponse struct {
+	Proto        HTTPProtocol `json:"proto"`
+	StatusCode   int          `json:"statusCode"`
+	StatusReason string       `json:"statusReason"`
+	Headers      []HTTPHeader `json:"headers"`
+	Body         *string      `json:"body"`
+	// IDs of the match and replace rules that modified the response.
+	RuleIDs []ulid.ULID `json:"ruleIDs"`
+}
+
 type HTTPRequest struct {
 	ID       ulid.ULID     `json:"id"`
 	URL      *url.URL      `json:"url"`
@@ -57,14 +117,33 @@ type HTTPRequest struct {
 }
 
 type HTTPRequestLog struct {
-	ID        ulid.ULID        `json:"id"`
-	URL       string           `json:"url"`
-	Method    HTTPMethod       `json:"method"`
-	Proto     string           `json:"proto"`
-	Headers   []HTTPHeader     `json:"headers"`
-	Body      *string          `json:"body"`
-	Timestamp time.Time        `json:"timestamp"`
-	Response  *HTTPResponseLog `json:"response"`
+	ID      ulid.ULID    `json:"id"`
+	URL     string       `json:"url"`
+	Method  HTTPMethod   `json:"method"`
+	Proto   string       `json:"proto"`
+	Headers []HTTPHeader `json:"headers"`
+	Body    *string      `json:"body"`
+	// True if only the first part of the body was logged, because it exceeded the
+	// maximum body size.
+	BodyTruncated bool      `json:"bodyTruncated"`
+	Timestamp     time.Time `json:"timestamp"`
+	// Map Remote or Map Local rule that was applied to the request, if any.
+	MapRule *MapRuleMatch `json:"mapRule"`
+	// Request before match and replace rules modified it, if any rule applied.
+	Original *HTTPOriginalRequest `json:"original"`
+	// Annotations that scripts and plugins added to the request.
+	Annotations []string `json:"annotations"`
+	// Findings that plugins reported for the request.
+	Findings []Finding        `json:"findings"`
+	Response *HTTPResponseLog `json:"response"`
+}
+
+type HTTPRequestLogConnection struct {
+	Nodes    []HTTPRequestLog `json:"nodes"`
+	PageInfo *PageInfo        `json:"pageInfo"`
+	// Number of request logs that match the active filter. Counting requires
+	// iterating over all request logs, so only query it when needed.
+	TotalCount int `json:"totalCount"`
 }
 
 type HTTPRequestLogFilter struct {
@@ -94,14 +173,141 @@ type HTTPResponseLog struct {
 	StatusCode   int          `json:"statusCode"`
 	StatusReason string       `json:"statusReason"`
 	Body         *string      `json:"body"`
-	Headers      []HTTPHeader `json:"headers"`
+	// True if only the first part of the body was logged, because it exceeded the
+	// maximum body size. While a response is streamed, the body contains the part
+	// that was received so far.
+	BodyTruncated bool         `json:"bodyTruncated"`
+	Headers       []HTTPHeader `json:"headers"`
+	// Name of the client certificate that was presented to the server, if any.
+	ClientCertificate *string `json:"clientCertificate"`
+	// Set when the request couldn't be proxied, in which case the response is the
+	// error page that was returned to the client.
+	Error *ProxyError `json:"error"`
+	// Response before match and replace rules modified it, if any rule applied.
+	Original *HTTPOriginalResponse `json:"original"`
+	// Annotations that scripts and plugins added to the response.
+	Annotations []string `json:"annotations"`
+	// Findings that plugins reported for the response.
+	Findings []Finding `json:"findings"`
+	// Content coding(s) of the response body as received, if it was decoded.
+	ContentEncoding *string `json:"contentEncoding"`
+	// Base64 encoded response body as received, before it was decoded.
+	EncodedBody *string            `json:"encodedBody"`
+	Timing      *HTTPTiming        `json:"timing"`
+	TLS         *TLSConnectionInfo `json:"tls"`
+}
+
+// Durations of the phases of an HTTP round trip, in milliseconds.
+type HTTPTiming struct {
+	DNSMs             float64 `json:"dnsMs"`
+	ConnectMs         float64 `json:"connectMs"`
+	TLSHandshakeMs    float64 `json:"tlsHandshakeMs"`
+	TimeToFirstByteMs float64 `json:"timeToFirstByteMs"`
+	TotalMs           float64 `json:"totalMs"`
+}
+
+type ImportHARResult struct {
+	// Number of request logs that were created.
+	Count int `json:"count"`
 }
 
 type InterceptSettings struct {
-	RequestsEnabled  bool    `json:"requestsEnabled"`
-	ResponsesEnabled bool    `json:"responsesEnabled"`
-	RequestFilter    *string `json:"requestFilter"`
-	ResponseFilter   *string `json:"responseFilter"`
+	RequestsEnabled   bool    `json:"requestsEnabled"`
+	ResponsesEnabled  bool    `json:"responsesEnabled"`
+	WebSocketsEnabled bool    `json:"webSocketsEnabled"`
+	RequestFilter     *string `json:"requestFilter"`
+	ResponseFilter    *string `json:"responseFilter"`
+}
+
+// Answers requests matching `url` with a local file or body, without contacting
+// the server.
+type MapLocalRule struct {
+	ID      ulid.ULID `json:"id"`
+	Name    string    `json:"name"`
+	Enabled bool      `json:"enabled"`
+	URL     string    `json:"url"`
+	// Absolute path of a file or directory to serve.
+	FilePath   *string      `json:"filePath"`
+	Body       *string      `json:"body"`
+	StatusCode int          `json:"statusCode"`
+	Headers    []HTTPHeader `json:"headers"`
+}
+
+type MapLocalRuleInput struct {
+	// When null, a new ID is assigned.
+	ID         *ulid.ULID        `json:"id"`
+	Name       *string           `json:"name"`
+	Enabled    bool              `json:"enabled"`
+	URL        string            `json:"url"`
+	FilePath   *string           `json:"filePath"`
+	Body       *string           `json:"body"`
+	StatusCode *int              `json:"statusCode"`
+	Headers    []HTTPHeaderInput `json:"headers"`
+}
+
+// Rewrites the URL of requests matching `url`. Unset URL parts are left as-is.
+type MapRemoteRule struct {
+	ID      ulid.ULID `json:"id"`
+	Name    string    `json:"name"`
+	Enabled bool      `json:"enabled"`
+	URL     string    `json:"url"`
+	Scheme  *string   `json:"scheme"`
+	Host    *string   `json:"host"`
+	Port    *string   `json:"port"`
+	// Can reference capture groups of `url`, e.g. `/v2/$1`.
+	Path         *string `json:"path"`
+	PreserveHost bool    `json:"preserveHost"`
+}
+
+type MapRemoteRuleInput struct {
+	// When null, a new ID is assigned.
+	ID           *ulid.ULID `json:"id"`
+	Name         *string    `json:"name"`
+	Enabled      bool       `json:"enabled"`
+	URL          string     `json:"url"`
+	Scheme       *string    `json:"scheme"`
+	Host         *string    `json:"host"`
+	Port         *string    `json:"port"`
+	Path         *string    `json:"path"`
+	PreserveHost *bool      `json:"preserveHost"`
+}
+
+type MapRuleMatch struct {
+	Type     MapRuleType `json:"type"`
+	RuleID   ulid.ULID   `json:"ruleID"`
+	RuleName string      `json:"ruleName"`
+	// URL of the request before the rule was applied.
+	OriginalURL string `json:"originalURL"`
+}
+
+// Replaces occurrences of `match` in the target part of requests or responses.
+type MatchReplaceRule struct {
+	ID      ulid.ULID          `json:"id"`
+	Name    string             `json:"name"`
+	Enabled bool               `json:"enabled"`
+	Target  MatchReplaceTarget `json:"target"`
+	// Literal string, or regular expression when `isRegexp` is true. For header
+	// targets, each header line (`<key>: <value>`) is matched, and an empty string
+	// adds `replace` as a new header.
+	Match    string `json:"match"`
+	IsRegexp bool   `json:"isRegexp"`
+	// For header targets, replacing a header line with an empty string removes it.
+	Replace string `json:"replace"`
+	// Optional filter expression, the rule only applies to matching requests or
+	// responses.
+	Condition *string `json:"condition"`
+}
+
+type MatchReplaceRuleInput struct {
+	// When null, a new ID is assigned.
+	ID        *ulid.ULID         `json:"id"`
+	Name      *string            `json:"name"`
+	Enabled   bool               `json:"enabled"`
+	Target    MatchReplaceTarget `json:"target"`
+	Match     string             `json:"match"`
+	IsRegexp  *bool              `json:"isRegexp"`
+	Replace   string             `json:"replace"`
+	Condition *string            `json:"condition"`
 }
 
 type ModifyRequestInput struct {
@@ -131,6 +337,21 @@ type ModifyResponseResult struct {
 	Success bool `json:"success"`
 }
 
+type ModifyWebSocketMessageInput struct {
+	ID      ulid.ULID `json:"id"`
+	Payload *string   `json:"payload"`
+}
+
+type ModifyWebSocketMessageResult struct {
+	Success bool `json:"success"`
+}
+
+type PageInfo struct {
+	// ID of the last node on the page. Pass it as `after` to get the next page.
+	EndCursor   *ulid.ULID `json:"endCursor"`
+	HasNextPage bool       `json:"hasNextPage"`
+}
+
 type Project struct {
 	ID       ulid.ULID        `json:"id"`
 	Name     string           `json:"name"`
@@ -139,7 +360,57 @@ type Project struct {
 }
 
 type ProjectSettings struct {
-	Intercept *InterceptSettings `json:"intercept"`
+	Intercept         *InterceptSettings     `json:"intercept"`
+	RequestLog        *RequestLogSettings    `json:"requestLog"`
+	Proxy             *ProxySettings         `json:"proxy"`
+	UpstreamProxy     *UpstreamProxySettings `json:"upstreamProxy"`
+	MapRemoteRules    []MapRemoteRule        `json:"mapRemoteRules"`
+	MapLocalRules     []MapLocalRule         `json:"mapLocalRules"`
+	MatchReplaceRules []MatchReplaceRule     `json:"matchReplaceRules"`
+	Scripts           []Script               `json:"scripts"`
+	// Order and enabled state of the proxy's modifiers, empty if these were never
+	// changed for the project.
+	ProxyModifiers []ProxyModifier `json:"proxyModifiers"`
+	ThrottleRules  []ThrottleRule  `json:"throttleRules"`
+}
+
+type ProxyError struct {
+	Kind    ProxyErrorKind `json:"kind"`
+	Message string         `json:"message"`
+}
+
+// Named modifier in one of the proxy's modifier chains (e.g. request logging or
+// intercept). Modifiers in a chain are called in order.
+type ProxyModifier struct {
+	Kind    ProxyModifierKind `json:"kind"`
+	Name    string            `json:"name"`
+	Enabled bool              `json:"enabled"`
+}
+
+type ProxyModifierInput struct {
+	Kind    ProxyModifierKind `json:"kind"`
+	Name    string            `json:"name"`
+	Enabled bool              `json:"enabled"`
+}
+
+type ProxySettings struct {
+	// When enabled, HTTP/2 isn't negotiated with clients over TLS, and intercepted
+	// HTTPS traffic is served over HTTP/1.1.
+	DowngradeHTTP2 bool `json:"downgradeHTTP2"`
+	// When enabled, encoded (e.g. gzip, br) response bodies are decoded, so they
+	// can be read and modified.
+	DecompressBodies bool `json:"decompressBodies"`
+	// Tunnels matching any of these rules are relayed without TLS termination.
+	TLSPassthrough []TLSPassthroughRule `json:"tlsPassthrough"`
+}
+
+type RequestLogSettings struct {
+	// Maximum number of bytes of request and response bodies that are logged.
+	MaxBodySize int `json:"maxBodySize"`
+	// When enabled, request and response bodies are indexed, so that searching
+	// bodies with `body:` terms (e.g. `body:token`) doesn't scan every body. Other
+	// search terms, and `req.body` or `res.body` comparisons, don't use the index.
+	FullTextIndex bool `json:"fullTextIndex"`
 }
 
 type ScopeHeader struct {
@@ -164,6 +435,30 @@ type ScopeRuleInput struct {
 	Body   *string           `json:"body"`
 }
 
+// Starlark script that defines an `onRequest(req)` and/or `onResponse(res)`
+// function, which are called for proxied requests and responses.
+type Script struct {
+	ID      ulid.ULID `json:"id"`
+	Name    string    `json:"name"`
+	Enabled bool      `json:"enabled"`
+	Source  string    `json:"source"`
+}
+
+type ScriptInput struct {
+	// When null, a new ID is assigned.
+	ID      *ulid.ULID `json:"id"`
+	Name    string     `json:"name"`
+	Enabled bool       `json:"enabled"`
+	Source  string     `json:"source"`
+}
+
+type SendWebSocketMessageInput struct {
+	RequestID ulid.ULID                 `json:"requestID"`
+	Direction WebSocketMessageDirection `json:"direction"`
+	Opcode    WebSocketOpcode           `json:"opcode"`
+	Payload   *string                   `json:"payload"`
+}
+
 type SenderRequest struct {
 	ID                 ulid.ULID        `json:"id"`
 	SourceRequestLogID *ulid.ULID       `json:"sourceRequestLogID"`
@@ -195,11 +490,244 @@ type SenderRequestInput struct {
 	Body    *string           `json:"body"`
 }
 
+type TLSCertificateSummary struct {
+	Subject      string    `json:"subject"`
+	Issuer       string    `json:"issuer"`
+	SerialNumber string    `json:"serialNumber"`
+	DNSNames     []string  `json:"dnsNames"`
+	NotBefore    time.Time `json:"notBefore"`
+	NotAfter     time.Time `json:"notAfter"`
+}
+
+type TLSConnectionInfo struct {
+	Version          string                  `json:"version"`
+	CipherSuite      string                  `json:"cipherSuite"`
+	Alpn             *string                 `json:"alpn"`
+	ServerName       *string                 `json:"serverName"`
+	PeerCertificates []TLSCertificateSummary `json:"peerCertificates"`
+}
+
+type TLSPassthroughRule struct {
+	Host *string `json:"host"`
+	// Glob pattern (e.g. `*.apple.com`) that is matched against the hostname. When
+	// set, it's used instead of `host`.
+	HostGlob *string `json:"hostGlob"`
+	Port     *string `json:"port"`
+}
+
+// Exactly one of `host` and `hostGlob` must be set.
+type TLSPassthroughRuleInput struct {
+	Host     *string `json:"host"`
+	HostGlob *string `json:"hostGlob"`
+	Port     *string `json:"port"`
+}
+
+// Simulated network conditions. Durations are in milliseconds, and bandwidth is
+// in bytes per second, where 0 means unlimited.
+type ThrottleConditions struct {
+	Latency int `json:"latency"`
+	// Maximum random deviation from `latency`.
+	Jitter                 int `json:"jitter"`
+	UploadBytesPerSecond   int `json:"uploadBytesPerSecond"`
+	DownloadBytesPerSecond int `json:"downloadBytesPerSecond"`
+	// Probability (0 to 1) of a request failing.
+	FailureRate float64              `json:"failureRate"`
+	FailureMode *ThrottleFailureMode `json:"failureMode"`
+}
+
+type ThrottleConditionsInput struct {
+	Latency                *int                 `json:"latency"`
+	Jitter                 *int                 `json:"jitter"`
+	UploadBytesPerSecond   *int                 `json:"uploadBytesPerSecond"`
+	DownloadBytesPerSecond *int                 `json:"downloadBytesPerSecond"`
+	FailureRate            *float64             `json:"failureRate"`
+	FailureMode            *ThrottleFailureMode `json:"failureMode"`
+}
+
+type ThrottlePreset struct {
+	Name       string              `json:"name"`
+	Conditions *ThrottleConditions `json:"conditions"`
+}
+
+// Applies network conditions to requests that match `host` and `condition`. The
+// first enabled rule that matches a request is used.
+type ThrottleRule struct {
+	ID      ulid.ULID `json:"id"`
+	Name    string    `json:"name"`
+	Enabled bool      `json:"enabled"`
+	// Matched against the host (without port) of requests.
+	Host *string `json:"host"`
+	// Optional filter expression, the rule only applies to matching requests.
+	Condition  *string             `json:"condition"`
+	Conditions *ThrottleConditions `json:"conditions"`
+}
+
+type ThrottleRuleInput struct {
+	// When null, a new ID is assigned.
+	ID        *ulid.ULID `json:"id"`
+	Name      *string    `json:"name"`
+	Enabled   bool       `json:"enabled"`
+	Host      *string    `json:"host"`
+	Condition *string    `json:"condition"`
+	// Name of a preset (e.g. `slow-3g`) to use the conditions of. Either `preset`
+	// or `conditions` must be set.
+	Preset     *string                  `json:"preset"`
+	Conditions *ThrottleConditionsInput `json:"conditions"`
+}
+
+// Passthrough tunnel that was relayed without interception.
+type TunnelLog struct {
+	ID            ulid.ULID `json:"id"`
+	Host          string    `json:"host"`
+	Port          string    `json:"port"`
+	BytesSent     int       `json:"bytesSent"`
+	BytesReceived int       `json:"bytesReceived"`
+	StartedAt     time.Time `json:"startedAt"`
+	DurationMs    int       `json:"durationMs"`
+}
+
 type UpdateInterceptSettingsInput struct {
-	RequestsEnabled  bool    `json:"requestsEnabled"`
-	ResponsesEnabled bool    `json:"responsesEnabled"`
-	RequestFilter    *string `json:"requestFilter"`
-	ResponseFilter   *string `json:"responseFilter"`
+	RequestsEnabled   bool    `json:"requestsEnabled"`
+	ResponsesEnabled  bool    `json:"responsesEnabled"`
+	WebSocketsEnabled *bool   `json:"webSocketsEnabled"`
+	RequestFilter     *string `json:"requestFilter"`
+	ResponseFilter    *string `json:"responseFilter"`
+}
+
+type UpdateProxySettingsInput struct {
+	DowngradeHTTP2 bool `json:"downgradeHTTP2"`
+	// When null, the current value is kept.
+	DecompressBodies *bool `json:"decompressBodies"`
+}
+
+type UpdateRequestLogSettingsInput struct {
+	// When null, the default maximum body size (10 MiB) is used.
+	MaxBodySize *int `json:"maxBodySize"`
+	// When true, the full-text index is built from existing request logs. When
+	// null, the index is disabled.
+	FullTextIndex *bool `json:"fullTextIndex"`
+}
+
+type UpdateUpstreamProxySettingsInput struct {
+	Type     UpstreamProxyType `json:"type"`
+	Addr     string            `json:"addr"`
+	Username *string           `json:"username"`
+	Password *string           `json:"password"`
+	Bypass   []string          `json:"bypass"`
+}
+
+// Upstream proxy that outgoing requests (from the proxy and sender) are sent via.
+type UpstreamProxySettings struct {
+	Type     UpstreamProxyType `json:"type"`
+	Addr     string            `json:"addr"`
+	Username *string           `json:"username"`
+	// Host patterns (e.g. `*.example.com`) that bypass the upstream proxy.
+	Bypass []string `json:"bypass"`
+}
+
+type WebSocketMessage struct {
+	ID ulid.ULID `json:"id"`
+	// ID of the HTTP request (log) that initiated the WebSocket handshake.
+	RequestID ulid.ULID                 `json:"requestID"`
+	Direction WebSocketMessageDirection `json:"direction"`
+	Opcode    WebSocketOpcode           `json:"opcode"`
+	Payload   *string                   `json:"payload"`
+	Timestamp time.Time                 `json:"timestamp"`
+}
+
+// Format that requests can be converted to.
+type CodeFormat string
+
+const (
+	CodeFormatCurl           CodeFormat = "CURL"
+	CodeFormatRaw            CodeFormat = "RAW"
+	CodeFormatFetch          CodeFormat = "FETCH"
+	CodeFormatPythonRequests CodeFormat = "PYTHON_REQUESTS"
+	CodeFormatGo             CodeFormat = "GO"
+)
+
+var AllCodeFormat = []CodeFormat{
+	CodeFormatCurl,
+	CodeFormatRaw,
+	CodeFormatFetch,
+	CodeFormatPythonRequests,
+	CodeFormatGo,
+}
+
+func (e CodeFormat) IsValid() bool {
+	switch e {
+	case CodeFormatCurl, CodeFormatRaw, CodeFormatFetch, CodeFormatPythonRequests, CodeFormatGo:
+		return true
+	}
+	return false
+}
+
+func (e CodeFormat) String() string {
+	return string(e)
+}
+
+func (e *CodeFormat) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = CodeFormat(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid CodeFormat", str)
+	}
+	return nil
+}
+
+func (e CodeFormat) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type FindingSeverity string
+
+const (
+	FindingSeverityInfo     FindingSeverity = "INFO"
+	FindingSeverityLow      FindingSeverity = "LOW"
+	FindingSeverityMedium   FindingSeverity = "MEDIUM"
+	FindingSeverityHigh     FindingSeverity = "HIGH"
+	FindingSeverityCritical FindingSeverity = "CRITICAL"
+)
+
+var AllFindingSeverity = []FindingSeverity{
+	FindingSeverityInfo,
+	FindingSeverityLow,
+	FindingSeverityMedium,
+	FindingSeverityHigh,
+	FindingSeverityCritical,
+}
+
+func (e FindingSeverity) IsValid() bool {
+	switch e {
+	case FindingSeverityInfo, FindingSeverityLow, FindingSeverityMedium, FindingSeverityHigh, FindingSeverityCritical:
+		return true
+	}
+	return false
+}
+
+func (e FindingSeverity) String() string {
+	return string(e)
+}
+
+func (e *FindingSeverity) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = FindingSeverity(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid FindingSeverity", str)
+	}
+	return nil
+}
+
+func (e FindingSeverity) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
 type HTTPMethod string
@@ -299,3 +827,398 @@ func (e *HTTPProtocol) UnmarshalGQL(v interface{}) error {
 func (e HTTPProtocol) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
+
+type MapRuleType string
+
+const (
+	MapRuleTypeRemote MapRuleType = "REMOTE"
+	MapRuleTypeLocal  MapRuleType = "LOCAL"
+)
+
+var AllMapRuleType = []MapRuleType{
+	MapRuleTypeRemote,
+	MapRuleTypeLocal,
+}
+
+func (e MapRuleType) IsValid() bool {
+	switch e {
+	case MapRuleTypeRemote, MapRuleTypeLocal:
+		return true
+	}
+	return false
+}
+
+func (e MapRuleType) String() string {
+	return string(e)
+}
+
+func (e *MapRuleType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = MapRuleType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid MapRuleType", str)
+	}
+	return nil
+}
+
+func (e MapRuleType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type MatchReplaceTarget string
+
+const (
+	MatchReplaceTargetRequestLine    MatchReplaceTarget = "REQUEST_LINE"
+	MatchReplaceTargetRequestHeader  MatchReplaceTarget = "REQUEST_HEADER"
+	MatchReplaceTargetRequestBody    MatchReplaceTarget = "REQUEST_BODY"
+	MatchReplaceTargetResponseHeader MatchReplaceTarget = "RESPONSE_HEADER"
+	MatchReplaceTargetResponseBody   MatchReplaceTarget = "RESPONSE_BODY"
+)
+
+var AllMatchReplaceTarget = []MatchReplaceTarget{
+	MatchReplaceTargetRequestLine,
+	MatchReplaceTargetRequestHeader,
+	MatchReplaceTargetRequestBody,
+	MatchReplaceTargetResponseHeader,
+	MatchReplaceTargetResponseBody,
+}
+
+func (e MatchReplaceTarget) IsValid() bool {
+	switch e {
+	case MatchReplaceTargetRequestLine, MatchReplaceTargetRequestHeader, MatchReplaceTargetRequestBody, MatchReplaceTargetResponseHeader, MatchReplaceTargetResponseBody:
+		return true
+	}
+	return false
+}
+
+func (e MatchReplaceTarget) String() string {
+	return string(e)
+}
+
+func (e *MatchReplaceTarget) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = MatchReplaceTarget(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid MatchReplaceTarget", str)
+	}
+	return nil
+}
+
+func (e MatchReplaceTarget) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type ProxyErrorKind string
+
+const (
+	ProxyErrorKindDNS               ProxyErrorKind = "DNS"
+	ProxyErrorKindConnectionRefused ProxyErrorKind = "CONNECTION_REFUSED"
+	ProxyErrorKindTLS               ProxyErrorKind = "TLS"
+	ProxyErrorKindTimeout           ProxyErrorKind = "TIMEOUT"
+	ProxyErrorKindCanceled          ProxyErrorKind = "CANCELED"
+	ProxyErrorKindConnectionReset   ProxyErrorKind = "CONNECTION_RESET"
+	ProxyErrorKindUnknown           ProxyErrorKind = "UNKNOWN"
+)
+
+var AllProxyErrorKind = []ProxyErrorKind{
+	ProxyErrorKindDNS,
+	ProxyErrorKindConnectionRefused,
+	ProxyErrorKindTLS,
+	ProxyErrorKindTimeout,
+	ProxyErrorKindCanceled,
+	ProxyErrorKindConnectionReset,
+	ProxyErrorKindUnknown,
+}
+
+func (e ProxyErrorKind) IsValid() bool {
+	switch e {
+	case ProxyErrorKindDNS, ProxyErrorKindConnectionRefused, ProxyErrorKindTLS, ProxyErrorKindTimeout, ProxyErrorKindCanceled, ProxyErrorKindConnectionReset, ProxyErrorKindUnknown:
+		return true
+	}
+	return false
+}
+
+func (e ProxyErrorKind) String() string {
+	return string(e)
+}
+
+func (e *ProxyErrorKind) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ProxyErrorKind(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ProxyErrorKind", str)
+	}
+	return nil
+}
+
+func (e ProxyErrorKind) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type ProxyModifierKind string
+
+const (
+	ProxyModifierKindRequest   ProxyModifierKind = "REQUEST"
+	ProxyModifierKindResponse  ProxyModifierKind = "RESPONSE"
+	ProxyModifierKindWebsocket ProxyModifierKind = "WEBSOCKET"
+)
+
+var AllProxyModifierKind = []ProxyModifierKind{
+	ProxyModifierKindRequest,
+	ProxyModifierKindResponse,
+	ProxyModifierKindWebsocket,
+}
+
+func (e ProxyModifierKind) IsValid() bool {
+	switch e {
+	case ProxyModifierKindRequest, ProxyModifierKindResponse, ProxyModifierKindWebsocket:
+		return true
+	}
+	return false
+}
+
+func (e ProxyModifierKind) String() string {
+	return string(e)
+}
+
+func (e *ProxyModifierKind) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ProxyModifierKind(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ProxyModifierKind", str)
+	}
+	return nil
+}
+
+func (e ProxyModifierKind) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type SortDirection string
+
+const (
+	// Oldest first.
+	SortDirectionAsc SortDirection = "ASC"
+	// Newest first.
+	SortDirectionDesc SortDirection = "DESC"
+)
+
+var AllSortDirection = []SortDirection{
+	SortDirectionAsc,
+	SortDirectionDesc,
+}
+
+func (e SortDirection) IsValid() bool {
+	switch e {
+	case SortDirectionAsc, SortDirectionDesc:
+		return true
+	}
+	return false
+}
+
+func (e SortDirection) String() string {
+	return string(e)
+}
+
+func (e *SortDirection) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = SortDirection(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid SortDirection", str)
+	}
+	return nil
+}
+
+func (e SortDirection) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type ThrottleFailureMode string
+
+const (
+	// The client connection is closed without a response.
+	ThrottleFailureModeReset ThrottleFailureMode = "RESET"
+	// The proxy responds with `503 Service Unavailable`.
+	ThrottleFailureModeServerError ThrottleFailureMode = "SERVER_ERROR"
+)
+
+var AllThrottleFailureMode = []ThrottleFailureMode{
+	ThrottleFailureModeReset,
+	ThrottleFailureModeServerError,
+}
+
+func (e ThrottleFailureMode) IsValid() bool {
+	switch e {
+	case ThrottleFailureModeReset, ThrottleFailureModeServerError:
+		return true
+	}
+	return false
+}
+
+func (e ThrottleFailureMode) String() string {
+	return string(e)
+}
+
+func (e *ThrottleFailureMode) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ThrottleFailureMode(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ThrottleFailureMode", str)
+	}
+	return nil
+}
+
+func (e ThrottleFailureMode) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type UpstreamProxyType string
+
+const (
+	UpstreamProxyTypeHTTP   UpstreamProxyType = "HTTP"
+	UpstreamProxyTypeHTTPS  UpstreamProxyType = "HTTPS"
+	UpstreamProxyTypeSocks5 UpstreamProxyType = "SOCKS5"
+)
+
+var AllUpstreamProxyType = []UpstreamProxyType{
+	UpstreamProxyTypeHTTP,
+	UpstreamProxyTypeHTTPS,
+	UpstreamProxyTypeSocks5,
+}
+
+func (e UpstreamProxyType) IsValid() bool {
+	switch e {
+	case UpstreamProxyTypeHTTP, UpstreamProxyTypeHTTPS, UpstreamProxyTypeSocks5:
+		return true
+	}
+	return false
+}
+
+func (e UpstreamProxyType) String() string {
+	return string(e)
+}
+
+func (e *UpstreamProxyType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = UpstreamProxyType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid UpstreamProxyType", str)
+	}
+	return nil
+}
+
+func (e UpstreamProxyType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type WebSocketMessageDirection string
+
+const (
+	WebSocketMessageDirectionClientToServer WebSocketMessageDirection = "CLIENT_TO_SERVER"
+	WebSocketMessageDirectionServerToClient WebSocketMessageDirection = "SERVER_TO_CLIENT"
+)
+
+var AllWebSocketMessageDirection = []WebSocketMessageDirection{
+	WebSocketMessageDirectionClientToServer,
+	WebSocketMessageDirectionServerToClient,
+}
+
+func (e WebSocketMessageDirection) IsValid() bool {
+	switch e {
+	case WebSocketMessageDirectionClientToServer, WebSocketMessageDirectionServerToClient:
+		return true
+	}
+	return false
+}
+
+func (e WebSocketMessageDirection) String() string {
+	return string(e)
+}
+
+func (e *WebSocketMessageDirection) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = WebSocketMessageDirection(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid WebSocketMessageDirection", str)
+	}
+	return nil
+}
+
+func (e WebSocketMessageDirection) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type WebSocketOpcode string
+
+const (
+	WebSocketOpcodeText   WebSocketOpcode = "TEXT"
+	WebSocketOpcodeBinary WebSocketOpcode = "BINARY"
+	WebSocketOpcodeClose  WebSocketOpcode = "CLOSE"
+)
+
+var AllWebSocketOpcode = []WebSocketOpcode{
+	WebSocketOpcodeText,
+	WebSocketOpcodeBinary,
+	WebSocketOpcodeClose,
+}
+
+func (e WebSocketOpcode) IsValid() bool {
+	switch e {
+	case WebSocketOpcodeText, WebSocketOpcodeBinary, WebSocketOpcodeClose:
+		return true
+	}
+	return false
+}
+
+func (e WebSocketOpcode) String() string {
+	return string(e)
+}
+
+func (e *WebSocketOpcode) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = WebSocketOpcode(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid WebSocketOpcode", str)
+	}
+	return nil
+}
+
+func (e WebSocketOpcode) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
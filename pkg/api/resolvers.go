@@ -5,23 +5,35 @@ package api
 import (
 	"bytes"
 	"context"
+	"encoding/base64"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"net/http"
+	"path"
 	"regexp"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/99designs/gqlgen/graphql"
 	"github.com/oklog/ulid"
 	"github.com/vektah/gqlparser/v2/gqlerror"
 
 	"github.com/dstotijn/hetty/pkg/filter"
+	"github.com/dstotijn/hetty/pkg/har"
 	"github.com/dstotijn/hetty/pkg/proj"
 	"github.com/dstotijn/hetty/pkg/proxy"
+	"github.com/dstotijn/hetty/pkg/proxy/clientcert"
 	"github.com/dstotijn/hetty/pkg/proxy/intercept"
+	"github.com/dstotijn/hetty/pkg/proxy/maprule"
+	"github.com/dstotijn/hetty/pkg/proxy/matchreplace"
+	"github.com/dstotijn/hetty/pkg/proxy/plugin"
+	"github.com/dstotijn/hetty/pkg/proxy/script"
+	"github.com/dstotijn/hetty/pkg/proxy/throttle"
+	"github.com/dstotijn/hetty/pkg/proxy/upstream"
 	"github.com/dstotijn/hetty/pkg/reqlog"
 	"github.com/dstotijn/hetty/pkg/scope"
 	"github.com/dstotijn/hetty/pkg/sender"
@@ -39,11 +51,108 @@ var revHTTPProtocolMap = map[HTTPProtocol]string{
 	HTTPProtocolHTTP20: sender.HTTPProto20,
 }
 
+var revSortDirectionMap = map[SortDirection]reqlog.Direction{
+	SortDirectionAsc:  reqlog.DirectionAsc,
+	SortDirectionDesc: reqlog.DirectionDesc,
+}
+
+var webSocketOpcodeMap = map[proxy.WebSocketOpcode]WebSocketOpcode{
+	proxy.WebSocketOpText:   WebSocketOpcodeText,
+	proxy.WebSocketOpBinary: WebSocketOpcodeBinary,
+	proxy.WebSocketOpClose:  WebSocketOpcodeClose,
+}
+
+var upstreamProxyTypeMap = map[upstream.Type]UpstreamProxyType{
+	upstream.TypeHTTP:   UpstreamProxyTypeHTTP,
+	upstream.TypeHTTPS:  UpstreamProxyTypeHTTPS,
+	upstream.TypeSOCKS5: UpstreamProxyTypeSocks5,
+}
+
+var revUpstreamProxyTypeMap = map[UpstreamProxyType]upstream.Type{
+	UpstreamProxyTypeHTTP:   upstream.TypeHTTP,
+	UpstreamProxyTypeHTTPS:  upstream.TypeHTTPS,
+	UpstreamProxyTypeSocks5: upstream.TypeSOCKS5,
+}
+
+var proxyErrorKindMap = map[proxy.ErrorKind]ProxyErrorKind{
+	proxy.ErrorKindDNS:               ProxyErrorKindDNS,
+	proxy.ErrorKindConnectionRefused: ProxyErrorKindConnectionRefused,
+	proxy.ErrorKindTLS:               ProxyErrorKindTLS,
+	proxy.ErrorKindTimeout:           ProxyErrorKindTimeout,
+	proxy.ErrorKindCanceled:          ProxyErrorKindCanceled,
+	proxy.ErrorKindConnectionReset:   ProxyErrorKindConnectionReset,
+	proxy.ErrorKindUnknown:           ProxyErrorKindUnknown,
+}
+
+var matchReplaceTargetMap = map[matchreplace.Target]MatchReplaceTarget{
+	matchreplace.TargetRequestLine:    MatchReplaceTargetRequestLine,
+	matchreplace.TargetRequestHeader:  MatchReplaceTargetRequestHeader,
+	matchreplace.TargetRequestBody:    MatchReplaceTargetRequestBody,
+	matchreplace.TargetResponseHeader: MatchReplaceTargetResponseHeader,
+	matchreplace.TargetResponseBody:   MatchReplaceTargetResponseBody,
+}
+
+var findingSeverityMap = map[plugin.Severity]FindingSeverity{
+	plugin.SeverityInfo:     FindingSeverityInfo,
+	plugin.SeverityLow:      FindingSeverityLow,
+	plugin.SeverityMedium:   FindingSeverityMedium,
+	plugin.SeverityHigh:     FindingSeverityHigh,
+	plugin.SeverityCritical: FindingSeverityCritical,
+}
+
+var proxyModifierKindMap = map[proxy.ModifierKind]ProxyModifierKind{
+	proxy.ModifierKindRequest:   ProxyModifierKindRequest,
+	proxy.ModifierKindResponse:  ProxyModifierKindResponse,
+	proxy.ModifierKindWebSocket: ProxyModifierKindWebsocket,
+}
+
+var revProxyModifierKindMap = map[ProxyModifierKind]proxy.ModifierKind{
+	ProxyModifierKindRequest:   proxy.ModifierKindRequest,
+	ProxyModifierKindResponse:  proxy.ModifierKindResponse,
+	ProxyModifierKindWebsocket: proxy.ModifierKindWebSocket,
+}
+
+var throttleFailureModeMap = map[throttle.FailureMode]ThrottleFailureMode{
+	throttle.FailureModeReset:       ThrottleFailureModeReset,
+	throttle.FailureModeServerError: ThrottleFailureModeServerError,
+}
+
+var revThrottleFailureModeMap = map[ThrottleFailureMode]throttle.FailureMode{
+	ThrottleFailureModeReset:       throttle.FailureModeReset,
+	ThrottleFailureModeServerError: throttle.FailureModeServerError,
+}
+
+var revMatchReplaceTargetMap = map[MatchReplaceTarget]matchreplace.Target{
+	MatchReplaceTargetRequestLine:    matchreplace.TargetRequestLine,
+	MatchReplaceTargetRequestHeader:  matchreplace.TargetRequestHeader,
+	MatchReplaceTargetRequestBody:    matchreplace.TargetRequestBody,
+	MatchReplaceTargetResponseHeader: matchreplace.TargetResponseHeader,
+	MatchReplaceTargetResponseBody:   matchreplace.TargetResponseBody,
+}
+
+var revCodeFormatMap = map[CodeFormat]sender.CodeFormat{
+	CodeFormatCurl:           sender.CodeFormatCurl,
+	CodeFormatRaw:            sender.CodeFormatRaw,
+	CodeFormatFetch:          sender.CodeFormatFetch,
+	CodeFormatPythonRequests: sender.CodeFormatPythonRequests,
+	CodeFormatGo:             sender.CodeFormatGo,
+}
+
+var revWebSocketOpcodeMap = map[WebSocketOpcode]proxy.WebSocketOpcode{
+	WebSocketOpcodeText:   proxy.WebSocketOpText,
+	WebSocketOpcodeBinary: proxy.WebSocketOpBinary,
+	WebSocketOpcodeClose:  proxy.WebSocketOpClose,
+}
+
 type Resolver struct {
 	ProjectService    *proj.Service
 	RequestLogService *reqlog.Service
 	InterceptService  *intercept.Service
 	SenderService     *sender.Service
+	Proxy             *proxy.Proxy
+	// Version is the Hetty version, used e.g. as creator version of HAR
+	// exports.
+	Version string
 }
 
 type (
@@ -76,6 +185,66 @@ func (r *queryResolver) HTTPRequestLogs(ctx context.Context) ([]HTTPRequestLog,
 	return logs, nil
 }
 
+func (r *queryResolver) HTTPRequestLogsPage(
+	ctx context.Context,
+	after *ulid.ULID,
+	limit *int,
+	direction *SortDirection,
+) (*HTTPRequestLogConnection, error) {
+	opts := reqlog.PageOptions{}
+
+	if after != nil {
+		opts.After = *after
+	}
+
+	if limit != nil {
+		opts.Limit = *limit
+	}
+
+	if direction != nil {
+		opts.Direction = revSortDirectionMap[*direction]
+	}
+
+	// Counting requires iterating over all request logs, so only do it when
+	// the field was selected.
+	for _, field := range graphql.CollectFieldsCtx(ctx, nil) {
+		if field.Name == "totalCount" {
+			opts.CountTotal = true
+		}
+	}
+
+	page, err := r.RequestLogService.FindRequestsPage(ctx, opts)
+	if errors.Is(err, reqlog.ErrProjectIDMustBeSet) {
+		return nil, noActiveProjectErr(ctx)
+	} else if err != nil {
+		return nil, fmt.Errorf("could not query repository for requests: %w", err)
+	}
+
+	conn := &HTTPRequestLogConnection{
+		Nodes: make([]HTTPRequestLog, len(page.RequestLogs)),
+		PageInfo: &PageInfo{
+			HasNextPage: page.HasNextPage,
+		},
+		TotalCount: page.TotalCount,
+	}
+
+	for i, reqLog := range page.RequestLogs {
+		req, err := parseRequestLog(reqLog)
+		if err != nil {
+			return nil, err
+		}
+
+		conn.Nodes[i] = req
+	}
+
+	if len(page.RequestLogs) > 0 {
+		endCursor := page.RequestLogs[len(page.RequestLogs)-1].ID
+		conn.PageInfo.EndCursor = &endCursor
+	}
+
+	return conn, nil
+}
+
 func (r *queryResolver) HTTPRequestLog(ctx context.Context, id ulid.ULID) (*HTTPRequestLog, error) {
 	log, err := r.RequestLogService.FindRequestLogByID(ctx, id)
 	if errors.Is(err, reqlog.ErrRequestNotFound) {
@@ -99,16 +268,28 @@ func parseRequestLog(reqLog reqlog.RequestLog) (HTTPRequestLog, error) {
 	}
 
 	log := HTTPRequestLog{
-		ID:        reqLog.ID,
-		Proto:     reqLog.Proto,
-		Method:    method,
-		Timestamp: ulid.Time(reqLog.ID.Time()),
+		ID:            reqLog.ID,
+		Proto:         reqLog.Proto,
+		Method:        method,
+		BodyTruncated: reqLog.BodyTruncated,
+		Timestamp:     ulid.Time(reqLog.ID.Time()),
+		Annotations:   reqLog.Annotations,
+		Findings:      parseFindings(reqLog.Findings),
 	}
 
 	if reqLog.URL != nil {
 		log.URL = reqLog.URL.String()
 	}
 
+	if reqLog.MapRule != nil {
+		log.MapRule = parseMapRuleMatch(*reqLog.MapRule)
+	}
+
+	if reqLog.Original != nil {
+		origReq := parseOriginalRequest(*reqLog.Original, reqLog)
+		log.Original = &origReq
+	}
+
 	if len(reqLog.Body) > 0 {
 		bodyStr := string(reqLog.Body)
 		log.Body = &bodyStr
@@ -150,8 +331,11 @@ func parseResponseLog(resLog reqlog.ResponseLog) (HTTPResponseLog, error) {
 	}
 
 	httpResLog := HTTPResponseLog{
-		Proto:      proto,
-		StatusCode: resLog.StatusCode,
+		Proto:         proto,
+		StatusCode:    resLog.StatusCode,
+		BodyTruncated: resLog.BodyTruncated,
+		Annotations:   resLog.Annotations,
+		Findings:      parseFindings(resLog.Findings),
 	}
 	statusReasonSubs := strings.SplitN(resLog.Status, " ", 2)
 
@@ -164,6 +348,44 @@ func parseResponseLog(resLog reqlog.ResponseLog) (HTTPResponseLog, error) {
 		httpResLog.Body = &bodyStr
 	}
 
+	if resLog.Error != nil {
+		httpResLog.Error = &ProxyError{
+			Kind:    proxyErrorKindMap[resLog.Error.Kind],
+			Message: resLog.Error.Message,
+		}
+	}
+
+	if resLog.Original != nil {
+		origRes := parseOriginalResponse(*resLog.Original, resLog)
+		httpResLog.Original = &origRes
+	}
+
+	if resLog.EncodedBody != nil {
+		contentEncoding := resLog.ContentEncoding
+		encodedBody := base64.StdEncoding.EncodeToString(resLog.EncodedBody)
+		httpResLog.ContentEncoding = &contentEncoding
+		httpResLog.EncodedBody = &encodedBody
+	}
+
+	if resLog.ClientCertName != "" {
+		certName := resLog.ClientCertName
+		httpResLog.ClientCertificate = &certName
+	}
+
+	if resLog.Timing != nil {
+		httpResLog.Timing = &HTTPTiming{
+			DNSMs:             durationMs(resLog.Timing.DNS),
+			ConnectMs:         durationMs(resLog.Timing.Connect),
+			TLSHandshakeMs:    durationMs(resLog.Timing.TLSHandshake),
+			TimeToFirstByteMs: durationMs(resLog.Timing.TimeToFirstByte),
+			TotalMs:           durationMs(resLog.Timing.Total),
+		}
+	}
+
+	if resLog.TLS != nil {
+		httpResLog.TLS = parseTLSInfo(*resLog.TLS)
+	}
+
 	if resLog.Header != nil {
 		httpResLog.Headers = make([]HTTPHeader, 0)
 
@@ -283,6 +505,42 @@ func (r *mutationResolver) ClearHTTPRequestLog(ctx context.Context) (*ClearHTTPR
 	return &ClearHTTPRequestLogResult{true}, nil
 }
 
+func (r *queryResolver) HTTPRequestLogsHar(ctx context.Context, ids []ulid.ULID) (string, error) {
+	h, err := r.RequestLogService.ExportHAR(ctx, ids, har.Creator{Name: "Hetty", Version: r.Version})
+
+	switch {
+	case errors.Is(err, reqlog.ErrProjectIDMustBeSet):
+		return "", noActiveProjectErr(ctx)
+	case errors.Is(err, reqlog.ErrRequestNotFound):
+		return "", gqlerror.Errorf("Request log not found.")
+	case err != nil:
+		return "", fmt.Errorf("could not export request logs: %w", err)
+	}
+
+	buf, err := json.Marshal(h)
+	if err != nil {
+		return "", fmt.Errorf("could not encode HAR: %w", err)
+	}
+
+	return string(buf), nil
+}
+
+func (r *mutationResolver) ImportHar(ctx context.Context, input string) (*ImportHARResult, error) {
+	h, err := har.Decode(strings.NewReader(input))
+	if err != nil {
+		return nil, gqlerror.Errorf("Invalid HAR: %v", err)
+	}
+
+	reqLogs, err := r.RequestLogService.ImportHAR(ctx, h)
+	if errors.Is(err, reqlog.ErrProjectIDMustBeSet) {
+		return nil, noActiveProjectErr(ctx)
+	} else if err != nil {
+		return nil, fmt.Errorf("could not import HAR: %w", err)
+	}
+
+	return &ImportHARResult{Count: len(reqLogs)}, nil
+}
+
 func (r *mutationResolver) SetScope(ctx context.Context, input []ScopeRuleInput) ([]ScopeRule, error) {
 	rules := make([]scope.Rule, len(input))
 
@@ -368,6 +626,42 @@ func (r *queryResolver) SenderRequest(ctx context.Context, id ulid.ULID) (*Sende
 	return &req, nil
 }
 
+func (r *queryResolver) HTTPRequestLogCode(ctx context.Context, id ulid.ULID, format CodeFormat) (*string, error) {
+	reqLog, err := r.RequestLogService.FindRequestLogByID(ctx, id)
+	if errors.Is(err, reqlog.ErrRequestNotFound) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("could not get request by ID: %w", err)
+	}
+
+	return generateCode(sender.RequestFromRequestLog(reqLog), format)
+}
+
+func (r *queryResolver) SenderRequestCode(ctx context.Context, id ulid.ULID, format CodeFormat) (*string, error) {
+	req, err := r.SenderService.FindRequestByID(ctx, id)
+	if errors.Is(err, sender.ErrRequestNotFound) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("could not get request by ID: %w", err)
+	}
+
+	return generateCode(req, format)
+}
+
+func generateCode(req sender.Request, format CodeFormat) (*string, error) {
+	codeFormat, ok := revCodeFormatMap[format]
+	if !ok {
+		return nil, gqlerror.Errorf("Invalid code format: %v", format)
+	}
+
+	code, err := sender.GenerateCode(req, codeFormat)
+	if err != nil {
+		return nil, fmt.Errorf("could not generate code: %w", err)
+	}
+
+	return &code, nil
+}
+
 func (r *queryResolver) SenderRequests(ctx context.Context) ([]SenderRequest, error) {
 	reqs, err := r.SenderService.FindRequests(ctx)
 	if errors.Is(err, proj.ErrNoProject) {
@@ -453,6 +747,50 @@ func (r *mutationResolver) CreateOrUpdateSenderRequest(
 	return &senderReq, nil
 }
 
+func (r *mutationResolver) CreateSenderRequestFromCurl(ctx context.Context, command string) (*SenderRequest, error) {
+	req, err := sender.ParseCurl(command)
+	if err != nil {
+		return nil, gqlerror.Errorf("Invalid curl command: %v", err)
+	}
+
+	return r.createSenderRequest(ctx, req)
+}
+
+func (r *mutationResolver) CreateSenderRequestFromRaw(
+	ctx context.Context,
+	raw string,
+	https *bool,
+) (*SenderRequest, error) {
+	useTLS := https == nil || *https
+
+	req, err := sender.ParseRawRequest(raw, useTLS)
+	if err != nil {
+		return nil, gqlerror.Errorf("Invalid raw HTTP request: %v", err)
+	}
+
+	return r.createSenderRequest(ctx, req)
+}
+
+func (r *mutationResolver) createSenderRequest(ctx context.Context, req sender.Request) (*SenderRequest, error) {
+	if method := HTTPMethod(req.Method); !method.IsValid() {
+		return nil, gqlerror.Errorf("Unsupported HTTP method: %v", req.Method)
+	}
+
+	req, err := r.SenderService.CreateOrUpdateRequest(ctx, req)
+	if errors.Is(err, sender.ErrProjectIDMustBeSet) {
+		return nil, noActiveProjectErr(ctx)
+	} else if err != nil {
+		return nil, fmt.Errorf("could not create sender request: %w", err)
+	}
+
+	senderReq, err := parseSenderRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &senderReq, nil
+}
+
 func (r *mutationResolver) CreateSenderRequestFromHTTPRequestLog(
 	ctx context.Context,
 	id ulid.ULID,
@@ -638,6 +976,10 @@ func (r *mutationResolver) UpdateInterceptSettings(
 		ResponsesEnabled: input.ResponsesEnabled,
 	}
 
+	if input.WebSocketsEnabled != nil {
+		settings.WebSocketsEnabled = *input.WebSocketsEnabled
+	}
+
 	if input.RequestFilter != nil && *input.RequestFilter != "" {
 		expr, err := filter.ParseQuery(*input.RequestFilter)
 		if err != nil {
@@ -664,8 +1006,9 @@ func (r *mutationResolver) UpdateInterceptSettings(
 	}
 
 	updated := &InterceptSettings{
-		RequestsEnabled:  settings.RequestsEnabled,
-		ResponsesEnabled: settings.ResponsesEnabled,
+		RequestsEnabled:   settings.RequestsEnabled,
+		ResponsesEnabled:  settings.ResponsesEnabled,
+		WebSocketsEnabled: settings.WebSocketsEnabled,
 	}
 
 	if settings.RequestFilter != nil {
@@ -681,113 +1024,870 @@ func (r *mutationResolver) UpdateInterceptSettings(
 	return updated, nil
 }
 
-func parseSenderRequest(req sender.Request) (SenderRequest, error) {
-	method := HTTPMethod(req.Method)
-	if method != "" && !method.IsValid() {
-		return SenderRequest{}, fmt.Errorf("sender request has invalid method: %v", method)
+func (r *mutationResolver) UpdateRequestLogSettings(
+	ctx context.Context,
+	input UpdateRequestLogSettingsInput,
+) (*RequestLogSettings, error) {
+	var maxBodySize int64
+	if input.MaxBodySize != nil {
+		maxBodySize = int64(*input.MaxBodySize)
 	}
 
-	reqProto := httpProtocolMap[req.Proto]
-	if !reqProto.IsValid() {
-		return SenderRequest{}, fmt.Errorf("sender request has invalid protocol: %v", req.Proto)
-	}
+	fullTextIndex := input.FullTextIndex != nil && *input.FullTextIndex
 
-	senderReq := SenderRequest{
-		ID:        req.ID,
-		URL:       req.URL,
-		Method:    method,
-		Proto:     HTTPProtocol(req.Proto),
-		Timestamp: ulid.Time(req.ID.Time()),
+	err := r.ProjectService.SetRequestLogMaxBodySize(ctx, maxBodySize)
+
+	switch {
+	case errors.Is(err, proj.ErrNoProject):
+		return nil, noActiveProjectErr(ctx)
+	case errors.Is(err, proj.ErrInvalidBodySize):
+		return nil, gqlerror.Errorf("Max body size must not be negative.")
+	case err != nil:
+		return nil, fmt.Errorf("could not update request log settings: %w", err)
 	}
 
-	if req.SourceRequestLogID.Compare(ulid.ULID{}) != 0 {
-		senderReq.SourceRequestLogID = &req.SourceRequestLogID
+	err = r.ProjectService.SetRequestLogFullTextIndex(ctx, fullTextIndex)
+	if err != nil {
+		return nil, fmt.Errorf("could not update request log settings: %w", err)
 	}
 
-	if req.Header != nil {
-		senderReq.Headers = make([]HTTPHeader, 0)
+	return parseRequestLogSettings(maxBodySize, fullTextIndex), nil
+}
 
-		for key, values := range req.Header {
-			for _, value := range values {
-				senderReq.Headers = append(senderReq.Headers, HTTPHeader{
-					Key:   key,
-					Value: value,
-				})
-			}
-		}
+func (r *mutationResolver) UpdateProxySettings(
+	ctx context.Context,
+	input UpdateProxySettingsInput,
+) (*ProxySettings, error) {
+	settings := r.Proxy.Settings()
+	settings.DowngradeHTTP2 = input.DowngradeHTTP2
 
-		sort.Sort(HTTPHeaders(senderReq.Headers))
+	if input.DecompressBodies != nil {
+		settings.DisableDecompression = !*input.DecompressBodies
 	}
 
-	if len(req.Body) > 0 {
-		bodyStr := string(req.Body)
-		senderReq.Body = &bodyStr
+	err := r.ProjectService.UpdateProxySettings(ctx, settings)
+	if errors.Is(err, proj.ErrNoProject) {
+		return nil, noActiveProjectErr(ctx)
+	} else if err != nil {
+		return nil, fmt.Errorf("could not update proxy settings: %w", err)
 	}
 
-	if req.Response != nil {
-		resLog, err := parseResponseLog(*req.Response)
-		if err != nil {
-			return SenderRequest{}, err
-		}
-
-		resLog.ID = req.ID
-
-		senderReq.Response = &resLog
-	}
+	return parseProxySettings(r.Proxy.Settings()), nil
+}
 
-	return senderReq, nil
+func (r *queryResolver) MatchReplaceRules(ctx context.Context) ([]MatchReplaceRule, error) {
+	return parseMatchReplaceRules(r.ProjectService.MatchReplace().Rules()), nil
 }
 
-func parseHTTPRequest(req *http.Request) (HTTPRequest, error) {
-	method := HTTPMethod(req.Method)
-	if method != "" && !method.IsValid() {
-		return HTTPRequest{}, fmt.Errorf("http request has invalid method: %v", method)
-	}
+func (r *mutationResolver) SetMatchReplaceRules(
+	ctx context.Context,
+	input []MatchReplaceRuleInput,
+) ([]MatchReplaceRule, error) {
+	rules := make([]matchreplace.Rule, len(input))
 
-	reqProto := httpProtocolMap[req.Proto]
-	if !reqProto.IsValid() {
-		return HTTPRequest{}, fmt.Errorf("http request has invalid protocol: %v", req.Proto)
-	}
+	for i, rule := range input {
+		target, ok := revMatchReplaceTargetMap[rule.Target]
+		if !ok {
+			return nil, gqlerror.Errorf("Invalid match and replace target: %v", rule.Target)
+		}
 
-	id, ok := proxy.RequestIDFromContext(req.Context())
-	if !ok {
-		return HTTPRequest{}, errors.New("http request has missing ID")
-	}
+		rules[i] = matchreplace.Rule{
+			Enabled: rule.Enabled,
+			Target:  target,
+			Match:   rule.Match,
+			Replace: rule.Replace,
+		}
 
-	httpReq := HTTPRequest{
-		ID:     id,
-		URL:    req.URL,
-		Method: method,
-		Proto:  HTTPProtocol(req.Proto),
-	}
+		if rule.ID != nil {
+			rules[i].ID = *rule.ID
+		}
 
-	if req.Header != nil {
-		httpReq.Headers = make([]HTTPHeader, 0)
+		if rule.Name != nil {
+			rules[i].Name = *rule.Name
+		}
 
-		for key, values := range req.Header {
-			for _, value := range values {
-				httpReq.Headers = append(httpReq.Headers, HTTPHeader{
-					Key:   key,
-					Value: value,
-				})
-			}
+		if rule.IsRegexp != nil {
+			rules[i].IsRegexp = *rule.IsRegexp
 		}
 
-		sort.Sort(HTTPHeaders(httpReq.Headers))
-	}
+		if rule.Condition != nil && *rule.Condition != "" {
+			expr, err := filter.ParseQuery(*rule.Condition)
+			if err != nil {
+				return nil, gqlerror.Errorf("Invalid condition in match and replace rule: %v", err)
+			}
 
-	if req.Body != nil {
-		body, err := ioutil.ReadAll(req.Body)
-		if err != nil {
-			return HTTPRequest{}, fmt.Errorf("failed to read request body: %w", err)
+			rules[i].Condition = expr
 		}
+	}
 
-		req.Body = ioutil.NopCloser(bytes.NewBuffer(body))
-		bodyStr := string(body)
-		httpReq.Body = &bodyStr
+	rules, err := r.ProjectService.SetMatchReplaceRules(ctx, rules)
+
+	switch {
+	case errors.Is(err, proj.ErrNoProject):
+		return nil, noActiveProjectErr(ctx)
+	case errors.Is(err, matchreplace.ErrInvalidRule):
+		return nil, gqlerror.Errorf("Invalid match and replace rule: %v", err)
+	case err != nil:
+		return nil, fmt.Errorf("could not set match and replace rules: %w", err)
 	}
 
-	return httpReq, nil
+	return parseMatchReplaceRules(rules), nil
+}
+
+func (r *queryResolver) Scripts(ctx context.Context) ([]Script, error) {
+	return parseScripts(r.ProjectService.Scripts().Scripts()), nil
+}
+
+func (r *mutationResolver) SetScripts(ctx context.Context, input []ScriptInput) ([]Script, error) {
+	scripts := make([]script.Script, len(input))
+
+	for i, s := range input {
+		scripts[i] = script.Script{
+			Name:    s.Name,
+			Enabled: s.Enabled,
+			Source:  s.Source,
+		}
+
+		if s.ID != nil {
+			scripts[i].ID = *s.ID
+		}
+	}
+
+	scripts, err := r.ProjectService.SetScripts(ctx, scripts)
+
+	switch {
+	case errors.Is(err, proj.ErrNoProject):
+		return nil, noActiveProjectErr(ctx)
+	case errors.Is(err, script.ErrInvalidScript):
+		return nil, gqlerror.Errorf("Invalid script: %v", err)
+	case err != nil:
+		return nil, fmt.Errorf("could not set scripts: %w", err)
+	}
+
+	return parseScripts(scripts), nil
+}
+
+func (r *queryResolver) ProxyModifiers(ctx context.Context) ([]ProxyModifier, error) {
+	return parseProxyModifiers(r.Proxy.Modifiers()), nil
+}
+
+func (r *mutationResolver) SetProxyModifiers(
+	ctx context.Context,
+	input []ProxyModifierInput,
+) ([]ProxyModifier, error) {
+	modifiers := make([]proxy.Modifier, len(input))
+
+	for i, mod := range input {
+		kind, ok := revProxyModifierKindMap[mod.Kind]
+		if !ok {
+			return nil, gqlerror.Errorf("Invalid proxy modifier kind: %v", mod.Kind)
+		}
+
+		modifiers[i] = proxy.Modifier{
+			Kind:    kind,
+			Name:    mod.Name,
+			Enabled: mod.Enabled,
+		}
+	}
+
+	modifiers, err := r.ProjectService.SetProxyModifiers(ctx, modifiers)
+
+	switch {
+	case errors.Is(err, proj.ErrNoProject):
+		return nil, noActiveProjectErr(ctx)
+	case errors.Is(err, proxy.ErrModifierNotFound), errors.Is(err, proxy.ErrModifierExists):
+		return nil, gqlerror.Errorf("Invalid proxy modifiers: %v", err)
+	case err != nil:
+		return nil, fmt.Errorf("could not set proxy modifiers: %w", err)
+	}
+
+	return parseProxyModifiers(modifiers), nil
+}
+
+func (r *queryResolver) ThrottleRules(ctx context.Context) ([]ThrottleRule, error) {
+	return parseThrottleRules(r.ProjectService.Throttle().Rules()), nil
+}
+
+func (r *queryResolver) ThrottlePresets(ctx context.Context) ([]ThrottlePreset, error) {
+	presets := make([]ThrottlePreset, len(throttle.Presets))
+
+	for i, preset := range throttle.Presets {
+		presets[i] = ThrottlePreset{
+			Name:       preset.Name,
+			Conditions: parseThrottleConditions(preset.Conditions),
+		}
+	}
+
+	return presets, nil
+}
+
+func (r *mutationResolver) SetThrottleRules(ctx context.Context, input []ThrottleRuleInput) ([]ThrottleRule, error) {
+	rules := make([]throttle.Rule, len(input))
+
+	for i, rule := range input {
+		rules[i] = throttle.Rule{
+			Enabled: rule.Enabled,
+		}
+
+		if rule.ID != nil {
+			rules[i].ID = *rule.ID
+		}
+
+		if rule.Name != nil {
+			rules[i].Name = *rule.Name
+		}
+
+		if rule.Host != nil && *rule.Host != "" {
+			host, err := regexp.Compile(*rule.Host)
+			if err != nil {
+				return nil, gqlerror.Errorf("Invalid host in throttle rule: %v", err)
+			}
+
+			rules[i].Host = host
+		}
+
+		if rule.Condition != nil && *rule.Condition != "" {
+			expr, err := filter.ParseQuery(*rule.Condition)
+			if err != nil {
+				return nil, gqlerror.Errorf("Invalid condition in throttle rule: %v", err)
+			}
+
+			rules[i].Condition = expr
+		}
+
+		switch {
+		case rule.Preset != nil && rule.Conditions != nil:
+			return nil, gqlerror.Errorf("Either preset or conditions must be set for throttle rule, not both.")
+		case rule.Preset != nil:
+			cond, ok := throttle.PresetByName(*rule.Preset)
+			if !ok {
+				return nil, gqlerror.Errorf("Unknown throttle preset: %v", *rule.Preset)
+			}
+
+			rules[i].Conditions = cond
+		case rule.Conditions != nil:
+			cond, err := parseThrottleConditionsInput(*rule.Conditions)
+			if err != nil {
+				return nil, err
+			}
+
+			rules[i].Conditions = cond
+		default:
+			return nil, gqlerror.Errorf("Either preset or conditions must be set for throttle rule.")
+		}
+	}
+
+	rules, err := r.ProjectService.SetThrottleRules(ctx, rules)
+
+	switch {
+	case errors.Is(err, proj.ErrNoProject):
+		return nil, noActiveProjectErr(ctx)
+	case errors.Is(err, throttle.ErrInvalidRule):
+		return nil, gqlerror.Errorf("Invalid throttle rule: %v", err)
+	case err != nil:
+		return nil, fmt.Errorf("could not set throttle rules: %w", err)
+	}
+
+	return parseThrottleRules(rules), nil
+}
+
+func parseThrottleConditionsInput(input ThrottleConditionsInput) (throttle.Conditions, error) {
+	cond := throttle.Conditions{}
+
+	if input.Latency != nil {
+		cond.Latency = time.Duration(*input.Latency) * time.Millisecond
+	}
+
+	if input.Jitter != nil {
+		cond.Jitter = time.Duration(*input.Jitter) * time.Millisecond
+	}
+
+	if input.UploadBytesPerSecond != nil {
+		cond.UploadBytesPerSecond = int64(*input.UploadBytesPerSecond)
+	}
+
+	if input.DownloadBytesPerSecond != nil {
+		cond.DownloadBytesPerSecond = int64(*input.DownloadBytesPerSecond)
+	}
+
+	if input.FailureRate != nil {
+		cond.FailureRate = *input.FailureRate
+	}
+
+	if input.FailureMode != nil {
+		failureMode, ok := revThrottleFailureModeMap[*input.FailureMode]
+		if !ok {
+			return throttle.Conditions{}, gqlerror.Errorf("Invalid throttle failure mode: %v", *input.FailureMode)
+		}
+
+		cond.FailureMode = failureMode
+	}
+
+	return cond, nil
+}
+
+func (r *mutationResolver) SetTLSPassthroughRules(
+	ctx context.Context,
+	input []TLSPassthroughRuleInput,
+) ([]TLSPassthroughRule, error) {
+	rules := make([]proxy.PassthroughRule, len(input))
+
+	for i, rule := range input {
+		switch {
+		case rule.Host != nil && rule.HostGlob == nil:
+			host, err := regexp.Compile(*rule.Host)
+			if err != nil {
+				return nil, fmt.Errorf("invalid host in TLS passthrough rule: %w", err)
+			}
+
+			rules[i].Host = host
+		case rule.HostGlob != nil && rule.Host == nil:
+			if _, err := path.Match(*rule.HostGlob, ""); err != nil || *rule.HostGlob == "" {
+				return nil, fmt.Errorf("invalid host glob in TLS passthrough rule: %q", *rule.HostGlob)
+			}
+
+			rules[i].HostGlob = *rule.HostGlob
+		default:
+			return nil, errors.New("exactly one of host and host glob must be set in TLS passthrough rule")
+		}
+
+		if rule.Port != nil {
+			rules[i].Port = *rule.Port
+		}
+	}
+
+	err := r.ProjectService.SetTLSPassthroughRules(ctx, rules)
+	if errors.Is(err, proj.ErrNoProject) {
+		return nil, noActiveProjectErr(ctx)
+	} else if err != nil {
+		return nil, fmt.Errorf("could not set TLS passthrough rules: %w", err)
+	}
+
+	return parseProxySettings(r.Proxy.Settings()).TLSPassthrough, nil
+}
+
+func (r *queryResolver) ClientCertificates(ctx context.Context) ([]ClientCertificate, error) {
+	certs, err := r.ProjectService.ClientCertificates(ctx)
+	if errors.Is(err, proj.ErrNoProject) {
+		return nil, noActiveProjectErr(ctx)
+	} else if err != nil {
+		return nil, fmt.Errorf("could not get client certificates: %w", err)
+	}
+
+	clientCerts := make([]ClientCertificate, 0, len(certs))
+
+	for _, cert := range certs {
+		clientCert, err := parseClientCertificate(cert)
+		if err != nil {
+			return nil, err
+		}
+
+		clientCerts = append(clientCerts, clientCert)
+	}
+
+	return clientCerts, nil
+}
+
+func (r *mutationResolver) AddClientCertificate(
+	ctx context.Context,
+	input AddClientCertificateInput,
+) (*ClientCertificate, error) {
+	var (
+		cert clientcert.Certificate
+		err  error
+	)
+
+	switch {
+	case input.Pkcs12 != nil:
+		data, decodeErr := base64.StdEncoding.DecodeString(*input.Pkcs12)
+		if decodeErr != nil {
+			return nil, gqlerror.Errorf("PKCS#12 data must be base64 encoded.")
+		}
+
+		var password string
+		if input.Pkcs12Password != nil {
+			password = *input.Pkcs12Password
+		}
+
+		cert, err = clientcert.ParsePKCS12(data, password)
+	case input.CertPem != nil:
+		var keyPEM []byte
+		if input.KeyPem != nil {
+			keyPEM = []byte(*input.KeyPem)
+		}
+
+		cert, err = clientcert.ParsePEM([]byte(*input.CertPem), keyPEM)
+	default:
+		return nil, gqlerror.Errorf("Either PEM or PKCS#12 data must be set.")
+	}
+
+	if err != nil {
+		return nil, gqlerror.Errorf("Invalid client certificate: %v", err)
+	}
+
+	cert.Name = input.Name
+	cert.Hosts = input.Hosts
+
+	cert, err = r.ProjectService.AddClientCertificate(ctx, cert)
+
+	switch {
+	case errors.Is(err, proj.ErrNoProject):
+		return nil, noActiveProjectErr(ctx)
+	case errors.Is(err, clientcert.ErrInvalidHostPattern):
+		return nil, gqlerror.Errorf("Invalid client certificate: %v", err)
+	case err != nil:
+		return nil, fmt.Errorf("could not add client certificate: %w", err)
+	}
+
+	clientCert, err := parseClientCertificate(cert)
+	if err != nil {
+		return nil, err
+	}
+
+	return &clientCert, nil
+}
+
+func (r *mutationResolver) DeleteClientCertificate(
+	ctx context.Context,
+	id ulid.ULID,
+) (*DeleteClientCertificateResult, error) {
+	err := r.ProjectService.DeleteClientCertificate(ctx, id)
+
+	switch {
+	case errors.Is(err, proj.ErrNoProject):
+		return nil, noActiveProjectErr(ctx)
+	case errors.Is(err, clientcert.ErrCertificateNotFound):
+		return nil, gqlerror.Errorf("Client certificate not found.")
+	case err != nil:
+		return nil, fmt.Errorf("could not delete client certificate: %w", err)
+	}
+
+	return &DeleteClientCertificateResult{
+		Success: true,
+	}, nil
+}
+
+func (r *queryResolver) MapRemoteRules(ctx context.Context) ([]MapRemoteRule, error) {
+	return parseMapRemoteRules(r.ProjectService.MapRules().RemoteRules()), nil
+}
+
+func (r *queryResolver) MapLocalRules(ctx context.Context) ([]MapLocalRule, error) {
+	return parseMapLocalRules(r.ProjectService.MapRules().LocalRules()), nil
+}
+
+func (r *mutationResolver) SetMapRemoteRules(ctx context.Context, input []MapRemoteRuleInput) ([]MapRemoteRule, error) {
+	rules := make([]maprule.RemoteRule, len(input))
+
+	for i, rule := range input {
+		u, err := regexp.Compile(rule.URL)
+		if err != nil {
+			return nil, gqlerror.Errorf("Invalid URL in Map Remote rule: %v", err)
+		}
+
+		rules[i] = maprule.RemoteRule{
+			Enabled: rule.Enabled,
+			URL:     u,
+		}
+
+		if rule.ID != nil {
+			rules[i].ID = *rule.ID
+		}
+
+		if rule.Name != nil {
+			rules[i].Name = *rule.Name
+		}
+
+		if rule.Scheme != nil {
+			rules[i].Scheme = *rule.Scheme
+		}
+
+		if rule.Host != nil {
+			rules[i].Host = *rule.Host
+		}
+
+		if rule.Port != nil {
+			rules[i].Port = *rule.Port
+		}
+
+		if rule.Path != nil {
+			rules[i].Path = *rule.Path
+		}
+
+		if rule.PreserveHost != nil {
+			rules[i].PreserveHost = *rule.PreserveHost
+		}
+	}
+
+	rules, err := r.ProjectService.SetMapRemoteRules(ctx, rules)
+
+	switch {
+	case errors.Is(err, proj.ErrNoProject):
+		return nil, noActiveProjectErr(ctx)
+	case errors.Is(err, maprule.ErrInvalidRule):
+		return nil, gqlerror.Errorf("Invalid Map Remote rule: %v", err)
+	case err != nil:
+		return nil, fmt.Errorf("could not set Map Remote rules: %w", err)
+	}
+
+	return parseMapRemoteRules(rules), nil
+}
+
+func (r *mutationResolver) SetMapLocalRules(ctx context.Context, input []MapLocalRuleInput) ([]MapLocalRule, error) {
+	rules := make([]maprule.LocalRule, len(input))
+
+	for i, rule := range input {
+		u, err := regexp.Compile(rule.URL)
+		if err != nil {
+			return nil, gqlerror.Errorf("Invalid URL in Map Local rule: %v", err)
+		}
+
+		rules[i] = maprule.LocalRule{
+			Enabled: rule.Enabled,
+			URL:     u,
+		}
+
+		if rule.ID != nil {
+			rules[i].ID = *rule.ID
+		}
+
+		if rule.Name != nil {
+			rules[i].Name = *rule.Name
+		}
+
+		if rule.FilePath != nil {
+			rules[i].FilePath = *rule.FilePath
+		}
+
+		if rule.Body != nil {
+			rules[i].Body = []byte(*rule.Body)
+		}
+
+		if rule.StatusCode != nil {
+			rules[i].StatusCode = *rule.StatusCode
+		}
+
+		if len(rule.Headers) > 0 {
+			rules[i].Header = make(http.Header)
+
+			for _, header := range rule.Headers {
+				rules[i].Header.Add(header.Key, header.Value)
+			}
+		}
+	}
+
+	rules, err := r.ProjectService.SetMapLocalRules(ctx, rules)
+
+	switch {
+	case errors.Is(err, proj.ErrNoProject):
+		return nil, noActiveProjectErr(ctx)
+	case errors.Is(err, maprule.ErrInvalidRule):
+		return nil, gqlerror.Errorf("Invalid Map Local rule: %v", err)
+	case err != nil:
+		return nil, fmt.Errorf("could not set Map Local rules: %w", err)
+	}
+
+	return parseMapLocalRules(rules), nil
+}
+
+func (r *queryResolver) TunnelLogs(ctx context.Context) ([]TunnelLog, error) {
+	tunnelLogs, err := r.RequestLogService.FindTunnelLogs(ctx)
+	if errors.Is(err, reqlog.ErrProjectIDMustBeSet) {
+		return nil, noActiveProjectErr(ctx)
+	} else if err != nil {
+		return nil, fmt.Errorf("could not query repository for tunnel logs: %w", err)
+	}
+
+	logs := make([]TunnelLog, len(tunnelLogs))
+
+	for i, tunnelLog := range tunnelLogs {
+		logs[i] = TunnelLog{
+			ID:            tunnelLog.ID,
+			Host:          tunnelLog.Host,
+			Port:          tunnelLog.Port,
+			BytesSent:     int(tunnelLog.BytesSent),
+			BytesReceived: int(tunnelLog.BytesReceived),
+			StartedAt:     tunnelLog.StartedAt,
+			DurationMs:    int(tunnelLog.Duration.Milliseconds()),
+		}
+	}
+
+	return logs, nil
+}
+
+func (r *mutationResolver) UpdateUpstreamProxySettings(
+	ctx context.Context,
+	input *UpdateUpstreamProxySettingsInput,
+) (*UpstreamProxySettings, error) {
+	var settings upstream.Settings
+
+	if input != nil {
+		settings = upstream.Settings{
+			Type:   revUpstreamProxyTypeMap[input.Type],
+			Addr:   input.Addr,
+			Bypass: input.Bypass,
+		}
+
+		if input.Username != nil {
+			settings.Username = *input.Username
+		}
+
+		if input.Password != nil {
+			settings.Password = *input.Password
+		}
+	}
+
+	if err := settings.Validate(); err != nil {
+		return nil, gqlerror.Errorf("Invalid upstream proxy settings: %v", err)
+	}
+
+	err := r.ProjectService.UpdateUpstreamProxySettings(ctx, settings)
+	if errors.Is(err, proj.ErrNoProject) {
+		return nil, noActiveProjectErr(ctx)
+	} else if err != nil {
+		return nil, fmt.Errorf("could not update upstream proxy settings: %w", err)
+	}
+
+	return parseUpstreamProxySettings(settings), nil
+}
+
+func (r *queryResolver) WebSocketMessages(ctx context.Context, requestLogID ulid.ULID) ([]WebSocketMessage, error) {
+	msgLogs, err := r.RequestLogService.FindWebSocketMessages(ctx, requestLogID)
+	if errors.Is(err, reqlog.ErrProjectIDMustBeSet) {
+		return nil, noActiveProjectErr(ctx)
+	} else if err != nil {
+		return nil, fmt.Errorf("could not get websocket messages: %w", err)
+	}
+
+	msgs := make([]WebSocketMessage, 0, len(msgLogs))
+
+	for _, msgLog := range msgLogs {
+		msg, ok := parseWebSocketMessage(proxy.WebSocketMessage{
+			ID:         msgLog.ID,
+			RequestID:  msgLog.RequestLogID,
+			Opcode:     msgLog.Opcode,
+			Payload:    msgLog.Payload,
+			FromClient: msgLog.FromClient,
+		})
+		if !ok {
+			continue
+		}
+
+		msgs = append(msgs, msg)
+	}
+
+	return msgs, nil
+}
+
+func (r *queryResolver) InterceptedWebSocketMessages(ctx context.Context) ([]WebSocketMessage, error) {
+	pending := r.InterceptService.WebSocketMessages()
+	msgs := make([]WebSocketMessage, 0, len(pending))
+
+	for _, msg := range pending {
+		parsed, ok := parseWebSocketMessage(msg)
+		if !ok {
+			continue
+		}
+
+		msgs = append(msgs, parsed)
+	}
+
+	return msgs, nil
+}
+
+func (r *mutationResolver) ModifyWebSocketMessage(
+	ctx context.Context,
+	input ModifyWebSocketMessageInput,
+) (*ModifyWebSocketMessageResult, error) {
+	payload := []byte{}
+	if input.Payload != nil {
+		payload = []byte(*input.Payload)
+	}
+
+	err := r.InterceptService.ModifyWebSocketMessage(input.ID, payload)
+	if err != nil {
+		return nil, fmt.Errorf("could not modify websocket message: %w", err)
+	}
+
+	return &ModifyWebSocketMessageResult{Success: true}, nil
+}
+
+func (r *mutationResolver) CancelWebSocketMessage(ctx context.Context, id ulid.ULID) (*CancelWebSocketMessageResult, error) {
+	err := r.InterceptService.CancelWebSocketMessage(id)
+	if err != nil {
+		return nil, fmt.Errorf("could not cancel websocket message: %w", err)
+	}
+
+	return &CancelWebSocketMessageResult{Success: true}, nil
+}
+
+func (r *mutationResolver) SendWebSocketMessage(
+	ctx context.Context,
+	input SendWebSocketMessageInput,
+) (*WebSocketMessage, error) {
+	msg := proxy.WebSocketMessage{
+		Opcode:     revWebSocketOpcodeMap[input.Opcode],
+		FromClient: input.Direction == WebSocketMessageDirectionClientToServer,
+	}
+
+	if input.Payload != nil {
+		msg.Payload = []byte(*input.Payload)
+	}
+
+	// Messages are sent in the context of the WebSocket connection, not `ctx`,
+	// so they pass through modifiers (e.g. logging) like relayed messages.
+	sent, err := r.Proxy.SendWebSocketMessage(input.RequestID, msg)
+	if errors.Is(err, proxy.ErrWebSocketConnNotFound) {
+		return nil, &gqlerror.Error{
+			Path:    graphql.GetPath(ctx),
+			Message: "WebSocket connection is closed.",
+			Extensions: map[string]interface{}{
+				"code": "websocket_conn_not_found",
+			},
+		}
+	} else if err != nil {
+		return nil, fmt.Errorf("could not send websocket message: %w", err)
+	}
+
+	parsed, _ := parseWebSocketMessage(sent)
+
+	return &parsed, nil
+}
+
+// parseWebSocketMessage returns false for messages with opcodes that aren't
+// exposed via the API.
+func parseWebSocketMessage(msg proxy.WebSocketMessage) (WebSocketMessage, bool) {
+	opcode, ok := webSocketOpcodeMap[msg.Opcode]
+	if !ok {
+		return WebSocketMessage{}, false
+	}
+
+	wsMsg := WebSocketMessage{
+		ID:        msg.ID,
+		RequestID: msg.RequestID,
+		Direction: WebSocketMessageDirectionServerToClient,
+		Opcode:    opcode,
+		Timestamp: ulid.Time(msg.ID.Time()),
+	}
+
+	if msg.FromClient {
+		wsMsg.Direction = WebSocketMessageDirectionClientToServer
+	}
+
+	if len(msg.Payload) > 0 {
+		payload := string(msg.Payload)
+		wsMsg.Payload = &payload
+	}
+
+	return wsMsg, true
+}
+
+func parseSenderRequest(req sender.Request) (SenderRequest, error) {
+	method := HTTPMethod(req.Method)
+	if method != "" && !method.IsValid() {
+		return SenderRequest{}, fmt.Errorf("sender request has invalid method: %v", method)
+	}
+
+	reqProto := httpProtocolMap[req.Proto]
+	if !reqProto.IsValid() {
+		return SenderRequest{}, fmt.Errorf("sender request has invalid protocol: %v", req.Proto)
+	}
+
+	senderReq := SenderRequest{
+		ID:        req.ID,
+		URL:       req.URL,
+		Method:    method,
+		Proto:     HTTPProtocol(req.Proto),
+		Timestamp: ulid.Time(req.ID.Time()),
+	}
+
+	if req.SourceRequestLogID.Compare(ulid.ULID{}) != 0 {
+		senderReq.SourceRequestLogID = &req.SourceRequestLogID
+	}
+
+	if req.Header != nil {
+		senderReq.Headers = make([]HTTPHeader, 0)
+
+		for key, values := range req.Header {
+			for _, value := range values {
+				senderReq.Headers = append(senderReq.Headers, HTTPHeader{
+					Key:   key,
+					Value: value,
+				})
+			}
+		}
+
+		sort.Sort(HTTPHeaders(senderReq.Headers))
+	}
+
+	if len(req.Body) > 0 {
+		bodyStr := string(req.Body)
+		senderReq.Body = &bodyStr
+	}
+
+	if req.Response != nil {
+		resLog, err := parseResponseLog(*req.Response)
+		if err != nil {
+			return SenderRequest{}, err
+		}
+
+		resLog.ID = req.ID
+
+		senderReq.Response = &resLog
+	}
+
+	return senderReq, nil
+}
+
+func parseHTTPRequest(req *http.Request) (HTTPRequest, error) {
+	method := HTTPMethod(req.Method)
+	if method != "" && !method.IsValid() {
+		return HTTPRequest{}, fmt.Errorf("http request has invalid method: %v", method)
+	}
+
+	reqProto := httpProtocolMap[req.Proto]
+	if !reqProto.IsValid() {
+		return HTTPRequest{}, fmt.Errorf("http request has invalid protocol: %v", req.Proto)
+	}
+
+	id, ok := proxy.RequestIDFromContext(req.Context())
+	if !ok {
+		return HTTPRequest{}, errors.New("http request has missing ID")
+	}
+
+	httpReq := HTTPRequest{
+		ID:     id,
+		URL:    req.URL,
+		Method: method,
+		Proto:  HTTPProtocol(req.Proto),
+	}
+
+	if req.Header != nil {
+		httpReq.Headers = make([]HTTPHeader, 0)
+
+		for key, values := range req.Header {
+			for _, value := range values {
+				httpReq.Headers = append(httpReq.Headers, HTTPHeader{
+					Key:   key,
+					Value: value,
+				})
+			}
+		}
+
+		sort.Sort(HTTPHeaders(httpReq.Headers))
+	}
+
+	if req.Body != nil {
+		body, err := ioutil.ReadAll(req.Body)
+		if err != nil {
+			return HTTPRequest{}, fmt.Errorf("failed to read request body: %w", err)
+		}
+
+		req.Body = ioutil.NopCloser(bytes.NewBuffer(body))
+		bodyStr := string(body)
+		httpReq.Body = &bodyStr
+	}
+
+	return httpReq, nil
 }
 
 func parseHTTPResponse(res *http.Response) (HTTPResponse, error) {
@@ -871,10 +1971,24 @@ func parseProject(projSvc *proj.Service, p proj.Project) Project {
 		Name:     p.Name,
 		IsActive: projSvc.IsProjectActive(p.ID),
 		Settings: &ProjectSettings{
+			RequestLog: parseRequestLogSettings(p.Settings.ReqLogMaxBodySize, p.Settings.ReqLogFullTextIndex),
 			Intercept: &InterceptSettings{
-				RequestsEnabled:  p.Settings.InterceptRequests,
-				ResponsesEnabled: p.Settings.InterceptResponses,
+				RequestsEnabled:   p.Settings.InterceptRequests,
+				ResponsesEnabled:  p.Settings.InterceptResponses,
+				WebSocketsEnabled: p.Settings.InterceptWebSockets,
 			},
+			Proxy: parseProxySettings(proxy.Settings{
+				DowngradeHTTP2:       p.Settings.ProxyDowngradeHTTP2,
+				TLSPassthrough:       p.Settings.ProxyTLSPassthrough,
+				DisableDecompression: p.Settings.ProxyDisableDecompression,
+			}),
+			UpstreamProxy:     parseUpstreamProxySettings(p.Settings.UpstreamProxy),
+			MapRemoteRules:    parseMapRemoteRules(p.Settings.MapRemoteRules),
+			MapLocalRules:     parseMapLocalRules(p.Settings.MapLocalRules),
+			MatchReplaceRules: parseMatchReplaceRules(p.Settings.MatchReplaceRules),
+			Scripts:           parseScripts(p.Settings.Scripts),
+			ProxyModifiers:    parseProxyModifiers(p.Settings.ProxyModifiers),
+			ThrottleRules:     parseThrottleRules(p.Settings.ThrottleRules),
 		},
 	}
 
@@ -891,6 +2005,379 @@ func parseProject(projSvc *proj.Service, p proj.Project) Project {
 	return project
 }
 
+func parseMapRemoteRules(rules []maprule.RemoteRule) []MapRemoteRule {
+	mapRules := make([]MapRemoteRule, len(rules))
+
+	for i, rule := range rules {
+		mapRules[i] = MapRemoteRule{
+			ID:           rule.ID,
+			Name:         rule.Name,
+			Enabled:      rule.Enabled,
+			URL:          rule.URL.String(),
+			Scheme:       nonEmptyStringPtr(rule.Scheme),
+			Host:         nonEmptyStringPtr(rule.Host),
+			Port:         nonEmptyStringPtr(rule.Port),
+			Path:         nonEmptyStringPtr(rule.Path),
+			PreserveHost: rule.PreserveHost,
+		}
+	}
+
+	return mapRules
+}
+
+func parseMapLocalRules(rules []maprule.LocalRule) []MapLocalRule {
+	mapRules := make([]MapLocalRule, len(rules))
+
+	for i, rule := range rules {
+		mapRules[i] = MapLocalRule{
+			ID:         rule.ID,
+			Name:       rule.Name,
+			Enabled:    rule.Enabled,
+			URL:        rule.URL.String(),
+			FilePath:   nonEmptyStringPtr(rule.FilePath),
+			Body:       nonEmptyStringPtr(string(rule.Body)),
+			StatusCode: rule.StatusCode,
+			Headers:    make([]HTTPHeader, 0),
+		}
+
+		if mapRules[i].StatusCode == 0 {
+			mapRules[i].StatusCode = http.StatusOK
+		}
+
+		for key, values := range rule.Header {
+			for _, value := range values {
+				mapRules[i].Headers = append(mapRules[i].Headers, HTTPHeader{
+					Key:   key,
+					Value: value,
+				})
+			}
+		}
+
+		sort.Sort(HTTPHeaders(mapRules[i].Headers))
+	}
+
+	return mapRules
+}
+
+func parseMapRuleMatch(match maprule.Match) *MapRuleMatch {
+	ruleType := MapRuleTypeRemote
+	if match.Type == maprule.RuleTypeLocal {
+		ruleType = MapRuleTypeLocal
+	}
+
+	return &MapRuleMatch{
+		Type:        ruleType,
+		RuleID:      match.RuleID,
+		RuleName:    match.RuleName,
+		OriginalURL: match.OriginalURL,
+	}
+}
+
+func nonEmptyStringPtr(s string) *string {
+	if s == "" {
+		return nil
+	}
+
+	return &s
+}
+
+func parseMatchReplaceRules(rules []matchreplace.Rule) []MatchReplaceRule {
+	mrRules := make([]MatchReplaceRule, len(rules))
+
+	for i, rule := range rules {
+		mrRules[i] = MatchReplaceRule{
+			ID:       rule.ID,
+			Name:     rule.Name,
+			Enabled:  rule.Enabled,
+			Target:   matchReplaceTargetMap[rule.Target],
+			Match:    rule.Match,
+			IsRegexp: rule.IsRegexp,
+			Replace:  rule.Replace,
+		}
+
+		if rule.Condition != nil {
+			condition := rule.Condition.String()
+			mrRules[i].Condition = &condition
+		}
+	}
+
+	return mrRules
+}
+
+func parseProxyModifiers(modifiers []proxy.Modifier) []ProxyModifier {
+	parsed := make([]ProxyModifier, len(modifiers))
+
+	for i, mod := range modifiers {
+		parsed[i] = ProxyModifier{
+			Kind:    proxyModifierKindMap[mod.Kind],
+			Name:    mod.Name,
+			Enabled: mod.Enabled,
+		}
+	}
+
+	return parsed
+}
+
+func parseThrottleRules(rules []throttle.Rule) []ThrottleRule {
+	parsed := make([]ThrottleRule, len(rules))
+
+	for i, rule := range rules {
+		parsed[i] = ThrottleRule{
+			ID:         rule.ID,
+			Name:       rule.Name,
+			Enabled:    rule.Enabled,
+			Conditions: parseThrottleConditions(rule.Conditions),
+		}
+
+		if rule.Host != nil {
+			host := rule.Host.String()
+			parsed[i].Host = &host
+		}
+
+		if rule.Condition != nil {
+			condition := rule.Condition.String()
+			parsed[i].Condition = &condition
+		}
+	}
+
+	return parsed
+}
+
+func parseThrottleConditions(cond throttle.Conditions) *ThrottleConditions {
+	parsed := &ThrottleConditions{
+		Latency:                int(cond.Latency.Milliseconds()),
+		Jitter:                 int(cond.Jitter.Milliseconds()),
+		UploadBytesPerSecond:   int(cond.UploadBytesPerSecond),
+		DownloadBytesPerSecond: int(cond.DownloadBytesPerSecond),
+		FailureRate:            cond.FailureRate,
+	}
+
+	if failureMode, ok := throttleFailureModeMap[cond.FailureMode]; ok {
+		parsed.FailureMode = &failureMode
+	}
+
+	return parsed
+}
+
+func parseFindings(findings []plugin.Finding) []Finding {
+	parsed := make([]Finding, len(findings))
+
+	for i, f := range findings {
+		parsed[i] = Finding{
+			Plugin:   f.Plugin,
+			Title:    f.Title,
+			Severity: findingSeverityMap[f.Severity],
+		}
+
+		if f.Description != "" {
+			description := f.Description
+			parsed[i].Description = &description
+		}
+	}
+
+	return parsed
+}
+
+func parseScripts(scripts []script.Script) []Script {
+	parsed := make([]Script, len(scripts))
+
+	for i, s := range scripts {
+		parsed[i] = Script{
+			ID:      s.ID,
+			Name:    s.Name,
+			Enabled: s.Enabled,
+			Source:  s.Source,
+		}
+	}
+
+	return parsed
+}
+
+func parseOriginalRequest(orig matchreplace.Request, reqLog reqlog.RequestLog) HTTPOriginalRequest {
+	origReq := HTTPOriginalRequest{
+		Method:  HTTPMethod(orig.Method),
+		Proto:   orig.Proto,
+		Headers: parseHTTPHeaders(orig.Header),
+		RuleIDs: orig.RuleIDs,
+	}
+
+	if orig.URL != nil {
+		origReq.URL = orig.URL.String()
+	}
+
+	// The body is only kept if rules modified it.
+	body := reqLog.Body
+	if orig.BodyModified {
+		body = orig.Body
+	}
+
+	if len(body) > 0 {
+		bodyStr := string(body)
+		origReq.Body = &bodyStr
+	}
+
+	return origReq
+}
+
+func parseOriginalResponse(orig matchreplace.Response, resLog reqlog.ResponseLog) HTTPOriginalResponse {
+	origRes := HTTPOriginalResponse{
+		Proto:      httpProtocolMap[orig.Proto],
+		StatusCode: orig.StatusCode,
+		Headers:    parseHTTPHeaders(orig.Header),
+		RuleIDs:    orig.RuleIDs,
+	}
+
+	if statusReasonSubs := strings.SplitN(orig.Status, " ", 2); len(statusReasonSubs) == 2 {
+		origRes.StatusReason = statusReasonSubs[1]
+	}
+
+	// The body is only kept if rules modified it.
+	body := resLog.Body
+	if orig.BodyModified {
+		body = orig.Body
+	}
+
+	if len(body) > 0 {
+		bodyStr := string(body)
+		origRes.Body = &bodyStr
+	}
+
+	return origRes
+}
+
+func parseHTTPHeaders(header http.Header) []HTTPHeader {
+	headers := make([]HTTPHeader, 0, len(header))
+
+	for key, values := range header {
+		for _, value := range values {
+			headers = append(headers, HTTPHeader{
+				Key:   key,
+				Value: value,
+			})
+		}
+	}
+
+	sort.Sort(HTTPHeaders(headers))
+
+	return headers
+}
+
+func parseTLSInfo(tlsInfo reqlog.TLSInfo) *TLSConnectionInfo {
+	connInfo := &TLSConnectionInfo{
+		Version:          tlsInfo.Version,
+		CipherSuite:      tlsInfo.CipherSuite,
+		PeerCertificates: make([]TLSCertificateSummary, 0, len(tlsInfo.PeerCertificates)),
+	}
+
+	if tlsInfo.ALPN != "" {
+		alpn := tlsInfo.ALPN
+		connInfo.Alpn = &alpn
+	}
+
+	if tlsInfo.ServerName != "" {
+		serverName := tlsInfo.ServerName
+		connInfo.ServerName = &serverName
+	}
+
+	for _, cert := range tlsInfo.PeerCertificates {
+		dnsNames := cert.DNSNames
+		if dnsNames == nil {
+			dnsNames = []string{}
+		}
+
+		connInfo.PeerCertificates = append(connInfo.PeerCertificates, TLSCertificateSummary{
+			Subject:      cert.Subject,
+			Issuer:       cert.Issuer,
+			SerialNumber: cert.SerialNumber,
+			DNSNames:     dnsNames,
+			NotBefore:    cert.NotBefore,
+			NotAfter:     cert.NotAfter,
+		})
+	}
+
+	return connInfo
+}
+
+func durationMs(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
+
+func parseClientCertificate(cert clientcert.Certificate) (ClientCertificate, error) {
+	leaf, err := cert.Leaf()
+	if err != nil {
+		return ClientCertificate{}, fmt.Errorf("could not parse client certificate: %w", err)
+	}
+
+	return ClientCertificate{
+		ID:       cert.ID,
+		Name:     cert.Name,
+		Hosts:    cert.Hosts,
+		Subject:  leaf.Subject.String(),
+		Issuer:   leaf.Issuer.String(),
+		NotAfter: leaf.NotAfter,
+	}, nil
+}
+
+func parseRequestLogSettings(maxBodySize int64, fullTextIndex bool) *RequestLogSettings {
+	if maxBodySize <= 0 {
+		maxBodySize = reqlog.DefaultMaxBodySize
+	}
+
+	return &RequestLogSettings{
+		MaxBodySize:   int(maxBodySize),
+		FullTextIndex: fullTextIndex,
+	}
+}
+
+func parseProxySettings(settings proxy.Settings) *ProxySettings {
+	proxySettings := &ProxySettings{
+		DowngradeHTTP2:   settings.DowngradeHTTP2,
+		DecompressBodies: !settings.DisableDecompression,
+		TLSPassthrough:   make([]TLSPassthroughRule, len(settings.TLSPassthrough)),
+	}
+
+	for i, rule := range settings.TLSPassthrough {
+		if rule.Host != nil {
+			host := rule.Host.String()
+			proxySettings.TLSPassthrough[i].Host = &host
+		}
+
+		if rule.HostGlob != "" {
+			hostGlob := rule.HostGlob
+			proxySettings.TLSPassthrough[i].HostGlob = &hostGlob
+		}
+
+		if rule.Port != "" {
+			port := rule.Port
+			proxySettings.TLSPassthrough[i].Port = &port
+		}
+	}
+
+	return proxySettings
+}
+
+func parseUpstreamProxySettings(settings upstream.Settings) *UpstreamProxySettings {
+	if !settings.Enabled() {
+		return nil
+	}
+
+	upstreamProxy := &UpstreamProxySettings{
+		Type:   upstreamProxyTypeMap[settings.Type],
+		Addr:   settings.Addr,
+		Bypass: settings.Bypass,
+	}
+
+	if upstreamProxy.Bypass == nil {
+		upstreamProxy.Bypass = []string{}
+	}
+
+	if settings.Username != "" {
+		upstreamProxy.Username = &settings.Username
+	}
+
+	return upstreamProxy
+}
+
 func stringPtrToRegexp(s *string) (*regexp.Regexp, error) {
 	if s == nil {
 		return nil, nil
@@ -41,6 +41,11 @@ func DatabaseFromBoltDB(db *bolt.DB) (*Database, error) {
 		return nil, fmt.Errorf("bolt: failed to create projects bucket: %w", err)
 	}
 
+	err = db.Update(migrateIndexes)
+	if err != nil {
+		return nil, fmt.Errorf("bolt: failed to migrate indexes: %w", err)
+	}
+
 	return &Database{bolt: db}, nil
 }
 
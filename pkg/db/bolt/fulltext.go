@@ -0,0 +1,142 @@
+package bolt
+
+import (
+	"bytes"
+	"context"
+	"encoding/gob"
+	"fmt"
+	"strconv"
+
+	"github.com/oklog/ulid"
+	bolt "go.etcd.io/bbolt"
+
+	"github.com/dstotijn/hetty/pkg/reqlog"
+)
+
+// The full-text index maps tokens of request and response bodies to request log
+// IDs, with keys that consist of the token, a zero byte and the request log ID.
+// Like the secondary indexes, it only exists if it's complete. It's optional,
+// because it's considerably larger than the other indexes. The query planner
+// only uses it for body search terms (e.g. `body:token`), see
+// reqlog.BodySearchPrefix.
+
+var reqLogFullTextBucketName = []byte("request_log_fulltext")
+
+// fullTextVersion must be incremented when tokenization changes, so that the
+// full-text index is rebuilt when it's enabled for a project.
+const fullTextVersion = 2
+
+// SetFullTextIndexEnabled builds the full-text index of request logs for a
+// project, or deletes it. Building is skipped if an up to date index exists.
+func (db *Database) SetFullTextIndexEnabled(ctx context.Context, projectID ulid.ULID, enabled bool) error {
+	err := db.bolt.Update(func(tx *bolt.Tx) error {
+		pb, err := projectBucket(tx, projectID[:])
+		if err != nil {
+			return fmt.Errorf("failed to get project bucket: %w", err)
+		}
+
+		fb := pb.Bucket(reqLogFullTextBucketName)
+
+		if !enabled {
+			if fb == nil {
+				return nil
+			}
+
+			return pb.DeleteBucket(reqLogFullTextBucketName)
+		}
+
+		if fb != nil && string(fb.Get(indexVersionKey)) == strconv.Itoa(fullTextVersion) {
+			return nil
+		}
+
+		return rebuildFullTextIndex(ctx, pb)
+	})
+	if err != nil {
+		return fmt.Errorf("bolt: failed to set full-text index: %w", err)
+	}
+
+	return nil
+}
+
+func rebuildFullTextIndex(ctx context.Context, pb *bolt.Bucket) error {
+	if pb.Bucket(reqLogFullTextBucketName) != nil {
+		err := pb.DeleteBucket(reqLogFullTextBucketName)
+		if err != nil {
+			return err
+		}
+	}
+
+	fb, err := pb.CreateBucket(reqLogFullTextBucketName)
+	if err != nil {
+		return err
+	}
+
+	if b := pb.Bucket(reqLogsBucketName); b != nil {
+		err = b.ForEach(func(k, v []byte) error {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+
+			var reqLog reqlog.RequestLog
+
+			err := gob.NewDecoder(bytes.NewReader(v)).Decode(&reqLog)
+			if err != nil {
+				return fmt.Errorf("failed to decode request log (id: %x): %w", k, err)
+			}
+
+			return putFullTextEntries(fb, reqLog)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return fb.Put(indexVersionKey, []byte(strconv.Itoa(fullTextVersion)))
+}
+
+// clearFullTextIndex empties the full-text index, if it exists.
+func clearFullTextIndex(pb *bolt.Bucket) error {
+	if pb.Bucket(reqLogFullTextBucketName) == nil {
+		return nil
+	}
+
+	return rebuildFullTextIndex(context.Background(), pb)
+}
+
+// updateFullTextIndex replaces the full-text index entries of a request log
+// that's about to be stored, if the project has a full-text index.
+func updateFullTextIndex(pb *bolt.Bucket, old []byte, reqLog reqlog.RequestLog) error {
+	fb := pb.Bucket(reqLogFullTextBucketName)
+	if fb == nil {
+		return nil
+	}
+
+	if old != nil {
+		var oldReqLog reqlog.RequestLog
+
+		err := gob.NewDecoder(bytes.NewReader(old)).Decode(&oldReqLog)
+		if err != nil {
+			return fmt.Errorf("failed to decode request log: %w", err)
+		}
+
+		for _, token := range oldReqLog.BodyTokens() {
+			err := fb.Delete(indexKey(token, oldReqLog.ID))
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return putFullTextEntries(fb, reqLog)
+}
+
+func putFullTextEntries(fb *bolt.Bucket, reqLog reqlog.RequestLog) error {
+	for _, token := range reqLog.BodyTokens() {
+		err := fb.Put(indexKey(token, reqLog.ID), []byte{})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
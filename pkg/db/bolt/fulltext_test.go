@@ -0,0 +1,195 @@
+package bolt_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/oklog/ulid"
+	"go.etcd.io/bbolt"
+
+	"github.com/dstotijn/hetty/pkg/db/bolt"
+	"github.com/dstotijn/hetty/pkg/filter"
+	"github.com/dstotijn/hetty/pkg/proj"
+	"github.com/dstotijn/hetty/pkg/reqlog"
+)
+
+func TestFullTextIndex(t *testing.T) {
+	t.Parallel()
+
+	path := t.TempDir() + "bolt.db"
+	boltDB, err := bbolt.Open(path, 0o600, nil)
+	if err != nil {
+		t.Fatalf("failed to open bolt database: %v", err)
+	}
+
+	db, err := bolt.DatabaseFromBoltDB(boltDB)
+	if err != nil {
+		t.Fatalf("failed to create database: %v", err)
+	}
+	defer db.Close()
+
+	projectID := ulid.MustNew(ulid.Timestamp(time.Now()), ulidEntropy)
+
+	err = db.UpsertProject(context.Background(), proj.Project{ID: projectID})
+	if err != nil {
+		t.Fatalf("unexpected error upserting project: %v", err)
+	}
+
+	newReqLog := func(i int, method, body string) reqlog.RequestLog {
+		return reqlog.RequestLog{
+			ID:        ulid.MustNew(ulid.Timestamp(time.Now())+uint64(i), ulidEntropy),
+			ProjectID: projectID,
+			URL:       mustParseURL(t, "https://example.com/"),
+			Method:    method,
+			Header:    http.Header{"Content-Type": []string{"application/json"}},
+			Body:      []byte(body),
+		}
+	}
+
+	fixtures := []reqlog.RequestLog{
+		newReqLog(0, http.MethodPost, `{"user": "Alice Smith"}`),
+		newReqLog(1, http.MethodGet, ""),
+		newReqLog(2, http.MethodPost, `{"user": "Bob", "token": "s3cret"}`),
+	}
+
+	// Request logs stored before the index is enabled must be indexed too.
+	for _, reqLog := range fixtures[:2] {
+		err = db.StoreRequestLog(context.Background(), reqLog)
+		if err != nil {
+			t.Fatalf("unexpected error creating request log fixture: %v", err)
+		}
+	}
+
+	err = db.SetFullTextIndexEnabled(context.Background(), projectID, true)
+	if err != nil {
+		t.Fatalf("unexpected error enabling full-text index: %v", err)
+	}
+
+	err = db.StoreRequestLog(context.Background(), fixtures[2])
+	if err != nil {
+		t.Fatalf("unexpected error creating request log fixture: %v", err)
+	}
+
+	resLog := reqlog.ResponseLog{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": []string{"text/html"}},
+		Body:       []byte("<p>Welcome, Alice</p>"),
+	}
+	fixtures[1].Response = &resLog
+
+	err = db.StoreResponseLog(context.Background(), projectID, fixtures[1].ID, resLog)
+	if err != nil {
+		t.Fatalf("unexpected error storing response log: %v", err)
+	}
+
+	queries := []string{
+		"body:alice",
+		`"body:Alice Smith"`,
+		"body:welcome",
+		"body:s3cret",
+		"body:tok",
+		"body:alice AND req.method = POST",
+		"body:bob OR body:welcome",
+		"NOT body:alice",
+		`body:alice AND req.body =~ "Smith"`,
+	}
+
+	assertResults := func(t *testing.T) {
+		t.Helper()
+
+		for _, query := range queries {
+			expr, err := filter.ParseQuery(query)
+			if err != nil {
+				t.Fatalf("unexpected error parsing query: %v", err)
+			}
+
+			var exp []ulid.ULID
+
+			for i := len(fixtures) - 1; i >= 0; i-- {
+				match, err := fixtures[i].Matches(expr)
+				if err != nil {
+					t.Fatalf("unexpected error matching fixture: %v", err)
+				}
+
+				if match {
+					exp = append(exp, fixtures[i].ID)
+				}
+			}
+
+			reqLogs, err := db.FindRequestLogs(context.Background(), reqlog.FindRequestsFilter{
+				ProjectID:  projectID,
+				SearchExpr: expr,
+			}, nil)
+			if err != nil {
+				t.Fatalf("unexpected error finding request logs: %v", err)
+			}
+
+			var got []ulid.ULID
+			for _, reqLog := range reqLogs {
+				got = append(got, reqLog.ID)
+			}
+
+			if diff := cmp.Diff(exp, got); diff != "" {
+				t.Errorf("request log IDs for query %q not equal (-exp, +got):\n%v", query, diff)
+			}
+		}
+	}
+
+	fullTextKeyN := func() int {
+		n := -1
+
+		err := boltDB.View(func(tx *bbolt.Tx) error {
+			if fb := tx.Bucket([]byte("projects")).Bucket(projectID[:]).Bucket([]byte("request_log_fulltext")); fb != nil {
+				n = fb.Stats().KeyN
+			}
+
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("unexpected error reading full-text index: %v", err)
+		}
+
+		return n
+	}
+
+	t.Run("enabled", func(t *testing.T) {
+		assertResults(t)
+
+		if n := fullTextKeyN(); n <= 1 {
+			t.Errorf("expected full-text index entries, got %v keys", n)
+		}
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		err := db.SetFullTextIndexEnabled(context.Background(), projectID, false)
+		if err != nil {
+			t.Fatalf("unexpected error disabling full-text index: %v", err)
+		}
+
+		if n := fullTextKeyN(); n != -1 {
+			t.Errorf("expected full-text index to be deleted, got %v keys", n)
+		}
+
+		assertResults(t)
+	})
+
+	t.Run("cleared", func(t *testing.T) {
+		err := db.SetFullTextIndexEnabled(context.Background(), projectID, true)
+		if err != nil {
+			t.Fatalf("unexpected error enabling full-text index: %v", err)
+		}
+
+		err = db.ClearRequestLogs(context.Background(), projectID)
+		if err != nil {
+			t.Fatalf("unexpected error clearing request logs: %v", err)
+		}
+
+		// Only the version key remains.
+		if n := fullTextKeyN(); n != 1 {
+			t.Errorf("expected empty full-text index, got %v keys", n)
+		}
+	})
+}
@@ -0,0 +1,650 @@
+package bolt
+
+import (
+	"bytes"
+	"context"
+	"encoding/gob"
+	"fmt"
+	"regexp"
+	"regexp/syntax"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/oklog/ulid"
+	bolt "go.etcd.io/bbolt"
+
+	"github.com/dstotijn/hetty/pkg/filter"
+	"github.com/dstotijn/hetty/pkg/reqlog"
+	"github.com/dstotijn/hetty/pkg/sender"
+)
+
+// Secondary indexes map values of search keys to the IDs of request logs and
+// sender requests, so that filter expressions can be evaluated without
+// decoding every record. Each index is a bucket with keys that consist of the
+// value, a zero byte and the record ID. A project's index bucket only exists
+// if it's complete, otherwise queries fall back to a full scan.
+
+var (
+	reqLogIndexesBucketName    = []byte("request_log_indexes")
+	senderReqIndexesBucketName = []byte("sender_request_indexes")
+	indexVersionKey            = []byte("version")
+)
+
+// indexVersion must be incremented when indexed fields change, so that indexes
+// are rebuilt when the database is opened.
+const indexVersion = 1
+
+// maxIndexValueSize is the maximum size of indexed values. Longer values are
+// truncated, so that prefix lookups still find them.
+const maxIndexValueSize = 1024
+
+type index struct {
+	name      []byte
+	searchKey string
+}
+
+var indexes = []index{
+	{name: []byte("host"), searchKey: "req.host"},
+	{name: []byte("method"), searchKey: "req.method"},
+	{name: []byte("path"), searchKey: "req.path"},
+	{name: []byte("status_code"), searchKey: "res.statusCode"},
+	{name: []byte("content_type"), searchKey: "res.contentType"},
+}
+
+type searchValueFunc func(key string) (string, bool)
+
+// indexedRecords describes a bucket of records and its indexes.
+type indexedRecords struct {
+	bucketName        []byte
+	indexesBucketName []byte
+	decode            func(raw []byte) (searchValueFunc, error)
+}
+
+var (
+	reqLogRecords = indexedRecords{
+		bucketName:        reqLogsBucketName,
+		indexesBucketName: reqLogIndexesBucketName,
+		decode: func(raw []byte) (searchValueFunc, error) {
+			var reqLog reqlog.RequestLog
+
+			err := gob.NewDecoder(bytes.NewReader(raw)).Decode(&reqLog)
+			if err != nil {
+				return nil, fmt.Errorf("failed to decode request log: %w", err)
+			}
+
+			return reqLog.SearchValue, nil
+		},
+	}
+	senderReqRecords = indexedRecords{
+		bucketName:        senderReqsBucketName,
+		indexesBucketName: senderReqIndexesBucketName,
+		decode: func(raw []byte) (searchValueFunc, error) {
+			var req sender.Request
+
+			err := gob.NewDecoder(bytes.NewReader(raw)).Decode(&req)
+			if err != nil {
+				return nil, fmt.Errorf("failed to decode sender request: %w", err)
+			}
+
+			return req.SearchValue, nil
+		},
+	}
+)
+
+// migrateIndexes builds missing or outdated indexes for all projects.
+func migrateIndexes(tx *bolt.Tx) error {
+	pb, err := projectsBucket(tx)
+	if err != nil {
+		return err
+	}
+
+	return pb.ForEachBucket(func(projectID []byte) error {
+		err := ensureIndexes(pb.Bucket(projectID))
+		if err != nil {
+			return fmt.Errorf("project (id: %x): %w", projectID, err)
+		}
+
+		return nil
+	})
+}
+
+func ensureIndexes(pb *bolt.Bucket) error {
+	for _, records := range []indexedRecords{reqLogRecords, senderReqRecords} {
+		ib := pb.Bucket(records.indexesBucketName)
+		if ib != nil && string(ib.Get(indexVersionKey)) == strconv.Itoa(indexVersion) {
+			continue
+		}
+
+		err := records.rebuild(pb)
+		if err != nil {
+			return fmt.Errorf("failed to build %s: %w", records.indexesBucketName, err)
+		}
+	}
+
+	return nil
+}
+
+func (records indexedRecords) rebuild(pb *bolt.Bucket) error {
+	if pb.Bucket(records.indexesBucketName) != nil {
+		err := pb.DeleteBucket(records.indexesBucketName)
+		if err != nil {
+			return err
+		}
+	}
+
+	ib, err := pb.CreateBucket(records.indexesBucketName)
+	if err != nil {
+		return err
+	}
+
+	for _, idx := range indexes {
+		_, err := ib.CreateBucket(idx.name)
+		if err != nil {
+			return err
+		}
+	}
+
+	if b := pb.Bucket(records.bucketName); b != nil {
+		err = b.ForEach(func(k, v []byte) error {
+			searchValue, err := records.decode(v)
+			if err != nil {
+				return err
+			}
+
+			var id ulid.ULID
+			copy(id[:], k)
+
+			return putIndexEntries(ib, id, searchValue)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return ib.Put(indexVersionKey, []byte(strconv.Itoa(indexVersion)))
+}
+
+// update replaces the index entries of a record that's about to be stored. The
+// raw record that's currently stored, if any, is used to delete stale entries.
+func (records indexedRecords) update(pb *bolt.Bucket, id ulid.ULID, old []byte, searchValue searchValueFunc) error {
+	ib := pb.Bucket(records.indexesBucketName)
+	if ib == nil {
+		return nil
+	}
+
+	if old != nil {
+		oldSearchValue, err := records.decode(old)
+		if err != nil {
+			return err
+		}
+
+		err = deleteIndexEntries(ib, id, oldSearchValue)
+		if err != nil {
+			return fmt.Errorf("failed to delete index entries: %w", err)
+		}
+	}
+
+	err := putIndexEntries(ib, id, searchValue)
+	if err != nil {
+		return fmt.Errorf("failed to put index entries: %w", err)
+	}
+
+	return nil
+}
+
+func indexKey(value string, id ulid.ULID) []byte {
+	if len(value) > maxIndexValueSize {
+		value = value[:maxIndexValueSize]
+	}
+
+	key := make([]byte, 0, len(value)+1+len(id))
+	key = append(key, value...)
+	key = append(key, 0)
+
+	return append(key, id[:]...)
+}
+
+func putIndexEntries(ib *bolt.Bucket, id ulid.ULID, searchValue searchValueFunc) error {
+	for _, idx := range indexes {
+		value, _ := searchValue(idx.searchKey)
+		if value == "" {
+			continue
+		}
+
+		b := ib.Bucket(idx.name)
+		if b == nil {
+			return fmt.Errorf("index bucket %q not found", idx.name)
+		}
+
+		err := b.Put(indexKey(value, id), []byte{})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func deleteIndexEntries(ib *bolt.Bucket, id ulid.ULID, searchValue searchValueFunc) error {
+	for _, idx := range indexes {
+		value, _ := searchValue(idx.searchKey)
+		if value == "" {
+			continue
+		}
+
+		b := ib.Bucket(idx.name)
+		if b == nil {
+			return fmt.Errorf("index bucket %q not found", idx.name)
+		}
+
+		err := b.Delete(indexKey(value, id))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// queryPlan is the result of planning a filter expression. If indexed is true,
+// only the records in `ids` (sorted) can match, and `residual` is the part of
+// the expression that must still be evaluated for them. Otherwise, all records
+// must be scanned and the full expression evaluated.
+type queryPlan struct {
+	indexed  bool
+	ids      []ulid.ULID
+	residual filter.Expression
+}
+
+type idSet map[ulid.ULID]struct{}
+
+// planner plans filter expressions using a project's secondary indexes and
+// full-text index. Either may be nil, if it doesn't exist.
+type planner struct {
+	indexes  *bolt.Bucket
+	fullText *bolt.Bucket
+}
+
+// plan turns the indexable parts of a filter expression into index lookups.
+// Equality comparisons, regular expressions with a literal prefix anchored at
+// the start (e.g. `req.path =~ "^/api/"`) and body search terms are indexable,
+// which can be combined with AND and OR.
+func (p planner) plan(expr filter.Expression) queryPlan {
+	if expr == nil {
+		return queryPlan{}
+	}
+
+	set, ok, residual := p.planExpr(expr)
+	if !ok {
+		return queryPlan{residual: expr}
+	}
+
+	ids := make([]ulid.ULID, 0, len(set))
+	for id := range set {
+		ids = append(ids, id)
+	}
+
+	sort.Slice(ids, func(i, j int) bool {
+		return ids[i].Compare(ids[j]) < 0
+	})
+
+	return queryPlan{indexed: true, ids: ids, residual: residual}
+}
+
+func (p planner) planExpr(expr filter.Expression) (idSet, bool, filter.Expression) {
+	if lit, ok := expr.(filter.StringLiteral); ok {
+		return p.planBodyTerms(lit)
+	}
+
+	infix, ok := expr.(filter.InfixExpression)
+	if !ok {
+		return nil, false, expr
+	}
+
+	switch infix.Operator {
+	case filter.TokOpAnd:
+		leftIDs, leftOK, leftResidual := p.planExpr(infix.Left)
+		rightIDs, rightOK, rightResidual := p.planExpr(infix.Right)
+		residual := andExpr(leftResidual, rightResidual)
+
+		switch {
+		case leftOK && rightOK:
+			return intersectIDs(leftIDs, rightIDs), true, residual
+		case leftOK:
+			return leftIDs, true, residual
+		case rightOK:
+			return rightIDs, true, residual
+		}
+
+		return nil, false, expr
+	case filter.TokOpOr:
+		leftIDs, leftOK, leftResidual := p.planExpr(infix.Left)
+		rightIDs, rightOK, rightResidual := p.planExpr(infix.Right)
+
+		if !leftOK || !rightOK {
+			return nil, false, expr
+		}
+
+		for id := range rightIDs {
+			leftIDs[id] = struct{}{}
+		}
+
+		// A residual of one operand can't be evaluated on its own, because
+		// the other operand may match instead.
+		if leftResidual != nil || rightResidual != nil {
+			return leftIDs, true, expr
+		}
+
+		return leftIDs, true, nil
+	}
+
+	return p.planComparison(infix)
+}
+
+func (p planner) planComparison(expr filter.InfixExpression) (idSet, bool, filter.Expression) {
+	left, ok := expr.Left.(filter.StringLiteral)
+	if !ok || p.indexes == nil {
+		return nil, false, expr
+	}
+
+	b := indexBucket(p.indexes, left.Value)
+	if b == nil {
+		return nil, false, expr
+	}
+
+	switch expr.Operator {
+	case filter.TokOpEq:
+		right, ok := expr.Right.(filter.StringLiteral)
+		// Search keys on the right hand side are resolved to values of the
+		// record, so they can't be looked up.
+		if !ok || right.Value == "" || len(right.Value) >= maxIndexValueSize || isSearchKey(right.Value) {
+			return nil, false, expr
+		}
+
+		return lookupIndex(b, append([]byte(right.Value), 0), true), true, nil
+	case filter.TokOpRe:
+		right, ok := expr.Right.(filter.RegexpLiteral)
+		if !ok {
+			return nil, false, expr
+		}
+
+		prefix, complete := anchoredLiteralPrefix(right.Regexp)
+		if prefix == "" || len(prefix) > maxIndexValueSize {
+			return nil, false, expr
+		}
+
+		ids := lookupIndex(b, []byte(prefix), false)
+		if complete {
+			return ids, true, nil
+		}
+
+		return ids, true, expr
+	}
+
+	return nil, false, expr
+}
+
+// planBodyTerms looks up the tokens of body search terms (e.g. `body:token`)
+// in the full-text index. Records match if they contain all tokens. Other
+// terms and `req.body` or `res.body` comparisons can't be answered by tokens,
+// so they're left as residual expressions.
+func (p planner) planBodyTerms(lit filter.StringLiteral) (idSet, bool, filter.Expression) {
+	terms, ok := strings.CutPrefix(lit.Value, reqlog.BodySearchPrefix)
+	if !ok || p.fullText == nil {
+		return nil, false, lit
+	}
+
+	tokens := reqlog.TokenizeQuery(terms)
+	if len(tokens) == 0 {
+		return nil, false, lit
+	}
+
+	var ids idSet
+
+	for _, token := range tokens {
+		tokenIDs := lookupIndex(p.fullText, append([]byte(token), 0), true)
+
+		if ids == nil {
+			ids = tokenIDs
+		} else {
+			ids = intersectIDs(ids, tokenIDs)
+		}
+	}
+
+	return ids, true, nil
+}
+
+func indexBucket(ib *bolt.Bucket, searchKey string) *bolt.Bucket {
+	for _, idx := range indexes {
+		if idx.searchKey == searchKey {
+			return ib.Bucket(idx.name)
+		}
+	}
+
+	return nil
+}
+
+// lookupIndex returns the IDs of index keys that start with `prefix`. If exact
+// is true, the prefix must be followed by the ID only.
+func lookupIndex(b *bolt.Bucket, prefix []byte, exact bool) idSet {
+	ids := make(idSet)
+	c := b.Cursor()
+
+	for k, _ := c.Seek(prefix); k != nil && bytes.HasPrefix(k, prefix); k, _ = c.Next() {
+		if exact && len(k) != len(prefix)+len(ulid.ULID{}) {
+			continue
+		}
+
+		var id ulid.ULID
+		copy(id[:], k[len(k)-len(id):])
+		ids[id] = struct{}{}
+	}
+
+	return ids
+}
+
+// anchoredLiteralPrefix returns the literal that a regular expression must
+// start with, if it's anchored at the start of the text. The boolean is true if
+// the regular expression consists of just the anchor and the literal.
+func anchoredLiteralPrefix(re *regexp.Regexp) (string, bool) {
+	if re == nil {
+		return "", false
+	}
+
+	parsed, err := syntax.Parse(re.String(), syntax.Perl)
+	if err != nil {
+		return "", false
+	}
+
+	parsed = parsed.Simplify()
+
+	if parsed.Op != syntax.OpConcat || len(parsed.Sub) < 2 || parsed.Sub[0].Op != syntax.OpBeginText {
+		return "", false
+	}
+
+	lit := parsed.Sub[1]
+	if lit.Op != syntax.OpLiteral || lit.Flags&syntax.FoldCase != 0 {
+		return "", false
+	}
+
+	return string(lit.Rune), len(parsed.Sub) == 2
+}
+
+func isSearchKey(s string) bool {
+	return strings.HasPrefix(s, "req.") || strings.HasPrefix(s, "res.")
+}
+
+func andExpr(left, right filter.Expression) filter.Expression {
+	switch {
+	case left == nil:
+		return right
+	case right == nil:
+		return left
+	}
+
+	return filter.InfixExpression{
+		Operator: filter.TokOpAnd,
+		Left:     left,
+		Right:    right,
+	}
+}
+
+func intersectIDs(a, b idSet) idSet {
+	if len(b) < len(a) {
+		a, b = b, a
+	}
+
+	ids := make(idSet, len(a))
+
+	for id := range a {
+		if _, ok := b[id]; ok {
+			ids[id] = struct{}{}
+		}
+	}
+
+	return ids
+}
+
+// recordCursor iterates over the records of a bucket, in ascending or
+// descending key order, starting after a given ID. If the query plan is
+// indexed, only records with IDs from the plan are visited.
+type recordCursor struct {
+	b     *bolt.Bucket
+	c     *bolt.Cursor
+	plan  queryPlan
+	after ulid.ULID
+	desc  bool
+	pos   int
+}
+
+func newRecordCursor(b *bolt.Bucket, plan queryPlan, after ulid.ULID, desc bool) *recordCursor {
+	return &recordCursor{
+		b:     b,
+		c:     b.Cursor(),
+		plan:  plan,
+		after: after,
+		desc:  desc,
+	}
+}
+
+func (rc *recordCursor) first() (key, value []byte) {
+	if !rc.plan.indexed {
+		return seekAfter(rc.c, rc.after, rc.desc)
+	}
+
+	ids := rc.plan.ids
+	zero := rc.after.Compare(ulid.ULID{}) == 0
+
+	switch {
+	case rc.desc && zero:
+		rc.pos = len(ids) - 1
+	case rc.desc:
+		rc.pos = sort.Search(len(ids), func(i int) bool { return ids[i].Compare(rc.after) >= 0 }) - 1
+	case zero:
+		rc.pos = 0
+	default:
+		rc.pos = sort.Search(len(ids), func(i int) bool { return ids[i].Compare(rc.after) > 0 })
+	}
+
+	return rc.current()
+}
+
+// seekAfter positions the cursor on the first key after `after` in the given
+// direction.
+func seekAfter(c *bolt.Cursor, after ulid.ULID, desc bool) (key, value []byte) {
+	zero := after.Compare(ulid.ULID{}) == 0
+
+	if !desc {
+		if zero {
+			return c.First()
+		}
+
+		key, value = c.Seek(after[:])
+		if key != nil && bytes.Equal(key, after[:]) {
+			return c.Next()
+		}
+
+		return key, value
+	}
+
+	if zero {
+		return c.Last()
+	}
+
+	// Seek positions the cursor on the first key equal to or greater than
+	// `after`, so the key before it is the first one that sorts lower.
+	if key, _ = c.Seek(after[:]); key == nil {
+		return c.Last()
+	}
+
+	return c.Prev()
+}
+
+func (rc *recordCursor) next() (key, value []byte) {
+	if !rc.plan.indexed {
+		if rc.desc {
+			return rc.c.Prev()
+		}
+
+		return rc.c.Next()
+	}
+
+	rc.advance()
+
+	return rc.current()
+}
+
+func (rc *recordCursor) advance() {
+	if rc.desc {
+		rc.pos--
+	} else {
+		rc.pos++
+	}
+}
+
+// current returns the record at the cursor position, skipping IDs that no
+// longer exist.
+func (rc *recordCursor) current() (key, value []byte) {
+	for ; rc.pos >= 0 && rc.pos < len(rc.plan.ids); rc.advance() {
+		key := rc.plan.ids[rc.pos][:]
+		if value := rc.b.Get(key); value != nil {
+			return key, value
+		}
+	}
+
+	return nil, nil
+}
+
+// countMatches counts the records that match a query plan. If match is nil,
+// all records in the plan match and none have to be decoded.
+func countMatches(ctx context.Context, b *bolt.Bucket, plan queryPlan, match func(v []byte) (bool, error)) (int, error) {
+	if match == nil {
+		if plan.indexed {
+			return len(plan.ids), nil
+		}
+
+		return b.Stats().KeyN, nil
+	}
+
+	var n int
+
+	rc := newRecordCursor(b, plan, ulid.ULID{}, false)
+
+	for k, v := rc.first(); k != nil; k, v = rc.next() {
+		if err := ctx.Err(); err != nil {
+			return 0, err
+		}
+
+		ok, err := match(v)
+		if err != nil {
+			return 0, fmt.Errorf("record (id: %x): %w", k, err)
+		}
+
+		if ok {
+			n++
+		}
+	}
+
+	return n, nil
+}
@@ -0,0 +1,413 @@
+package bolt_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/oklog/ulid"
+	"go.etcd.io/bbolt"
+
+	"github.com/dstotijn/hetty/pkg/db/bolt"
+	"github.com/dstotijn/hetty/pkg/filter"
+	"github.com/dstotijn/hetty/pkg/proj"
+	"github.com/dstotijn/hetty/pkg/reqlog"
+	"github.com/dstotijn/hetty/pkg/sender"
+)
+
+func indexFixtures(t *testing.T, projectID ulid.ULID) []reqlog.RequestLog {
+	t.Helper()
+
+	newID := func(i int) ulid.ULID {
+		return ulid.MustNew(ulid.Timestamp(time.Now())+uint64(i), ulidEntropy)
+	}
+
+	response := func(statusCode int, contentType string) *reqlog.ResponseLog {
+		return &reqlog.ResponseLog{
+			Proto:      "HTTP/1.1",
+			StatusCode: statusCode,
+			Status:     http.StatusText(statusCode),
+			Header:     http.Header{"Content-Type": []string{contentType}},
+		}
+	}
+
+	return []reqlog.RequestLog{
+		{
+			ID:        newID(0),
+			ProjectID: projectID,
+			URL:       mustParseURL(t, "https://example.com/api/users"),
+			Method:    http.MethodGet,
+			Response:  response(200, "application/json; charset=utf-8"),
+		},
+		{
+			ID:        newID(1),
+			ProjectID: projectID,
+			URL:       mustParseURL(t, "https://example.com/api/users"),
+			Method:    http.MethodPost,
+			Response:  response(201, "application/json"),
+		},
+		{
+			ID:        newID(2),
+			ProjectID: projectID,
+			URL:       mustParseURL(t, "https://example.com/index.html"),
+			Method:    http.MethodGet,
+			Response:  response(404, "text/html"),
+		},
+		{
+			ID:        newID(3),
+			ProjectID: projectID,
+			URL:       mustParseURL(t, "http://api.example.org:8080/api/v2/items?x=1"),
+			Method:    http.MethodGet,
+			Response:  response(200, "Application/JSON"),
+		},
+		{
+			ID:        newID(4),
+			ProjectID: projectID,
+			URL:       mustParseURL(t, "http://api.example.org:8080/apiary"),
+			Method:    http.MethodDelete,
+		},
+	}
+}
+
+func TestIndexedSearch(t *testing.T) {
+	t.Parallel()
+
+	path := t.TempDir() + "bolt.db"
+	boltDB, err := bbolt.Open(path, 0o600, nil)
+	if err != nil {
+		t.Fatalf("failed to open bolt database: %v", err)
+	}
+
+	db, err := bolt.DatabaseFromBoltDB(boltDB)
+	if err != nil {
+		t.Fatalf("failed to create database: %v", err)
+	}
+	defer db.Close()
+
+	projectID := ulid.MustNew(ulid.Timestamp(time.Now()), ulidEntropy)
+
+	err = db.UpsertProject(context.Background(), proj.Project{ID: projectID})
+	if err != nil {
+		t.Fatalf("unexpected error upserting project: %v", err)
+	}
+
+	fixtures := indexFixtures(t, projectID)
+
+	for _, reqLog := range fixtures {
+		err = db.StoreRequestLog(context.Background(), reqLog)
+		if err != nil {
+			t.Fatalf("unexpected error creating request log fixture: %v", err)
+		}
+
+		err = db.StoreSenderRequest(context.Background(), sender.Request{
+			ID:        reqLog.ID,
+			ProjectID: projectID,
+			URL:       reqLog.URL,
+			Method:    reqLog.Method,
+			Response:  reqLog.Response,
+		})
+		if err != nil {
+			t.Fatalf("unexpected error creating sender request fixture: %v", err)
+		}
+	}
+
+	queries := []string{
+		`req.method = GET`,
+		`req.host = "example.com"`,
+		`req.host = "api.example.org" AND req.method = GET`,
+		`req.method = POST OR req.method = DELETE`,
+		`res.statusCode = 200`,
+		`res.statusCode = 200 AND req.path =~ "^/api/v2"`,
+		`res.contentType = "application/json"`,
+		`req.path =~ "^/api"`,
+		`req.path =~ "^/api/"`,
+		`req.path =~ "^/api/.*s$"`,
+		`req.path =~ "(?i)^/API"`,
+		`req.method = GET AND NOT (res.statusCode = 404)`,
+		`req.method = GET OR req.url =~ "apiary"`,
+		`(req.method = GET OR req.path =~ "^/apiary$") AND req.host = "api.example.org"`,
+		`req.method = req.method`,
+		`req.method = PUT`,
+		`req.method != GET`,
+		`res.statusCode = ""`,
+	}
+
+	for _, query := range queries {
+		t.Run(query, func(t *testing.T) {
+			expr, err := filter.ParseQuery(query)
+			if err != nil {
+				t.Fatalf("unexpected error parsing query: %v", err)
+			}
+
+			var expReqLogIDs, expSenderReqIDs []ulid.ULID
+
+			// Results are returned newest first.
+			for i := len(fixtures) - 1; i >= 0; i-- {
+				match, err := fixtures[i].Matches(expr)
+				if err != nil {
+					t.Fatalf("unexpected error matching fixture: %v", err)
+				}
+
+				if match {
+					expReqLogIDs = append(expReqLogIDs, fixtures[i].ID)
+					expSenderReqIDs = append(expSenderReqIDs, fixtures[i].ID)
+				}
+			}
+
+			page, err := db.FindRequestLogsPage(context.Background(), reqlog.FindRequestsFilter{
+				ProjectID:  projectID,
+				SearchExpr: expr,
+			}, nil, reqlog.PageOptions{CountTotal: true})
+			if err != nil {
+				t.Fatalf("unexpected error finding request logs: %v", err)
+			}
+
+			var gotReqLogIDs []ulid.ULID
+			for _, reqLog := range page.RequestLogs {
+				gotReqLogIDs = append(gotReqLogIDs, reqLog.ID)
+			}
+
+			if diff := cmp.Diff(expReqLogIDs, gotReqLogIDs); diff != "" {
+				t.Errorf("request log IDs not equal (-exp, +got):\n%v", diff)
+			}
+
+			if page.TotalCount != len(expReqLogIDs) {
+				t.Errorf("expected total count %v, got: %v", len(expReqLogIDs), page.TotalCount)
+			}
+
+			senderReqs, err := db.FindSenderRequests(context.Background(), sender.FindRequestsFilter{
+				ProjectID:  projectID,
+				SearchExpr: expr,
+			}, nil)
+			if err != nil {
+				t.Fatalf("unexpected error finding sender requests: %v", err)
+			}
+
+			var gotSenderReqIDs []ulid.ULID
+			for _, req := range senderReqs {
+				gotSenderReqIDs = append(gotSenderReqIDs, req.ID)
+			}
+
+			if diff := cmp.Diff(expSenderReqIDs, gotSenderReqIDs); diff != "" {
+				t.Errorf("sender request IDs not equal (-exp, +got):\n%v", diff)
+			}
+		})
+	}
+}
+
+func TestIndexedSearchAfterResponseLog(t *testing.T) {
+	t.Parallel()
+
+	path := t.TempDir() + "bolt.db"
+	boltDB, err := bbolt.Open(path, 0o600, nil)
+	if err != nil {
+		t.Fatalf("failed to open bolt database: %v", err)
+	}
+
+	db, err := bolt.DatabaseFromBoltDB(boltDB)
+	if err != nil {
+		t.Fatalf("failed to create database: %v", err)
+	}
+	defer db.Close()
+
+	projectID := ulid.MustNew(ulid.Timestamp(time.Now()), ulidEntropy)
+
+	err = db.UpsertProject(context.Background(), proj.Project{ID: projectID})
+	if err != nil {
+		t.Fatalf("unexpected error upserting project: %v", err)
+	}
+
+	reqLog := reqlog.RequestLog{
+		ID:        ulid.MustNew(ulid.Timestamp(time.Now()), ulidEntropy),
+		ProjectID: projectID,
+		URL:       mustParseURL(t, "https://example.com/"),
+		Method:    http.MethodGet,
+	}
+
+	err = db.StoreRequestLog(context.Background(), reqLog)
+	if err != nil {
+		t.Fatalf("unexpected error storing request log: %v", err)
+	}
+
+	count := func(query string) int {
+		t.Helper()
+
+		expr, err := filter.ParseQuery(query)
+		if err != nil {
+			t.Fatalf("unexpected error parsing query: %v", err)
+		}
+
+		got, err := db.FindRequestLogs(context.Background(), reqlog.FindRequestsFilter{
+			ProjectID:  projectID,
+			SearchExpr: expr,
+		}, nil)
+		if err != nil {
+			t.Fatalf("unexpected error finding request logs: %v", err)
+		}
+
+		return len(got)
+	}
+
+	for _, statusCode := range []int{404, 200} {
+		err = db.StoreResponseLog(context.Background(), projectID, reqLog.ID, reqlog.ResponseLog{
+			StatusCode: statusCode,
+		})
+		if err != nil {
+			t.Fatalf("unexpected error storing response log: %v", err)
+		}
+	}
+
+	if got := count("res.statusCode = 404"); got != 0 {
+		t.Errorf("expected no request logs with replaced status code, got: %v", got)
+	}
+
+	if got := count("res.statusCode = 200"); got != 1 {
+		t.Errorf("expected 1 request log with status code, got: %v", got)
+	}
+}
+
+func TestIndexMigration(t *testing.T) {
+	t.Parallel()
+
+	path := t.TempDir() + "bolt.db"
+	boltDB, err := bbolt.Open(path, 0o600, nil)
+	if err != nil {
+		t.Fatalf("failed to open bolt database: %v", err)
+	}
+
+	db, err := bolt.DatabaseFromBoltDB(boltDB)
+	if err != nil {
+		t.Fatalf("failed to create database: %v", err)
+	}
+	defer db.Close()
+
+	projectID := ulid.MustNew(ulid.Timestamp(time.Now()), ulidEntropy)
+
+	err = db.UpsertProject(context.Background(), proj.Project{ID: projectID})
+	if err != nil {
+		t.Fatalf("unexpected error upserting project: %v", err)
+	}
+
+	fixtures := indexFixtures(t, projectID)
+
+	for _, reqLog := range fixtures {
+		err = db.StoreRequestLog(context.Background(), reqLog)
+		if err != nil {
+			t.Fatalf("unexpected error creating request log fixture: %v", err)
+		}
+	}
+
+	// Simulate a database that was created before indexes existed.
+	err = boltDB.Update(func(tx *bbolt.Tx) error {
+		return tx.Bucket([]byte("projects")).Bucket(projectID[:]).DeleteBucket([]byte("request_log_indexes"))
+	})
+	if err != nil {
+		t.Fatalf("unexpected error deleting indexes: %v", err)
+	}
+
+	_, err = bolt.DatabaseFromBoltDB(boltDB)
+	if err != nil {
+		t.Fatalf("failed to create database: %v", err)
+	}
+
+	var n int
+
+	err = boltDB.View(func(tx *bbolt.Tx) error {
+		ib := tx.Bucket([]byte("projects")).Bucket(projectID[:]).Bucket([]byte("request_log_indexes"))
+		if ib == nil {
+			t.Fatal("expected request log indexes bucket to exist")
+		}
+
+		n = ib.Bucket([]byte("method")).Stats().KeyN
+
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error reading indexes: %v", err)
+	}
+
+	if n != len(fixtures) {
+		t.Errorf("expected %v method index entries, got: %v", len(fixtures), n)
+	}
+}
+
+func TestIndexedSearchAfterClear(t *testing.T) {
+	t.Parallel()
+
+	path := t.TempDir() + "bolt.db"
+	boltDB, err := bbolt.Open(path, 0o600, nil)
+	if err != nil {
+		t.Fatalf("failed to open bolt database: %v", err)
+	}
+
+	db, err := bolt.DatabaseFromBoltDB(boltDB)
+	if err != nil {
+		t.Fatalf("failed to create database: %v", err)
+	}
+	defer db.Close()
+
+	projectID := ulid.MustNew(ulid.Timestamp(time.Now()), ulidEntropy)
+
+	err = db.UpsertProject(context.Background(), proj.Project{ID: projectID})
+	if err != nil {
+		t.Fatalf("unexpected error upserting project: %v", err)
+	}
+
+	fixtures := indexFixtures(t, projectID)
+
+	for _, reqLog := range fixtures[:4] {
+		err = db.StoreRequestLog(context.Background(), reqLog)
+		if err != nil {
+			t.Fatalf("unexpected error creating request log fixture: %v", err)
+		}
+	}
+
+	err = db.ClearRequestLogs(context.Background(), projectID)
+	if err != nil {
+		t.Fatalf("unexpected error clearing request logs: %v", err)
+	}
+
+	err = db.StoreRequestLog(context.Background(), fixtures[4])
+	if err != nil {
+		t.Fatalf("unexpected error storing request log: %v", err)
+	}
+
+	var n int
+
+	err = boltDB.View(func(tx *bbolt.Tx) error {
+		ib := tx.Bucket([]byte("projects")).Bucket(projectID[:]).Bucket([]byte("request_log_indexes"))
+		if ib == nil {
+			t.Fatal("expected request log indexes bucket to exist")
+		}
+
+		n = ib.Bucket([]byte("method")).Stats().KeyN
+
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error reading indexes: %v", err)
+	}
+
+	if n != 1 {
+		t.Errorf("expected 1 method index entry, got: %v", n)
+	}
+
+	expr, err := filter.ParseQuery("req.method = DELETE")
+	if err != nil {
+		t.Fatalf("unexpected error parsing query: %v", err)
+	}
+
+	got, err := db.FindRequestLogs(context.Background(), reqlog.FindRequestsFilter{
+		ProjectID:  projectID,
+		SearchExpr: expr,
+	}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error finding request logs: %v", err)
+	}
+
+	if len(got) != 1 || got[0].ID != fixtures[4].ID {
+		t.Errorf("expected request log (id: %v), got: %+v", fixtures[4].ID, got)
+	}
+}
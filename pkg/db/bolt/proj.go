@@ -75,6 +75,21 @@ func (db *Database) UpsertProject(ctx context.Context, project proj.Project) err
 			return fmt.Errorf("bolt: failed to create sender requests bucket: %w", err)
 		}
 
+		_, err = b.CreateBucketIfNotExists(wsMessagesBucketName)
+		if err != nil {
+			return fmt.Errorf("bolt: failed to create websocket messages bucket: %w", err)
+		}
+
+		_, err = b.CreateBucketIfNotExists(tunnelLogsBucketName)
+		if err != nil {
+			return fmt.Errorf("bolt: failed to create tunnel logs bucket: %w", err)
+		}
+
+		err = ensureIndexes(b)
+		if err != nil {
+			return fmt.Errorf("bolt: failed to create indexes: %w", err)
+		}
+
 		return nil
 	})
 	if err != nil {
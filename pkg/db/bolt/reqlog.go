@@ -32,59 +32,122 @@ func requestLogsBucket(tx *bolt.Tx, projectID ulid.ULID) (*bolt.Bucket, error) {
 	return b, nil
 }
 
-func (db *Database) FindRequestLogs(ctx context.Context, filter reqlog.FindRequestsFilter, scope *scope.Scope) (reqLogs []reqlog.RequestLog, err error) {
+func (db *Database) FindRequestLogs(ctx context.Context, filter reqlog.FindRequestsFilter, scope *scope.Scope) ([]reqlog.RequestLog, error) {
+	page, err := db.FindRequestLogsPage(ctx, filter, scope, reqlog.PageOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	return page.RequestLogs, nil
+}
+
+// FindRequestLogsPage iterates over request logs with a cursor, starting after
+// `opts.After`. Indexable parts of the search expression are looked up in the
+// secondary indexes, and request logs are decoded and filtered one at a time.
+// Iteration stops as soon as the page is full.
+func (db *Database) FindRequestLogsPage(
+	ctx context.Context,
+	filter reqlog.FindRequestsFilter,
+	scope *scope.Scope,
+	opts reqlog.PageOptions,
+) (page reqlog.Page, err error) {
 	if filter.ProjectID.Compare(ulid.ULID{}) == 0 {
-		return nil, reqlog.ErrProjectIDMustBeSet
+		return reqlog.Page{}, reqlog.ErrProjectIDMustBeSet
 	}
 
 	tx, err := db.bolt.Begin(false)
 	if err != nil {
-		return nil, fmt.Errorf("bolt: failed to begin transaction: %w", err)
+		return reqlog.Page{}, fmt.Errorf("bolt: failed to begin transaction: %w", err)
 	}
 	defer tx.Rollback()
 
 	b, err := requestLogsBucket(tx, filter.ProjectID)
 	if err != nil {
-		return nil, fmt.Errorf("bolt: failed to get request logs bucket: %w", err)
+		return reqlog.Page{}, fmt.Errorf("bolt: failed to get request logs bucket: %w", err)
 	}
 
-	err = b.ForEach(func(reqLogID, rawReqLog []byte) error {
-		var reqLog reqlog.RequestLog
-		err = gob.NewDecoder(bytes.NewReader(rawReqLog)).Decode(&reqLog)
+	plan := requestLogPlanner(tx, filter.ProjectID).plan(filter.SearchExpr)
+	filter.SearchExpr = plan.residual
+
+	rc := newRecordCursor(b, plan, opts.After, opts.Direction == reqlog.DirectionDesc)
+
+	for k, v := rc.first(); k != nil; k, v = rc.next() {
+		if err := ctx.Err(); err != nil {
+			return reqlog.Page{}, fmt.Errorf("bolt: failed to iterate over request logs: %w", err)
+		}
+
+		reqLog, match, err := decodeMatchingRequestLog(v, filter, scope)
 		if err != nil {
-			return fmt.Errorf("failed to decode request log: %w", err)
+			return reqlog.Page{}, fmt.Errorf("bolt: failed to iterate over request logs (id: %x): %w", k, err)
 		}
 
-		if filter.OnlyInScope && !reqLog.MatchScope(scope) {
-			return nil
+		if !match {
+			continue
 		}
 
-		// Filter by search expression. TODO: Once pagination is introduced,
-		// this filter logic should be done as items are retrieved.
-		if filter.SearchExpr != nil {
-			match, err := reqLog.Matches(filter.SearchExpr)
-			if err != nil {
-				return fmt.Errorf("failed to match search expression for request log (id: %v): %w", reqLogID, err)
-			}
+		if opts.Limit > 0 && len(page.RequestLogs) == opts.Limit {
+			page.HasNextPage = true
+			break
+		}
+
+		page.RequestLogs = append(page.RequestLogs, reqLog)
+	}
+
+	if opts.CountTotal {
+		var match func(v []byte) (bool, error)
 
-			if !match {
-				return nil
+		if filter.OnlyInScope || filter.SearchExpr != nil {
+			match = func(v []byte) (bool, error) {
+				_, match, err := decodeMatchingRequestLog(v, filter, scope)
+				return match, err
 			}
 		}
 
-		reqLogs = append(reqLogs, reqLog)
-		return nil
-	})
+		page.TotalCount, err = countMatches(ctx, b, plan, match)
+		if err != nil {
+			return reqlog.Page{}, fmt.Errorf("bolt: failed to count request logs: %w", err)
+		}
+	}
+
+	return page, nil
+}
+
+func requestLogPlanner(tx *bolt.Tx, projectID ulid.ULID) planner {
+	pb, err := projectBucket(tx, projectID[:])
 	if err != nil {
-		return nil, fmt.Errorf("bolt: failed to iterate over request logs: %w", err)
+		return planner{}
 	}
 
-	// Reverse items, so newest requests appear first.
-	for i, j := 0, len(reqLogs)-1; i < j; i, j = i+1, j-1 {
-		reqLogs[i], reqLogs[j] = reqLogs[j], reqLogs[i]
+	return planner{
+		indexes:  pb.Bucket(reqLogIndexesBucketName),
+		fullText: pb.Bucket(reqLogFullTextBucketName),
 	}
+}
 
-	return reqLogs, nil
+func decodeMatchingRequestLog(rawReqLog []byte, filter reqlog.FindRequestsFilter, scope *scope.Scope) (reqlog.RequestLog, bool, error) {
+	var reqLog reqlog.RequestLog
+
+	err := gob.NewDecoder(bytes.NewReader(rawReqLog)).Decode(&reqLog)
+	if err != nil {
+		return reqlog.RequestLog{}, false, fmt.Errorf("failed to decode request log: %w", err)
+	}
+
+	if filter.OnlyInScope && !reqLog.MatchScope(scope) {
+		return reqlog.RequestLog{}, false, nil
+	}
+
+	if filter.SearchExpr != nil {
+		match, err := reqLog.Matches(filter.SearchExpr)
+		if err != nil {
+			return reqlog.RequestLog{}, false, fmt.Errorf("failed to match search expression: %w", err)
+		}
+
+		if !match {
+			return reqlog.RequestLog{}, false, nil
+		}
+	}
+
+	return reqLog, true, nil
 }
 
 func (db *Database) FindRequestLogByID(ctx context.Context, projectID, reqLogID ulid.ULID) (reqLog reqlog.RequestLog, err error) {
@@ -113,22 +176,24 @@ func (db *Database) FindRequestLogByID(ctx context.Context, projectID, reqLogID
 }
 
 func (db *Database) StoreRequestLog(ctx context.Context, reqLog reqlog.RequestLog) error {
-	buf := bytes.Buffer{}
-
-	err := gob.NewEncoder(&buf).Encode(reqLog)
+	err := db.bolt.Update(func(txn *bolt.Tx) error {
+		return putRequestLog(txn, reqLog)
+	})
 	if err != nil {
-		return fmt.Errorf("bolt: failed to encode request log: %w", err)
+		return fmt.Errorf("bolt: failed to commit transaction: %w", err)
 	}
 
-	err = db.bolt.Update(func(txn *bolt.Tx) error {
-		b, err := requestLogsBucket(txn, reqLog.ProjectID)
-		if err != nil {
-			return fmt.Errorf("failed to get request logs bucket: %w", err)
-		}
+	return nil
+}
 
-		err = b.Put(reqLog.ID[:], buf.Bytes())
-		if err != nil {
-			return fmt.Errorf("failed to put request log: %w", err)
+// StoreRequestLogs stores request logs in a single transaction, so either all
+// or none of them are stored.
+func (db *Database) StoreRequestLogs(ctx context.Context, reqLogs []reqlog.RequestLog) error {
+	err := db.bolt.Update(func(txn *bolt.Tx) error {
+		for _, reqLog := range reqLogs {
+			if err := putRequestLog(txn, reqLog); err != nil {
+				return fmt.Errorf("request log (id: %v): %w", reqLog.ID, err)
+			}
 		}
 
 		return nil
@@ -140,6 +205,44 @@ func (db *Database) StoreRequestLog(ctx context.Context, reqLog reqlog.RequestLo
 	return nil
 }
 
+func putRequestLog(txn *bolt.Tx, reqLog reqlog.RequestLog) error {
+	buf := bytes.Buffer{}
+
+	err := gob.NewEncoder(&buf).Encode(reqLog)
+	if err != nil {
+		return fmt.Errorf("failed to encode request log: %w", err)
+	}
+
+	b, err := requestLogsBucket(txn, reqLog.ProjectID)
+	if err != nil {
+		return fmt.Errorf("failed to get request logs bucket: %w", err)
+	}
+
+	pb, err := projectBucket(txn, reqLog.ProjectID[:])
+	if err != nil {
+		return fmt.Errorf("failed to get project bucket: %w", err)
+	}
+
+	old := b.Get(reqLog.ID[:])
+
+	err = reqLogRecords.update(pb, reqLog.ID, old, reqLog.SearchValue)
+	if err != nil {
+		return fmt.Errorf("failed to update indexes: %w", err)
+	}
+
+	err = updateFullTextIndex(pb, old, reqLog)
+	if err != nil {
+		return fmt.Errorf("failed to update full-text index: %w", err)
+	}
+
+	err = b.Put(reqLog.ID[:], buf.Bytes())
+	if err != nil {
+		return fmt.Errorf("failed to put request log: %w", err)
+	}
+
+	return nil
+}
+
 func (db *Database) StoreResponseLog(ctx context.Context, projectID, reqLogID ulid.ULID, resLog reqlog.ResponseLog) error {
 	buf := bytes.Buffer{}
 
@@ -167,6 +270,21 @@ func (db *Database) StoreResponseLog(ctx context.Context, projectID, reqLogID ul
 
 		reqLog.Response = &resLog
 
+		pb, err := projectBucket(txn, projectID[:])
+		if err != nil {
+			return fmt.Errorf("failed to get project bucket: %w", err)
+		}
+
+		err = reqLogRecords.update(pb, reqLog.ID, rawReqLog, reqLog.SearchValue)
+		if err != nil {
+			return fmt.Errorf("failed to update indexes: %w", err)
+		}
+
+		err = updateFullTextIndex(pb, rawReqLog, reqLog)
+		if err != nil {
+			return fmt.Errorf("failed to update full-text index: %w", err)
+		}
+
 		buf := bytes.Buffer{}
 		err = gob.NewEncoder(&buf).Encode(reqLog)
 		if err != nil {
@@ -194,7 +312,40 @@ func (db *Database) ClearRequestLogs(ctx context.Context, projectID ulid.ULID) e
 			return fmt.Errorf("failed to get project bucket: %w", err)
 		}
 
-		return pb.DeleteBucket(reqLogsBucketName)
+		err = pb.DeleteBucket(reqLogsBucketName)
+		if err != nil {
+			return fmt.Errorf("failed to delete request logs bucket: %w", err)
+		}
+
+		_, err = pb.CreateBucket(reqLogsBucketName)
+		if err != nil {
+			return fmt.Errorf("failed to create request logs bucket: %w", err)
+		}
+
+		// Rebuilding the (now empty) indexes keeps them complete, so that
+		// queries keep using them for request logs that are stored later.
+		err = reqLogRecords.rebuild(pb)
+		if err != nil {
+			return fmt.Errorf("failed to clear request log indexes: %w", err)
+		}
+
+		err = clearFullTextIndex(pb)
+		if err != nil {
+			return fmt.Errorf("failed to clear full-text index: %w", err)
+		}
+
+		// WebSocket messages belong to request logs, so clear them too.
+		err = pb.DeleteBucket(wsMessagesBucketName)
+		if err != nil && !errors.Is(err, bolt.ErrBucketNotFound) {
+			return fmt.Errorf("failed to delete websocket messages bucket: %w", err)
+		}
+
+		err = pb.DeleteBucket(tunnelLogsBucketName)
+		if err != nil && !errors.Is(err, bolt.ErrBucketNotFound) {
+			return fmt.Errorf("failed to delete tunnel logs bucket: %w", err)
+		}
+
+		return nil
 	})
 	if err != nil {
 		return fmt.Errorf("bolt: failed to commit transaction: %w", err)
@@ -13,6 +13,7 @@ import (
 	"go.etcd.io/bbolt"
 
 	"github.com/dstotijn/hetty/pkg/db/bolt"
+	"github.com/dstotijn/hetty/pkg/filter"
 	"github.com/dstotijn/hetty/pkg/proj"
 	"github.com/dstotijn/hetty/pkg/reqlog"
 )
@@ -129,6 +130,159 @@ func TestFindRequestLogs(t *testing.T) {
 	})
 }
 
+func TestFindRequestLogsPage(t *testing.T) {
+	t.Parallel()
+
+	path := t.TempDir() + "bolt.db"
+	boltDB, err := bbolt.Open(path, 0o600, nil)
+	if err != nil {
+		t.Fatalf("failed to open bolt database: %v", err)
+	}
+
+	db, err := bolt.DatabaseFromBoltDB(boltDB)
+	if err != nil {
+		t.Fatalf("failed to create database: %v", err)
+	}
+	defer db.Close()
+
+	projectID := ulid.MustNew(ulid.Timestamp(time.Now()), ulidEntropy)
+
+	err = db.UpsertProject(context.Background(), proj.Project{
+		ID: projectID,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error upserting project: %v", err)
+	}
+
+	ids := make([]ulid.ULID, 5)
+
+	for i := range ids {
+		ids[i] = ulid.MustNew(ulid.Timestamp(time.Now())+uint64(i), ulidEntropy)
+		method := http.MethodGet
+
+		if i%2 == 1 {
+			method = http.MethodPost
+		}
+
+		err = db.StoreRequestLog(context.Background(), reqlog.RequestLog{
+			ID:        ids[i],
+			ProjectID: projectID,
+			URL:       mustParseURL(t, "https://example.com/"),
+			Method:    method,
+		})
+		if err != nil {
+			t.Fatalf("unexpected error creating request log fixture: %v", err)
+		}
+	}
+
+	pageIDs := func(page reqlog.Page) []ulid.ULID {
+		var got []ulid.ULID
+		for _, reqLog := range page.RequestLogs {
+			got = append(got, reqLog.ID)
+		}
+
+		return got
+	}
+
+	tests := []struct {
+		name          string
+		searchExpr    string
+		opts          reqlog.PageOptions
+		expIDs        []ulid.ULID
+		expNextPage   bool
+		expTotalCount int
+	}{
+		{
+			name:        "first page, newest first",
+			opts:        reqlog.PageOptions{Limit: 2},
+			expIDs:      []ulid.ULID{ids[4], ids[3]},
+			expNextPage: true,
+		},
+		{
+			name:        "next page, newest first",
+			opts:        reqlog.PageOptions{After: ids[3], Limit: 2},
+			expIDs:      []ulid.ULID{ids[2], ids[1]},
+			expNextPage: true,
+		},
+		{
+			name:   "last page, newest first",
+			opts:   reqlog.PageOptions{After: ids[1], Limit: 2},
+			expIDs: []ulid.ULID{ids[0]},
+		},
+		{
+			name:        "first page, oldest first",
+			opts:        reqlog.PageOptions{Limit: 2, Direction: reqlog.DirectionAsc},
+			expIDs:      []ulid.ULID{ids[0], ids[1]},
+			expNextPage: true,
+		},
+		{
+			name:   "last page, oldest first",
+			opts:   reqlog.PageOptions{After: ids[2], Limit: 2, Direction: reqlog.DirectionAsc},
+			expIDs: []ulid.ULID{ids[3], ids[4]},
+		},
+		{
+			name:   "exact limit",
+			opts:   reqlog.PageOptions{Limit: 5},
+			expIDs: []ulid.ULID{ids[4], ids[3], ids[2], ids[1], ids[0]},
+		},
+		{
+			name:          "total count",
+			opts:          reqlog.PageOptions{Limit: 1, CountTotal: true},
+			expIDs:        []ulid.ULID{ids[4]},
+			expNextPage:   true,
+			expTotalCount: 5,
+		},
+		{
+			name:          "filtered while iterating",
+			searchExpr:    "req.method = POST",
+			opts:          reqlog.PageOptions{Limit: 1, CountTotal: true},
+			expIDs:        []ulid.ULID{ids[3]},
+			expNextPage:   true,
+			expTotalCount: 2,
+		},
+		{
+			name:       "filtered next page",
+			searchExpr: "req.method = POST",
+			opts:       reqlog.PageOptions{After: ids[3], Limit: 1},
+			expIDs:     []ulid.ULID{ids[1]},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			findFilter := reqlog.FindRequestsFilter{
+				ProjectID: projectID,
+			}
+
+			if tt.searchExpr != "" {
+				expr, err := filter.ParseQuery(tt.searchExpr)
+				if err != nil {
+					t.Fatalf("unexpected error parsing search expression: %v", err)
+				}
+
+				findFilter.SearchExpr = expr
+			}
+
+			got, err := db.FindRequestLogsPage(context.Background(), findFilter, nil, tt.opts)
+			if err != nil {
+				t.Fatalf("unexpected error finding request logs: %v", err)
+			}
+
+			if diff := cmp.Diff(tt.expIDs, pageIDs(got)); diff != "" {
+				t.Fatalf("request log IDs not equal (-exp, +got):\n%v", diff)
+			}
+
+			if got.HasNextPage != tt.expNextPage {
+				t.Errorf("expected has next page to be %v, got: %v", tt.expNextPage, got.HasNextPage)
+			}
+
+			if got.TotalCount != tt.expTotalCount {
+				t.Errorf("expected total count %v, got: %v", tt.expTotalCount, got.TotalCount)
+			}
+		})
+	}
+}
+
 func mustParseURL(t *testing.T, s string) *url.URL {
 	t.Helper()
 
@@ -139,3 +293,71 @@ func mustParseURL(t *testing.T, s string) *url.URL {
 
 	return u
 }
+
+func TestStoreRequestLogs(t *testing.T) {
+	t.Parallel()
+
+	path := t.TempDir() + "bolt.db"
+	boltDB, err := bbolt.Open(path, 0o600, nil)
+	if err != nil {
+		t.Fatalf("failed to open bolt database: %v", err)
+	}
+
+	db, err := bolt.DatabaseFromBoltDB(boltDB)
+	if err != nil {
+		t.Fatalf("failed to create database: %v", err)
+	}
+	defer db.Close()
+
+	projectID := ulid.MustNew(ulid.Timestamp(time.Now()), ulidEntropy)
+
+	err = db.UpsertProject(context.Background(), proj.Project{ID: projectID})
+	if err != nil {
+		t.Fatalf("unexpected error upserting project: %v", err)
+	}
+
+	newReqLog := func(projectID ulid.ULID) reqlog.RequestLog {
+		return reqlog.RequestLog{
+			ID:        ulid.MustNew(ulid.Timestamp(time.Now()), ulidEntropy),
+			ProjectID: projectID,
+			URL:       mustParseURL(t, "https://example.com/foobar"),
+			Method:    http.MethodGet,
+		}
+	}
+
+	findAll := func() []reqlog.RequestLog {
+		t.Helper()
+
+		got, err := db.FindRequestLogs(context.Background(), reqlog.FindRequestsFilter{ProjectID: projectID}, nil)
+		if err != nil {
+			t.Fatalf("unexpected error finding request logs: %v", err)
+		}
+
+		return got
+	}
+
+	// The last request log belongs to a project that doesn't exist, so none of
+	// the request logs are stored.
+	err = db.StoreRequestLogs(context.Background(), []reqlog.RequestLog{
+		newReqLog(projectID),
+		newReqLog(ulid.MustNew(ulid.Timestamp(time.Now()), ulidEntropy)),
+	})
+	if !errors.Is(err, bolt.ErrProjectBucketNotFound) {
+		t.Fatalf("expected `bolt.ErrProjectBucketNotFound`, got: %v", err)
+	}
+
+	if got := findAll(); len(got) != 0 {
+		t.Errorf("expected no request logs, got: %v", len(got))
+	}
+
+	exp := []reqlog.RequestLog{newReqLog(projectID), newReqLog(projectID)}
+
+	err = db.StoreRequestLogs(context.Background(), exp)
+	if err != nil {
+		t.Fatalf("unexpected error storing request logs: %v", err)
+	}
+
+	if got := findAll(); len(got) != len(exp) {
+		t.Errorf("expected %v request logs, got: %v", len(exp), len(got))
+	}
+}
@@ -46,6 +46,16 @@ func (db *Database) StoreSenderRequest(ctx context.Context, req sender.Request)
 			return fmt.Errorf("failed to get sender requests bucket: %w", err)
 		}
 
+		pb, err := projectBucket(tx, req.ProjectID[:])
+		if err != nil {
+			return fmt.Errorf("failed to get project bucket: %w", err)
+		}
+
+		err = senderReqRecords.update(pb, req.ID, senderReqsBucket.Get(req.ID[:]), req.SearchValue)
+		if err != nil {
+			return fmt.Errorf("failed to update indexes: %w", err)
+		}
+
 		err = senderReqsBucket.Put(req.ID[:], buf.Bytes())
 		if err != nil {
 			return fmt.Errorf("failed to put sender request: %w", err)
@@ -106,45 +116,44 @@ func (db *Database) FindSenderRequests(ctx context.Context, filter sender.FindRe
 		return nil, fmt.Errorf("failed to get sender requests bucket: %w", err)
 	}
 
-	err = b.ForEach(func(senderReqID, rawSenderReq []byte) error {
+	var ib *bolt.Bucket
+	if pb, err := projectBucket(tx, filter.ProjectID[:]); err == nil {
+		ib = pb.Bucket(senderReqIndexesBucketName)
+	}
+
+	plan := planner{indexes: ib}.plan(filter.SearchExpr)
+
+	// Iterate in descending order, so newest requests appear first.
+	rc := newRecordCursor(b, plan, ulid.ULID{}, true)
+
+	for senderReqID, rawSenderReq := rc.first(); senderReqID != nil; senderReqID, rawSenderReq = rc.next() {
 		var req sender.Request
 		err = gob.NewDecoder(bytes.NewReader(rawSenderReq)).Decode(&req)
 		if err != nil {
-			return fmt.Errorf("failed to decode sender request: %w", err)
+			return nil, fmt.Errorf("bolt: failed to decode sender request: %w", err)
 		}
 
 		if filter.OnlyInScope {
 			if !req.MatchScope(scope) {
-				return nil
+				continue
 			}
 		}
 
-		// Filter by search expression. TODO: Once pagination is introduced,
-		// this filter logic should be done as items are retrieved.
-		if filter.SearchExpr != nil {
-			match, err := req.Matches(filter.SearchExpr)
+		if plan.residual != nil {
+			match, err := req.Matches(plan.residual)
 			if err != nil {
-				return fmt.Errorf(
-					"bolt: failed to match search expression for sender request (id: %v): %w",
+				return nil, fmt.Errorf(
+					"bolt: failed to match search expression for sender request (id: %x): %w",
 					senderReqID, err,
 				)
 			}
 
 			if !match {
-				return nil
+				continue
 			}
 		}
 
 		reqs = append(reqs, req)
-		return nil
-	})
-	if err != nil {
-		return nil, fmt.Errorf("bolt: failed to commit transaction: %w", err)
-	}
-
-	// Reverse items, so newest requests appear first.
-	for i, j := 0, len(reqs)-1; i < j; i, j = i+1, j-1 {
-		reqs[i], reqs[j] = reqs[j], reqs[i]
 	}
 
 	return reqs, nil
@@ -0,0 +1,91 @@
+package bolt
+
+import (
+	"bytes"
+	"context"
+	"encoding/gob"
+	"fmt"
+
+	"github.com/oklog/ulid"
+	bolt "go.etcd.io/bbolt"
+
+	"github.com/dstotijn/hetty/pkg/reqlog"
+)
+
+var tunnelLogsBucketName = []byte("tunnel_logs")
+
+func (db *Database) StoreTunnelLog(ctx context.Context, tunnelLog reqlog.TunnelLog) error {
+	buf := bytes.Buffer{}
+
+	err := gob.NewEncoder(&buf).Encode(tunnelLog)
+	if err != nil {
+		return fmt.Errorf("bolt: failed to encode tunnel log: %w", err)
+	}
+
+	err = db.bolt.Update(func(tx *bolt.Tx) error {
+		pb, err := projectBucket(tx, tunnelLog.ProjectID[:])
+		if err != nil {
+			return fmt.Errorf("failed to get project bucket: %w", err)
+		}
+
+		// Projects created before passthrough tunnel support don't have this
+		// bucket yet.
+		b, err := pb.CreateBucketIfNotExists(tunnelLogsBucketName)
+		if err != nil {
+			return fmt.Errorf("failed to create tunnel logs bucket: %w", err)
+		}
+
+		err = b.Put(tunnelLog.ID[:], buf.Bytes())
+		if err != nil {
+			return fmt.Errorf("failed to put tunnel log: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("bolt: failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+func (db *Database) FindTunnelLogs(ctx context.Context, projectID ulid.ULID) (tunnelLogs []reqlog.TunnelLog, err error) {
+	if projectID.Compare(ulid.ULID{}) == 0 {
+		return nil, reqlog.ErrProjectIDMustBeSet
+	}
+
+	err = db.bolt.View(func(tx *bolt.Tx) error {
+		pb, err := projectBucket(tx, projectID[:])
+		if err != nil {
+			return fmt.Errorf("failed to get project bucket: %w", err)
+		}
+
+		b := pb.Bucket(tunnelLogsBucketName)
+		if b == nil {
+			return nil
+		}
+
+		return b.ForEach(func(_, rawTunnelLog []byte) error {
+			var tunnelLog reqlog.TunnelLog
+
+			err := gob.NewDecoder(bytes.NewReader(rawTunnelLog)).Decode(&tunnelLog)
+			if err != nil {
+				return fmt.Errorf("failed to decode tunnel log: %w", err)
+			}
+
+			tunnelLogs = append(tunnelLogs, tunnelLog)
+
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, fmt.Errorf("bolt: failed to find tunnel logs: %w", err)
+	}
+
+	// Reverse items, so newest tunnels appear first.
+	for i, j := 0, len(tunnelLogs)-1; i < j; i, j = i+1, j-1 {
+		tunnelLogs[i], tunnelLogs[j] = tunnelLogs[j], tunnelLogs[i]
+	}
+
+	return tunnelLogs, nil
+}
@@ -0,0 +1,110 @@
+package bolt_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/oklog/ulid"
+	"go.etcd.io/bbolt"
+
+	"github.com/dstotijn/hetty/pkg/db/bolt"
+	"github.com/dstotijn/hetty/pkg/proj"
+	"github.com/dstotijn/hetty/pkg/reqlog"
+)
+
+func TestFindTunnelLogs(t *testing.T) {
+	t.Parallel()
+
+	t.Run("without project ID", func(t *testing.T) {
+		t.Parallel()
+
+		path := t.TempDir() + "bolt.db"
+		boltDB, err := bbolt.Open(path, 0o600, nil)
+		if err != nil {
+			t.Fatalf("failed to open bolt database: %v", err)
+		}
+
+		db, err := bolt.DatabaseFromBoltDB(boltDB)
+		if err != nil {
+			t.Fatalf("failed to create database: %v", err)
+		}
+		defer db.Close()
+
+		_, err = db.FindTunnelLogs(context.Background(), ulid.ULID{})
+		if !errors.Is(err, reqlog.ErrProjectIDMustBeSet) {
+			t.Fatalf("expected `reqlog.ErrProjectIDMustBeSet`, got: %v", err)
+		}
+	})
+
+	t.Run("returns tunnel logs", func(t *testing.T) {
+		t.Parallel()
+
+		path := t.TempDir() + "bolt.db"
+		boltDB, err := bbolt.Open(path, 0o600, nil)
+		if err != nil {
+			t.Fatalf("failed to open bolt database: %v", err)
+		}
+
+		db, err := bolt.DatabaseFromBoltDB(boltDB)
+		if err != nil {
+			t.Fatalf("failed to create database: %v", err)
+		}
+		defer db.Close()
+
+		projectID := ulid.MustNew(ulid.Timestamp(time.Now()), ulidEntropy)
+
+		err = db.UpsertProject(context.Background(), proj.Project{
+			ID: projectID,
+		})
+		if err != nil {
+			t.Fatalf("unexpected error upserting project: %v", err)
+		}
+
+		startedAt := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+		fixtures := []reqlog.TunnelLog{
+			{
+				ID:            ulid.MustNew(ulid.Timestamp(time.Now()), ulidEntropy),
+				ProjectID:     projectID,
+				Host:          "example.com",
+				Port:          "443",
+				BytesSent:     512,
+				BytesReceived: 4096,
+				StartedAt:     startedAt,
+				Duration:      3 * time.Second,
+			},
+			{
+				ID:            ulid.MustNew(ulid.Timestamp(time.Now())+100, ulidEntropy),
+				ProjectID:     projectID,
+				Host:          "telemetry.example.com",
+				Port:          "443",
+				BytesSent:     128,
+				BytesReceived: 64,
+				StartedAt:     startedAt.Add(time.Minute),
+				Duration:      time.Second,
+			},
+		}
+
+		for _, tunnelLog := range fixtures {
+			err = db.StoreTunnelLog(context.Background(), tunnelLog)
+			if err != nil {
+				t.Fatalf("unexpected error creating tunnel log fixture: %v", err)
+			}
+		}
+
+		got, err := db.FindTunnelLogs(context.Background(), projectID)
+		if err != nil {
+			t.Fatalf("unexpected error finding tunnel logs: %v", err)
+		}
+
+		// We expect the found tunnel logs are *reversed*, e.g. newest first.
+		exp := []reqlog.TunnelLog{fixtures[1], fixtures[0]}
+
+		if diff := cmp.Diff(exp, got); diff != "" {
+			t.Fatalf("tunnel logs not equal (-exp, +got):\n%v", diff)
+		}
+	})
+}
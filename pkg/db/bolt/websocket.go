@@ -0,0 +1,102 @@
+package bolt
+
+import (
+	"bytes"
+	"context"
+	"encoding/gob"
+	"fmt"
+
+	"github.com/oklog/ulid"
+	bolt "go.etcd.io/bbolt"
+
+	"github.com/dstotijn/hetty/pkg/reqlog"
+)
+
+var wsMessagesBucketName = []byte("websocket_messages")
+
+// wsMessageKey returns the key for a WebSocket message log. Keys are prefixed
+// with the request log ID, so messages of a connection can be iterated over
+// in chronological order with a prefix scan.
+func wsMessageKey(reqLogID, msgID ulid.ULID) []byte {
+	key := make([]byte, 0, len(reqLogID)+len(msgID))
+	key = append(key, reqLogID[:]...)
+	key = append(key, msgID[:]...)
+
+	return key
+}
+
+func (db *Database) StoreWebSocketMessageLog(ctx context.Context, msgLog reqlog.WebSocketMessageLog) error {
+	buf := bytes.Buffer{}
+
+	err := gob.NewEncoder(&buf).Encode(msgLog)
+	if err != nil {
+		return fmt.Errorf("bolt: failed to encode websocket message log: %w", err)
+	}
+
+	err = db.bolt.Update(func(tx *bolt.Tx) error {
+		pb, err := projectBucket(tx, msgLog.ProjectID[:])
+		if err != nil {
+			return fmt.Errorf("failed to get project bucket: %w", err)
+		}
+
+		// Projects created before WebSocket support don't have this bucket yet.
+		b, err := pb.CreateBucketIfNotExists(wsMessagesBucketName)
+		if err != nil {
+			return fmt.Errorf("failed to create websocket messages bucket: %w", err)
+		}
+
+		err = b.Put(wsMessageKey(msgLog.RequestLogID, msgLog.ID), buf.Bytes())
+		if err != nil {
+			return fmt.Errorf("failed to put websocket message log: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("bolt: failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+func (db *Database) FindWebSocketMessageLogs(
+	ctx context.Context,
+	projectID, reqLogID ulid.ULID,
+) (msgLogs []reqlog.WebSocketMessageLog, err error) {
+	if projectID.Compare(ulid.ULID{}) == 0 {
+		return nil, reqlog.ErrProjectIDMustBeSet
+	}
+
+	err = db.bolt.View(func(tx *bolt.Tx) error {
+		pb, err := projectBucket(tx, projectID[:])
+		if err != nil {
+			return fmt.Errorf("failed to get project bucket: %w", err)
+		}
+
+		b := pb.Bucket(wsMessagesBucketName)
+		if b == nil {
+			return nil
+		}
+
+		c := b.Cursor()
+		prefix := reqLogID[:]
+
+		for k, v := c.Seek(prefix); k != nil && bytes.HasPrefix(k, prefix); k, v = c.Next() {
+			var msgLog reqlog.WebSocketMessageLog
+
+			err = gob.NewDecoder(bytes.NewReader(v)).Decode(&msgLog)
+			if err != nil {
+				return fmt.Errorf("failed to decode websocket message log: %w", err)
+			}
+
+			msgLogs = append(msgLogs, msgLog)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("bolt: failed to find websocket message logs: %w", err)
+	}
+
+	return msgLogs, nil
+}
@@ -0,0 +1,54 @@
+package filter
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// Compare evaluates a comparison operator for two operand values. For ordering
+// operators (e.g. `>`), operands are compared numerically when both are
+// numbers, so that `res.duration > 2000` works as expected. Otherwise, they
+// are compared lexicographically.
+func Compare(op TokenType, left, right string) (bool, error) {
+	switch op {
+	case TokOpEq:
+		return left == right, nil
+	case TokOpNotEq:
+		return left != right, nil
+	}
+
+	cmp := compareValues(left, right)
+
+	switch op {
+	case TokOpGt:
+		return cmp > 0, nil
+	case TokOpLt:
+		return cmp < 0, nil
+	case TokOpGtEq:
+		return cmp >= 0, nil
+	case TokOpLtEq:
+		return cmp <= 0, nil
+	default:
+		return false, fmt.Errorf("filter: unsupported operator %q", op.String())
+	}
+}
+
+func compareValues(left, right string) int {
+	leftNum, leftErr := strconv.ParseFloat(left, 64)
+	rightNum, rightErr := strconv.ParseFloat(right, 64)
+
+	switch {
+	case leftErr == nil && rightErr == nil && leftNum < rightNum:
+		return -1
+	case leftErr == nil && rightErr == nil && leftNum > rightNum:
+		return 1
+	case leftErr == nil && rightErr == nil:
+		return 0
+	case left < right:
+		return -1
+	case left > right:
+		return 1
+	default:
+		return 0
+	}
+}
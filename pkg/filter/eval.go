@@ -0,0 +1,169 @@
+package filter
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Fields is a registry of the fields that filter expressions can refer to. It's
+// implemented for each type of record that expressions are evaluated against,
+// so that keys, operators and type coercion behave the same for all of them.
+type Fields interface {
+	// Field returns the value of the field with key `key`, e.g. `req.method`.
+	// The boolean is false if there's no such field, in which case the key is
+	// evaluated as a literal value.
+	Field(key string) (string, bool, error)
+	// Headers returns the headers of a headers field, e.g. `req.headers`, which
+	// are matched as `<key>: <value>` lines. The boolean is false if `key`
+	// isn't a headers field.
+	Headers(key string) (http.Header, bool)
+	// Text returns the values that free-text literals are matched against.
+	Text() ([]string, error)
+}
+
+// TermMatcher can be implemented by Fields to match free-text literals itself,
+// e.g. search terms with a special prefix. The second boolean is false if the
+// literal must be matched against Fields.Text instead.
+type TermMatcher interface {
+	MatchTerm(literal string) (match bool, ok bool)
+}
+
+// Evaluate returns true if the fields of a record match the expression.
+func Evaluate(expr Expression, fields Fields) (bool, error) {
+	switch e := expr.(type) {
+	case PrefixExpression:
+		return evalPrefixExpr(e, fields)
+	case InfixExpression:
+		return evalInfixExpr(e, fields)
+	case StringLiteral:
+		return evalStringLiteral(e, fields)
+	default:
+		return false, fmt.Errorf("filter: expression type (%T) not supported", expr)
+	}
+}
+
+func evalPrefixExpr(expr PrefixExpression, fields Fields) (bool, error) {
+	switch expr.Operator {
+	case TokOpNot:
+		match, err := Evaluate(expr.Right, fields)
+		if err != nil {
+			return false, err
+		}
+
+		return !match, nil
+	default:
+		return false, errors.New("filter: operator is not supported")
+	}
+}
+
+func evalInfixExpr(expr InfixExpression, fields Fields) (bool, error) {
+	switch expr.Operator {
+	case TokOpAnd:
+		left, err := Evaluate(expr.Left, fields)
+		if err != nil {
+			return false, err
+		}
+
+		right, err := Evaluate(expr.Right, fields)
+		if err != nil {
+			return false, err
+		}
+
+		return left && right, nil
+	case TokOpOr:
+		left, err := Evaluate(expr.Left, fields)
+		if err != nil {
+			return false, err
+		}
+
+		right, err := Evaluate(expr.Right, fields)
+		if err != nil {
+			return false, err
+		}
+
+		return left || right, nil
+	}
+
+	left, ok := expr.Left.(StringLiteral)
+	if !ok {
+		return false, errors.New("filter: left operand must be a string literal")
+	}
+
+	if headers, ok := fields.Headers(left.Value); ok {
+		match, err := MatchHTTPHeaders(expr.Operator, expr.Right, headers)
+		if err != nil {
+			return false, fmt.Errorf("filter: failed to match HTTP headers: %w", err)
+		}
+
+		return match, nil
+	}
+
+	leftVal, err := fieldValue(fields, left.Value)
+	if err != nil {
+		return false, fmt.Errorf("filter: failed to get value of left operand: %w", err)
+	}
+
+	if expr.Operator == TokOpRe || expr.Operator == TokOpNotRe {
+		right, ok := expr.Right.(RegexpLiteral)
+		if !ok {
+			return false, errors.New("filter: right operand must be a regular expression")
+		}
+
+		if expr.Operator == TokOpRe {
+			return right.MatchString(leftVal), nil
+		}
+
+		return !right.MatchString(leftVal), nil
+	}
+
+	right, ok := expr.Right.(StringLiteral)
+	if !ok {
+		return false, errors.New("filter: right operand must be a string literal")
+	}
+
+	rightVal, err := fieldValue(fields, right.Value)
+	if err != nil {
+		return false, fmt.Errorf("filter: failed to get value of right operand: %w", err)
+	}
+
+	return Compare(expr.Operator, leftVal, rightVal)
+}
+
+// fieldValue returns the value of a field, or the key itself if it's a literal.
+func fieldValue(fields Fields, key string) (string, error) {
+	value, ok, err := fields.Field(key)
+	if err != nil {
+		return "", err
+	}
+
+	if !ok {
+		return key, nil
+	}
+
+	return value, nil
+}
+
+func evalStringLiteral(strLiteral StringLiteral, fields Fields) (bool, error) {
+	if matcher, ok := fields.(TermMatcher); ok {
+		if match, ok := matcher.MatchTerm(strLiteral.Value); ok {
+			return match, nil
+		}
+	}
+
+	text, err := fields.Text()
+	if err != nil {
+		return false, fmt.Errorf("filter: failed to get text values: %w", err)
+	}
+
+	needle := strings.ToLower(strLiteral.Value)
+
+	for _, value := range text {
+		if strings.Contains(strings.ToLower(value), needle) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
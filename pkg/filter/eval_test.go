@@ -0,0 +1,175 @@
+package filter_test
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/dstotijn/hetty/pkg/filter"
+)
+
+func TestEvaluate(t *testing.T) {
+	t.Parallel()
+
+	fields := filter.HTTPFields{
+		Request: &filter.HTTPRequest{
+			Proto:  "HTTP/1.1",
+			Method: http.MethodPost,
+			URL:    &url.URL{Scheme: "https", Host: "example.com:8443", Path: "/api/users"},
+			Header: http.Header{
+				"X-Foo":  []string{"bar"},
+				"Cookie": []string{"session=abc"},
+			},
+			Body: func() ([]byte, error) { return []byte(`{"name": "Alice"}`), nil },
+		},
+		Response: &filter.HTTPResponse{
+			Proto:      "HTTP/1.1",
+			StatusCode: http.StatusNotFound,
+			Status:     "404 Not Found",
+			Header:     http.Header{"Content-Type": []string{"text/html; charset=utf-8"}},
+		},
+		Extra: map[string]filter.ExtraField{
+			"req.id":       {Value: func() string { return "01FEXAMPLE" }},
+			"res.duration": {Value: func() string { return "150.5" }, Numeric: true},
+		},
+	}
+
+	tests := []struct {
+		query    string
+		expMatch bool
+	}{
+		{query: "req.method = POST", expMatch: true},
+		{query: "req.host = example.com", expMatch: true},
+		{query: `req.path =~ "^/api"`, expMatch: true},
+		{query: `req.url !~ "^http:"`, expMatch: true},
+		{query: `req.body =~ "Alice"`, expMatch: true},
+		{query: "req.header.x-foo = bar", expMatch: true},
+		{query: "req.cookie.session = abc", expMatch: true},
+		{query: `req.headers = "X-Foo: bar"`, expMatch: true},
+		{query: "res.statusCode >= 400 AND res.statusCode < 500", expMatch: true},
+		{query: `res.statusReason = "Not Found"`, expMatch: true},
+		{query: "res.contentType = text/html", expMatch: true},
+		{query: "res.duration > 100", expMatch: true},
+		{query: "res.duration > 1000", expMatch: false},
+		{query: "req.id = 01FEXAMPLE", expMatch: true},
+		{query: "NOT (req.method = POST)", expMatch: false},
+		{query: "req.method = GET OR res.statusCode = 404", expMatch: true},
+		{query: "ALICE", expMatch: true},
+		{query: "x-foo: bar", expMatch: true},
+		{query: "01fexample", expMatch: true},
+		{query: "150.5", expMatch: false},
+		{query: "foobar", expMatch: false},
+	}
+
+	for _, tt := range tests {
+		expr, err := filter.ParseQuery(tt.query)
+		if err != nil {
+			t.Fatalf("query %q: unexpected error parsing query: %v", tt.query, err)
+		}
+
+		match, err := filter.Evaluate(expr, fields)
+		if err != nil {
+			t.Fatalf("query %q: unexpected error: %v", tt.query, err)
+		}
+
+		if match != tt.expMatch {
+			t.Errorf("query %q: expected match result %v, got: %v", tt.query, tt.expMatch, match)
+		}
+	}
+}
+
+func TestEvaluateWithoutResponse(t *testing.T) {
+	t.Parallel()
+
+	fields := filter.HTTPFields{
+		Request: &filter.HTTPRequest{Method: http.MethodGet},
+	}
+
+	for query, expMatch := range map[string]bool{
+		`res.statusCode = ""`:    true,
+		"res.statusCode = 200":   false,
+		"res.header.X-Foo = bar": false,
+	} {
+		expr, err := filter.ParseQuery(query)
+		if err != nil {
+			t.Fatalf("query %q: unexpected error parsing query: %v", query, err)
+		}
+
+		match, err := filter.Evaluate(expr, fields)
+		if err != nil {
+			t.Fatalf("query %q: unexpected error: %v", query, err)
+		}
+
+		if match != expMatch {
+			t.Errorf("query %q: expected match result %v, got: %v", query, expMatch, match)
+		}
+	}
+}
+
+func TestEvaluateBodyError(t *testing.T) {
+	t.Parallel()
+
+	errBody := errors.New("read error")
+	fields := filter.HTTPFields{
+		Request: &filter.HTTPRequest{
+			Body: func() ([]byte, error) { return nil, errBody },
+		},
+	}
+
+	expr, err := filter.ParseQuery("req.body = foo")
+	if err != nil {
+		t.Fatalf("unexpected error parsing query: %v", err)
+	}
+
+	if _, err := filter.Evaluate(expr, fields); !errors.Is(err, errBody) {
+		t.Errorf("expected error %v, got: %v", errBody, err)
+	}
+}
+
+func TestEvaluatePrefixedFields(t *testing.T) {
+	t.Parallel()
+
+	req, err := http.NewRequest(http.MethodGet, "https://example.com/foo", nil)
+	if err != nil {
+		t.Fatalf("failed to create request: %v", err)
+	}
+
+	res := &http.Response{
+		StatusCode: http.StatusOK,
+		Status:     "200 OK",
+		Header:     http.Header{"X-Foo": []string{"bar"}},
+	}
+
+	fields := filter.PrefixedFields{
+		HTTPFields: filter.HTTPFields{
+			Request:  filter.NewHTTPRequest(req, nil),
+			Response: filter.NewHTTPResponse(res, nil),
+		},
+		Prefix: "res.",
+	}
+
+	for query, expMatch := range map[string]bool{
+		"statusCode = 200":                            true,
+		"statusReason = OK":                           true,
+		"header.x-foo = bar":                          true,
+		`headers = "X-Foo: bar"`:                      true,
+		"req.host = example.com AND statusCode = 200": true,
+		"req.method = POST":                           false,
+		"method = GET":                                false,
+	} {
+		expr, err := filter.ParseQuery(query)
+		if err != nil {
+			t.Fatalf("query %q: unexpected error parsing query: %v", query, err)
+		}
+
+		match, err := filter.Evaluate(expr, fields)
+		if err != nil {
+			t.Fatalf("query %q: unexpected error: %v", query, err)
+		}
+
+		if match != expMatch {
+			t.Errorf("query %q: expected match result %v, got: %v", query, expMatch, match)
+		}
+	}
+}
@@ -0,0 +1,288 @@
+package filter
+
+import (
+	"fmt"
+	"mime"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// HTTPRequest contains the parts of an HTTP request that filter fields are
+// resolved from.
+type HTTPRequest struct {
+	Proto  string
+	Method string
+	URL    *url.URL
+	Header http.Header
+	// Body returns the request body. It may be nil if there's no body.
+	Body func() ([]byte, error)
+}
+
+// HTTPResponse contains the parts of an HTTP response that filter fields are
+// resolved from.
+type HTTPResponse struct {
+	Proto      string
+	StatusCode int
+	// Status is the status line without protocol, e.g. `200 OK`, or just the
+	// reason phrase.
+	Status string
+	Header http.Header
+	// Body returns the response body. It may be nil if there's no body.
+	Body func() ([]byte, error)
+}
+
+// ExtraField is a record specific field, e.g. the ID of a request log.
+type ExtraField struct {
+	Value func() string
+	// Numeric fields (e.g. timings) aren't matched by free-text literals.
+	Numeric bool
+}
+
+// HTTPFields implements Fields for an HTTP request and its response. It
+// resolves the fields that all records share:
+//
+//   - `req.proto`, `req.url`, `req.host`, `req.path`, `req.method`, `req.body`
+//   - `res.proto`, `res.statusCode`, `res.statusReason`, `res.contentType`, `res.body`
+//   - `req.headers` and `res.headers`
+//   - `req.header.<name>`, `req.cookie.<name>`, `res.header.<name>` and `res.cookie.<name>`
+//
+// Like the `statusReason` field of the GraphQL API, `res.statusReason` is the
+// reason phrase without status code, e.g. `OK`.
+//
+// Fields of the response resolve to an empty string if there's no response.
+type HTTPFields struct {
+	Request  *HTTPRequest
+	Response *HTTPResponse
+	// Extra contains record specific fields by key. These take precedence over
+	// the shared fields.
+	Extra map[string]ExtraField
+	// MatchTermFn, if set, is used to implement TermMatcher.
+	MatchTermFn func(literal string) (match bool, ok bool)
+}
+
+var (
+	httpRequestFieldFns = map[string]func(req *HTTPRequest) (string, error){
+		"proto": func(req *HTTPRequest) (string, error) { return req.Proto, nil },
+		"url": func(req *HTTPRequest) (string, error) {
+			if req.URL == nil {
+				return "", nil
+			}
+			return req.URL.String(), nil
+		},
+		"host": func(req *HTTPRequest) (string, error) {
+			if req.URL == nil {
+				return "", nil
+			}
+			return req.URL.Hostname(), nil
+		},
+		"path": func(req *HTTPRequest) (string, error) {
+			if req.URL == nil {
+				return "", nil
+			}
+			return req.URL.Path, nil
+		},
+		"method": func(req *HTTPRequest) (string, error) { return req.Method, nil },
+		"body":   func(req *HTTPRequest) (string, error) { return readBody(req.Body) },
+	}
+	httpRequestFieldNames = []string{"proto", "url", "host", "path", "method", "body"}
+
+	httpResponseFieldFns = map[string]func(res *HTTPResponse) (string, error){
+		"proto":      func(res *HTTPResponse) (string, error) { return res.Proto, nil },
+		"statusCode": func(res *HTTPResponse) (string, error) { return strconv.Itoa(res.StatusCode), nil },
+		"statusReason": func(res *HTTPResponse) (string, error) {
+			if reason, ok := strings.CutPrefix(res.Status, strconv.Itoa(res.StatusCode)+" "); ok {
+				return reason, nil
+			}
+			return res.Status, nil
+		},
+		"contentType": func(res *HTTPResponse) (string, error) {
+			mediaType, _, _ := mime.ParseMediaType(res.Header.Get("Content-Type"))
+			return mediaType, nil
+		},
+		"body": func(res *HTTPResponse) (string, error) { return readBody(res.Body) },
+	}
+	httpResponseFieldNames = []string{"proto", "statusCode", "statusReason", "contentType", "body"}
+)
+
+// NewHTTPRequest returns the filter fields of an HTTP request. Its body is
+// resolved with `body`, which may be nil.
+func NewHTTPRequest(req *http.Request, body func() ([]byte, error)) *HTTPRequest {
+	return &HTTPRequest{
+		Proto:  req.Proto,
+		Method: req.Method,
+		URL:    req.URL,
+		Header: req.Header,
+		Body:   body,
+	}
+}
+
+// NewHTTPResponse returns the filter fields of an HTTP response. Its body is
+// resolved with `body`, which may be nil.
+func NewHTTPResponse(res *http.Response, body func() ([]byte, error)) *HTTPResponse {
+	return &HTTPResponse{
+		Proto:      res.Proto,
+		StatusCode: res.StatusCode,
+		Status:     res.Status,
+		Header:     res.Header,
+		Body:       body,
+	}
+}
+
+// PrefixedFields resolves keys without `req.` or `res.` prefix (e.g. `method`
+// or `statusCode`), which predate the shared filter fields, by adding Prefix.
+// Prefixed keys are resolved as-is, so e.g. a response filter can match on
+// `req.host` too.
+type PrefixedFields struct {
+	HTTPFields
+	Prefix string
+}
+
+// Field implements Fields.
+func (f PrefixedFields) Field(key string) (string, bool, error) {
+	value, ok, err := f.HTTPFields.Field(key)
+	if ok || err != nil {
+		return value, ok, err
+	}
+
+	return f.HTTPFields.Field(f.Prefix + key)
+}
+
+// Headers implements Fields.
+func (f PrefixedFields) Headers(key string) (http.Header, bool) {
+	if headers, ok := f.HTTPFields.Headers(key); ok {
+		return headers, true
+	}
+
+	return f.HTTPFields.Headers(f.Prefix + key)
+}
+
+func readBody(fn func() ([]byte, error)) (string, error) {
+	if fn == nil {
+		return "", nil
+	}
+
+	body, err := fn()
+	if err != nil {
+		return "", fmt.Errorf("failed to read body: %w", err)
+	}
+
+	return string(body), nil
+}
+
+// Field implements Fields.
+func (f HTTPFields) Field(key string) (string, bool, error) {
+	if strings.HasPrefix(key, "res.") && f.Response == nil {
+		return "", true, nil
+	}
+
+	if extra, ok := f.Extra[key]; ok {
+		return extra.Value(), true, nil
+	}
+
+	if name, ok := strings.CutPrefix(key, "req."); ok {
+		if f.Request == nil {
+			return "", true, nil
+		}
+
+		if fn, ok := httpRequestFieldFns[name]; ok {
+			value, err := fn(f.Request)
+			return value, true, err
+		}
+
+		value, ok := RequestHeaderField(name, f.Request.Header)
+
+		return value, ok, nil
+	}
+
+	if name, ok := strings.CutPrefix(key, "res."); ok {
+		if fn, ok := httpResponseFieldFns[name]; ok {
+			value, err := fn(f.Response)
+			return value, true, err
+		}
+
+		value, ok := ResponseHeaderField(name, f.Response.Header)
+
+		return value, ok, nil
+	}
+
+	return "", false, nil
+}
+
+// Headers implements Fields.
+func (f HTTPFields) Headers(key string) (http.Header, bool) {
+	switch key {
+	case "req.headers":
+		if f.Request == nil {
+			return nil, true
+		}
+		return f.Request.Header, true
+	case "res.headers":
+		if f.Response == nil {
+			return nil, true
+		}
+		return f.Response.Header, true
+	default:
+		return nil, false
+	}
+}
+
+// Text implements Fields. It returns the header lines and fields of the request
+// and response, and the extra fields that aren't numeric.
+func (f HTTPFields) Text() ([]string, error) {
+	var text []string
+
+	if f.Request != nil {
+		text = appendHeaderLines(text, f.Request.Header)
+
+		for _, name := range httpRequestFieldNames {
+			value, err := httpRequestFieldFns[name](f.Request)
+			if err != nil {
+				return nil, err
+			}
+
+			text = append(text, value)
+		}
+	}
+
+	if f.Response != nil {
+		text = appendHeaderLines(text, f.Response.Header)
+
+		for _, name := range httpResponseFieldNames {
+			value, err := httpResponseFieldFns[name](f.Response)
+			if err != nil {
+				return nil, err
+			}
+
+			text = append(text, value)
+		}
+	}
+
+	for _, extra := range f.Extra {
+		if !extra.Numeric {
+			text = append(text, extra.Value())
+		}
+	}
+
+	return text, nil
+}
+
+// MatchTerm implements TermMatcher.
+func (f HTTPFields) MatchTerm(literal string) (bool, bool) {
+	if f.MatchTermFn == nil {
+		return false, false
+	}
+
+	return f.MatchTermFn(literal)
+}
+
+func appendHeaderLines(text []string, headers http.Header) []string {
+	for key, values := range headers {
+		for _, value := range values {
+			text = append(text, fmt.Sprintf("%v: %v", key, value))
+		}
+	}
+
+	return text
+}
@@ -4,8 +4,83 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"strings"
 )
 
+const (
+	// HeaderFieldPrefix is the prefix of fields for the value of a single
+	// header, e.g. `header.Authorization`. Header names are case-insensitive.
+	HeaderFieldPrefix = "header."
+	// CookieFieldPrefix is the prefix of fields for the value of a cookie, e.g.
+	// `cookie.session`. Cookie names are case-sensitive.
+	CookieFieldPrefix = "cookie."
+)
+
+// RequestHeaderField returns the value of a header or cookie field (e.g.
+// `header.Authorization` or `cookie.session`) of request headers. Cookies are
+// read from `Cookie` headers. The boolean is false if the field isn't a header
+// or cookie field.
+func RequestHeaderField(field string, headers http.Header) (string, bool) {
+	if name, ok := strings.CutPrefix(field, HeaderFieldPrefix); ok {
+		return HeaderValue(headers, name), true
+	}
+
+	if name, ok := strings.CutPrefix(field, CookieFieldPrefix); ok {
+		req := http.Request{Header: headers}
+
+		cookie, err := req.Cookie(name)
+		if err != nil {
+			return "", true
+		}
+
+		return cookie.Value, true
+	}
+
+	return "", false
+}
+
+// ResponseHeaderField returns the value of a header or cookie field (e.g.
+// `header.Content-Type` or `cookie.session`) of response headers. Cookies are
+// read from `Set-Cookie` headers. The boolean is false if the field isn't a
+// header or cookie field.
+func ResponseHeaderField(field string, headers http.Header) (string, bool) {
+	if name, ok := strings.CutPrefix(field, HeaderFieldPrefix); ok {
+		return HeaderValue(headers, name), true
+	}
+
+	if name, ok := strings.CutPrefix(field, CookieFieldPrefix); ok {
+		res := http.Response{Header: headers}
+
+		for _, cookie := range res.Cookies() {
+			if cookie.Name == name {
+				return cookie.Value, true
+			}
+		}
+
+		return "", true
+	}
+
+	return "", false
+}
+
+// HeaderValue returns the values of header `name`, joined with a comma. The
+// name is matched case-insensitively, also for headers that aren't in
+// canonical form.
+func HeaderValue(headers http.Header, name string) string {
+	var values []string
+
+	for key, vals := range headers {
+		if strings.EqualFold(key, name) {
+			values = append(values, vals...)
+		}
+	}
+
+	return strings.Join(values, ", ")
+}
+
+// MatchHTTPHeaders matches headers, formatted as `<key>: <value>`, against a
+// string literal or regular expression. For (in)equality, header keys are
+// compared case-insensitively.
 func MatchHTTPHeaders(op TokenType, expr Expression, headers http.Header) (bool, error) {
 	if headers == nil {
 		return false, nil
@@ -21,7 +96,7 @@ func MatchHTTPHeaders(op TokenType, expr Expression, headers http.Header) (bool,
 		// Return `true` if at least one header (<key>: <value>) is equal to the string literal.
 		for key, values := range headers {
 			for _, value := range values {
-				if strLiteral.Value == fmt.Sprintf("%v: %v", key, value) {
+				if headerEqual(strLiteral.Value, key, value) {
 					return true, nil
 				}
 			}
@@ -37,7 +112,7 @@ func MatchHTTPHeaders(op TokenType, expr Expression, headers http.Header) (bool,
 		// Return `true` if none of the headers (<key>: <value>) are equal to the string literal.
 		for key, values := range headers {
 			for _, value := range values {
-				if strLiteral.Value == fmt.Sprintf("%v: %v", key, value) {
+				if headerEqual(strLiteral.Value, key, value) {
 					return false, nil
 				}
 			}
@@ -80,3 +155,11 @@ func MatchHTTPHeaders(op TokenType, expr Expression, headers http.Header) (bool,
 		return false, fmt.Errorf("filter: unsupported operator %q", op.String())
 	}
 }
+
+// headerEqual returns true if `s` equals `<key>: <value>`, with the key compared
+// case-insensitively.
+func headerEqual(s, key, value string) bool {
+	k, v, ok := strings.Cut(s, ": ")
+
+	return ok && strings.EqualFold(k, key) && v == value
+}
@@ -0,0 +1,81 @@
+package filter_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/dstotijn/hetty/pkg/filter"
+)
+
+func TestRequestHeaderField(t *testing.T) {
+	t.Parallel()
+
+	headers := http.Header{
+		"X-Foo":  []string{"a", "b"},
+		"x-bar":  []string{"c"},
+		"Cookie": []string{"theme=dark; session=abc"},
+	}
+
+	tests := []struct {
+		field      string
+		expValue   string
+		expIsField bool
+	}{
+		{field: "header.x-foo", expValue: "a, b", expIsField: true},
+		{field: "header.X-Bar", expValue: "c", expIsField: true},
+		{field: "header.X-Baz", expValue: "", expIsField: true},
+		{field: "cookie.session", expValue: "abc", expIsField: true},
+		{field: "cookie.Session", expValue: "", expIsField: true},
+		{field: "method", expValue: "", expIsField: false},
+	}
+
+	for _, tt := range tests {
+		value, ok := filter.RequestHeaderField(tt.field, headers)
+		if value != tt.expValue || ok != tt.expIsField {
+			t.Errorf("field %q: expected (%q, %v), got: (%q, %v)", tt.field, tt.expValue, tt.expIsField, value, ok)
+		}
+	}
+}
+
+func TestResponseHeaderField(t *testing.T) {
+	t.Parallel()
+
+	headers := http.Header{
+		"Set-Cookie": []string{"session=abc; Path=/; HttpOnly", "theme=dark"},
+	}
+
+	value, ok := filter.ResponseHeaderField("cookie.theme", headers)
+	if value != "dark" || !ok {
+		t.Errorf("expected (\"dark\", true), got: (%q, %v)", value, ok)
+	}
+
+	value, ok = filter.ResponseHeaderField("header.set-cookie", headers)
+	if value != "session=abc; Path=/; HttpOnly, theme=dark" || !ok {
+		t.Errorf("unexpected header value: (%q, %v)", value, ok)
+	}
+}
+
+func TestMatchHTTPHeaders(t *testing.T) {
+	t.Parallel()
+
+	headers := http.Header{"Content-Type": []string{"text/html"}}
+
+	for _, tt := range []struct {
+		op       filter.TokenType
+		value    string
+		expMatch bool
+	}{
+		{op: filter.TokOpEq, value: "content-type: text/html", expMatch: true},
+		{op: filter.TokOpEq, value: "Content-Type: TEXT/HTML", expMatch: false},
+		{op: filter.TokOpNotEq, value: "CONTENT-TYPE: text/html", expMatch: false},
+	} {
+		got, err := filter.MatchHTTPHeaders(tt.op, filter.StringLiteral{Value: tt.value}, headers)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if got != tt.expMatch {
+			t.Errorf("%v %q: expected match %v, got: %v", tt.op, tt.value, tt.expMatch, got)
+		}
+	}
+}
@@ -0,0 +1,197 @@
+// Package har implements the HTTP Archive (HAR) 1.2 format.
+// See: http://www.softwareishard.com/blog/har-12-spec/
+package har
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+)
+
+// Version is the HAR version of archives that are created with New.
+const Version = "1.2"
+
+var ErrInvalidHAR = errors.New("har: invalid HAR")
+
+type HAR struct {
+	Log Log `json:"log"`
+}
+
+type Log struct {
+	Version string   `json:"version"`
+	Creator Creator  `json:"creator"`
+	Browser *Creator `json:"browser,omitempty"`
+	Pages   []Page   `json:"pages,omitempty"`
+	Entries []Entry  `json:"entries"`
+	Comment string   `json:"comment,omitempty"`
+}
+
+type Creator struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+	Comment string `json:"comment,omitempty"`
+}
+
+type Page struct {
+	StartedDateTime time.Time   `json:"startedDateTime"`
+	ID              string      `json:"id"`
+	Title           string      `json:"title"`
+	PageTimings     PageTimings `json:"pageTimings"`
+	Comment         string      `json:"comment,omitempty"`
+}
+
+type PageTimings struct {
+	OnContentLoad *float64 `json:"onContentLoad,omitempty"`
+	OnLoad        *float64 `json:"onLoad,omitempty"`
+	Comment       string   `json:"comment,omitempty"`
+}
+
+type Entry struct {
+	PageRef         string    `json:"pageref,omitempty"`
+	StartedDateTime time.Time `json:"startedDateTime"`
+	// Time is the total elapsed time of the request, in milliseconds.
+	Time            float64  `json:"time"`
+	Request         Request  `json:"request"`
+	Response        Response `json:"response"`
+	Cache           Cache    `json:"cache"`
+	Timings         Timings  `json:"timings"`
+	ServerIPAddress string   `json:"serverIPAddress,omitempty"`
+	Connection      string   `json:"connection,omitempty"`
+	Comment         string   `json:"comment,omitempty"`
+}
+
+type Request struct {
+	Method      string      `json:"method"`
+	URL         string      `json:"url"`
+	HTTPVersion string      `json:"httpVersion"`
+	Cookies     []Cookie    `json:"cookies"`
+	Headers     []NameValue `json:"headers"`
+	QueryString []NameValue `json:"queryString"`
+	PostData    *PostData   `json:"postData,omitempty"`
+	// HeadersSize and BodySize are -1 when unknown.
+	HeadersSize int64  `json:"headersSize"`
+	BodySize    int64  `json:"bodySize"`
+	Comment     string `json:"comment,omitempty"`
+}
+
+type Response struct {
+	Status      int         `json:"status"`
+	StatusText  string      `json:"statusText"`
+	HTTPVersion string      `json:"httpVersion"`
+	Cookies     []Cookie    `json:"cookies"`
+	Headers     []NameValue `json:"headers"`
+	Content     Content     `json:"content"`
+	RedirectURL string      `json:"redirectURL"`
+	// HeadersSize and BodySize are -1 when unknown.
+	HeadersSize int64  `json:"headersSize"`
+	BodySize    int64  `json:"bodySize"`
+	Comment     string `json:"comment,omitempty"`
+}
+
+type Cookie struct {
+	Name     string     `json:"name"`
+	Value    string     `json:"value"`
+	Path     string     `json:"path,omitempty"`
+	Domain   string     `json:"domain,omitempty"`
+	Expires  *time.Time `json:"expires,omitempty"`
+	HTTPOnly bool       `json:"httpOnly,omitempty"`
+	Secure   bool       `json:"secure,omitempty"`
+	Comment  string     `json:"comment,omitempty"`
+}
+
+// NameValue is a header or query string parameter.
+type NameValue struct {
+	Name    string `json:"name"`
+	Value   string `json:"value"`
+	Comment string `json:"comment,omitempty"`
+}
+
+type PostData struct {
+	MimeType string  `json:"mimeType"`
+	Params   []Param `json:"params,omitempty"`
+	Text     string  `json:"text"`
+	Comment  string  `json:"comment,omitempty"`
+}
+
+type Param struct {
+	Name        string `json:"name"`
+	Value       string `json:"value,omitempty"`
+	FileName    string `json:"fileName,omitempty"`
+	ContentType string `json:"contentType,omitempty"`
+	Comment     string `json:"comment,omitempty"`
+}
+
+type Content struct {
+	Size        int64  `json:"size"`
+	Compression int64  `json:"compression,omitempty"`
+	MimeType    string `json:"mimeType"`
+	Text        string `json:"text,omitempty"`
+	// Encoding is `base64` for binary content, and empty otherwise.
+	Encoding string `json:"encoding,omitempty"`
+	Comment  string `json:"comment,omitempty"`
+}
+
+// Cache is always empty for exported entries, as the proxy doesn't cache.
+type Cache struct {
+	Comment string `json:"comment,omitempty"`
+}
+
+// Timings are the durations of the phases of a request, in milliseconds. A
+// value of -1 means the phase doesn't apply to the request.
+type Timings struct {
+	Blocked float64 `json:"blocked"`
+	DNS     float64 `json:"dns"`
+	Connect float64 `json:"connect"`
+	Send    float64 `json:"send"`
+	Wait    float64 `json:"wait"`
+	Receive float64 `json:"receive"`
+	SSL     float64 `json:"ssl"`
+	Comment string  `json:"comment,omitempty"`
+}
+
+// New returns a new HAR with the given creator and entries.
+func New(creator Creator, entries []Entry) HAR {
+	if entries == nil {
+		entries = []Entry{}
+	}
+
+	return HAR{
+		Log: Log{
+			Version: Version,
+			Creator: creator,
+			Entries: entries,
+		},
+	}
+}
+
+// Decode reads and parses a HAR.
+func Decode(r io.Reader) (HAR, error) {
+	var h HAR
+
+	if err := json.NewDecoder(r).Decode(&h); err != nil {
+		return HAR{}, fmt.Errorf("%w: %v", ErrInvalidHAR, err)
+	}
+
+	if h.Log.Version == "" {
+		return HAR{}, fmt.Errorf("%w: missing log version", ErrInvalidHAR)
+	}
+
+	return h, nil
+}
+
+// Milliseconds returns a duration in milliseconds.
+func Milliseconds(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
+
+// Duration returns the duration of a number of milliseconds. Negative values
+// (i.e. -1, for phases that don't apply) return zero.
+func Duration(ms float64) time.Duration {
+	if ms <= 0 {
+		return 0
+	}
+
+	return time.Duration(ms * float64(time.Millisecond))
+}
@@ -0,0 +1,57 @@
+package har_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dstotijn/hetty/pkg/har"
+)
+
+func TestDecode(t *testing.T) {
+	t.Parallel()
+
+	h, err := har.Decode(strings.NewReader(`{
+		"log": {
+			"version": "1.2",
+			"creator": {"name": "foo", "version": "1.0"},
+			"entries": [{
+				"startedDateTime": "2021-02-03T04:05:06.007+01:00",
+				"time": 12.5,
+				"request": {"method": "GET", "url": "https://example.com/", "httpVersion": "HTTP/1.1"},
+				"response": {"status": 200, "statusText": "OK", "content": {"size": 3, "mimeType": "text/plain", "text": "foo"}},
+				"cache": {},
+				"timings": {"send": 0, "wait": 10, "receive": 2.5}
+			}]
+		}
+	}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(h.Log.Entries) != 1 {
+		t.Fatalf("expected 1 entry, got: %v", len(h.Log.Entries))
+	}
+
+	entry := h.Log.Entries[0]
+
+	exp := time.Date(2021, 2, 3, 3, 5, 6, 7_000_000, time.UTC)
+	if !entry.StartedDateTime.Equal(exp) {
+		t.Errorf("expected start time %v, got: %v", exp, entry.StartedDateTime)
+	}
+
+	if got := har.Duration(entry.Time); got != 12500*time.Microsecond {
+		t.Errorf("expected time 12.5ms, got: %v", got)
+	}
+}
+
+func TestDecodeInvalid(t *testing.T) {
+	t.Parallel()
+
+	for _, input := range []string{`foobar`, `{"log": {"entries": []}}`} {
+		if _, err := har.Decode(strings.NewReader(input)); !errors.Is(err, har.ErrInvalidHAR) {
+			t.Errorf("expected `har.ErrInvalidHAR` for %q, got: %v", input, err)
+		}
+	}
+}
@@ -12,7 +12,14 @@ import (
 	"github.com/oklog/ulid"
 
 	"github.com/dstotijn/hetty/pkg/filter"
+	"github.com/dstotijn/hetty/pkg/proxy"
+	"github.com/dstotijn/hetty/pkg/proxy/clientcert"
 	"github.com/dstotijn/hetty/pkg/proxy/intercept"
+	"github.com/dstotijn/hetty/pkg/proxy/maprule"
+	"github.com/dstotijn/hetty/pkg/proxy/matchreplace"
+	"github.com/dstotijn/hetty/pkg/proxy/script"
+	"github.com/dstotijn/hetty/pkg/proxy/throttle"
+	"github.com/dstotijn/hetty/pkg/proxy/upstream"
 	"github.com/dstotijn/hetty/pkg/reqlog"
 	"github.com/dstotijn/hetty/pkg/scope"
 	"github.com/dstotijn/hetty/pkg/sender"
@@ -26,6 +33,13 @@ type Service struct {
 	interceptSvc    *intercept.Service
 	reqLogSvc       *reqlog.Service
 	senderSvc       *sender.Service
+	proxy           *proxy.Proxy
+	upstream        *upstream.Dialer
+	clientCerts     *clientcert.Store
+	mapRules        *maprule.Engine
+	matchReplaceSvc *matchreplace.Service
+	scriptSvc       *script.Service
+	throttleSvc     *throttle.Service
 	scope           *scope.Scope
 	activeProjectID ulid.ULID
 	mu              sync.RWMutex
@@ -44,10 +58,13 @@ type Settings struct {
 	ReqLogBypassOutOfScope bool
 	ReqLogOnlyFindInScope  bool
 	ReqLogSearchExpr       filter.Expression
+	ReqLogMaxBodySize      int64
+	ReqLogFullTextIndex    bool
 
 	// Intercept settings
 	InterceptRequests       bool
 	InterceptResponses      bool
+	InterceptWebSockets     bool
 	InterceptRequestFilter  filter.Expression
 	InterceptResponseFilter filter.Expression
 
@@ -57,6 +74,30 @@ type Settings struct {
 
 	// Scope settings
 	ScopeRules []scope.Rule
+
+	// Proxy settings
+	ProxyDowngradeHTTP2       bool
+	ProxyTLSPassthrough       []proxy.PassthroughRule
+	ProxyDisableDecompression bool
+	UpstreamProxy             upstream.Settings
+	// ProxyModifiers is the order and enabled state of the proxy's modifiers.
+	ProxyModifiers []proxy.Modifier
+
+	// Client certificates
+	ClientCerts []clientcert.Certificate
+
+	// Map Remote and Map Local rules
+	MapRemoteRules []maprule.RemoteRule
+	MapLocalRules  []maprule.LocalRule
+
+	// Match and replace rules
+	MatchReplaceRules []matchreplace.Rule
+
+	// Scripts
+	Scripts []script.Script
+
+	// Network throttling rules
+	ThrottleRules []throttle.Rule
 }
 
 var (
@@ -64,6 +105,7 @@ var (
 	ErrNoProject       = errors.New("proj: no open project")
 	ErrNoSettings      = errors.New("proj: settings not found")
 	ErrInvalidName     = errors.New("proj: invalid name, must be alphanumeric or whitespace chars")
+	ErrInvalidBodySize = errors.New("proj: invalid max body size, must not be negative")
 )
 
 var nameRegexp = regexp.MustCompile(`^[\w\d\s]+$`)
@@ -73,17 +115,31 @@ type Config struct {
 	InterceptService *intercept.Service
 	ReqLogService    *reqlog.Service
 	SenderService    *sender.Service
+	Proxy            *proxy.Proxy
+	Upstream         *upstream.Dialer
+	ClientCerts      *clientcert.Store
+	MapRules         *maprule.Engine
+	MatchReplace     *matchreplace.Service
+	Scripts          *script.Service
+	Throttle         *throttle.Service
 	Scope            *scope.Scope
 }
 
 // NewService returns a new Service.
 func NewService(cfg Config) (*Service, error) {
 	return &Service{
-		repo:         cfg.Repository,
-		interceptSvc: cfg.InterceptService,
-		reqLogSvc:    cfg.ReqLogService,
-		senderSvc:    cfg.SenderService,
-		scope:        cfg.Scope,
+		repo:            cfg.Repository,
+		interceptSvc:    cfg.InterceptService,
+		reqLogSvc:       cfg.ReqLogService,
+		senderSvc:       cfg.SenderService,
+		proxy:           cfg.Proxy,
+		upstream:        cfg.Upstream,
+		clientCerts:     cfg.ClientCerts,
+		mapRules:        cfg.MapRules,
+		matchReplaceSvc: cfg.MatchReplace,
+		scriptSvc:       cfg.Scripts,
+		throttleSvc:     cfg.Throttle,
+		scope:           cfg.Scope,
 	}, nil
 }
 
@@ -117,16 +173,28 @@ func (svc *Service) CloseProject() error {
 	svc.activeProjectID = ulid.ULID{}
 	svc.reqLogSvc.SetActiveProjectID(ulid.ULID{})
 	svc.reqLogSvc.SetBypassOutOfScopeRequests(false)
+	svc.reqLogSvc.SetMaxBodySize(0)
 	svc.reqLogSvc.SetFindReqsFilter(reqlog.FindRequestsFilter{})
 	svc.interceptSvc.UpdateSettings(intercept.Settings{
-		RequestsEnabled:  false,
-		ResponsesEnabled: false,
-		RequestFilter:    nil,
-		ResponseFilter:   nil,
+		RequestsEnabled:   false,
+		ResponsesEnabled:  false,
+		WebSocketsEnabled: false,
+		RequestFilter:     nil,
+		ResponseFilter:    nil,
 	})
 	svc.senderSvc.SetActiveProjectID(ulid.ULID{})
 	svc.senderSvc.SetFindReqsFilter(sender.FindRequestsFilter{})
 	svc.scope.SetRules(nil)
+	svc.proxy.UpdateSettings(proxy.Settings{})
+	svc.proxy.ResetModifiers()
+	svc.upstream.UpdateSettings(upstream.Settings{})
+	_ = svc.clientCerts.SetCertificates(nil)
+	svc.mapRules.SetRemoteRules(nil)
+	svc.mapRules.SetLocalRules(nil)
+	_ = svc.matchReplaceSvc.SetRules(nil)
+	svc.matchReplaceSvc.SetMaxBodySize(0)
+	_ = svc.scriptSvc.SetScripts(nil)
+	_ = svc.throttleSvc.SetRules(nil)
 
 	return nil
 }
@@ -154,6 +222,44 @@ func (svc *Service) OpenProject(ctx context.Context, projectID ulid.ULID) (Proje
 		return Project{}, fmt.Errorf("proj: failed to get project: %w", err)
 	}
 
+	// Client certificates, match and replace rules, scripts and throttle rules
+	// are loaded, and the full-text index is built if needed, first, so the
+	// project isn't partially opened when these fail.
+	err = svc.clientCerts.SetCertificates(project.Settings.ClientCerts)
+	if err != nil {
+		return Project{}, fmt.Errorf("proj: failed to load client certificates: %w", err)
+	}
+
+	err = svc.matchReplaceSvc.SetRules(project.Settings.MatchReplaceRules)
+	if err != nil {
+		_ = svc.clientCerts.SetCertificates(nil)
+		return Project{}, fmt.Errorf("proj: failed to load match and replace rules: %w", err)
+	}
+
+	err = svc.scriptSvc.SetScripts(project.Settings.Scripts)
+	if err != nil {
+		_ = svc.clientCerts.SetCertificates(nil)
+		_ = svc.matchReplaceSvc.SetRules(nil)
+		return Project{}, fmt.Errorf("proj: failed to load scripts: %w", err)
+	}
+
+	err = svc.throttleSvc.SetRules(project.Settings.ThrottleRules)
+	if err != nil {
+		_ = svc.clientCerts.SetCertificates(nil)
+		_ = svc.matchReplaceSvc.SetRules(nil)
+		_ = svc.scriptSvc.SetScripts(nil)
+		return Project{}, fmt.Errorf("proj: failed to load throttle rules: %w", err)
+	}
+
+	err = svc.reqLogSvc.SetFullTextIndex(ctx, project.ID, project.Settings.ReqLogFullTextIndex)
+	if err != nil {
+		_ = svc.clientCerts.SetCertificates(nil)
+		_ = svc.matchReplaceSvc.SetRules(nil)
+		_ = svc.scriptSvc.SetScripts(nil)
+		_ = svc.throttleSvc.SetRules(nil)
+		return Project{}, fmt.Errorf("proj: failed to set up full-text index: %w", err)
+	}
+
 	svc.activeProjectID = project.ID
 
 	// Request log settings.
@@ -163,14 +269,19 @@ func (svc *Service) OpenProject(ctx context.Context, projectID ulid.ULID) (Proje
 		SearchExpr:  project.Settings.ReqLogSearchExpr,
 	})
 	svc.reqLogSvc.SetBypassOutOfScopeRequests(project.Settings.ReqLogBypassOutOfScope)
+	svc.reqLogSvc.SetMaxBodySize(project.Settings.ReqLogMaxBodySize)
 	svc.reqLogSvc.SetActiveProjectID(project.ID)
 
+	// Match and replace rules aren't applied to bodies that are too large to log.
+	svc.matchReplaceSvc.SetMaxBodySize(project.Settings.ReqLogMaxBodySize)
+
 	// Intercept settings.
 	svc.interceptSvc.UpdateSettings(intercept.Settings{
-		RequestsEnabled:  project.Settings.InterceptRequests,
-		ResponsesEnabled: project.Settings.InterceptResponses,
-		RequestFilter:    project.Settings.InterceptRequestFilter,
-		ResponseFilter:   project.Settings.InterceptResponseFilter,
+		RequestsEnabled:   project.Settings.InterceptRequests,
+		ResponsesEnabled:  project.Settings.InterceptResponses,
+		WebSocketsEnabled: project.Settings.InterceptWebSockets,
+		RequestFilter:     project.Settings.InterceptRequestFilter,
+		ResponseFilter:    project.Settings.InterceptResponseFilter,
 	})
 
 	// Sender settings.
@@ -184,9 +295,40 @@ func (svc *Service) OpenProject(ctx context.Context, projectID ulid.ULID) (Proje
 	// Scope settings.
 	svc.scope.SetRules(project.Settings.ScopeRules)
 
+	// Proxy settings.
+	svc.proxy.UpdateSettings(proxySettings(project.Settings))
+	svc.upstream.UpdateSettings(project.Settings.UpstreamProxy)
+	svc.setProxyModifiers(project.Settings.ProxyModifiers)
+
+	// Map Remote and Map Local rules.
+	svc.mapRules.SetRemoteRules(project.Settings.MapRemoteRules)
+	svc.mapRules.SetLocalRules(project.Settings.MapLocalRules)
+
 	return project, nil
 }
 
+// setProxyModifiers resets the proxy's modifiers, and applies the stored
+// modifiers that (still) exist.
+func (svc *Service) setProxyModifiers(modifiers []proxy.Modifier) {
+	svc.proxy.ResetModifiers()
+
+	exists := make(map[proxy.Modifier]bool)
+	for _, mod := range svc.proxy.Modifiers() {
+		exists[proxy.Modifier{Kind: mod.Kind, Name: mod.Name}] = true
+	}
+
+	known := make([]proxy.Modifier, 0, len(modifiers))
+
+	for _, mod := range modifiers {
+		if exists[proxy.Modifier{Kind: mod.Kind, Name: mod.Name}] {
+			known = append(known, mod)
+		}
+	}
+
+	// Modifiers were filtered, so this can't fail.
+	_ = svc.proxy.SetModifiers(known)
+}
+
 func (svc *Service) ActiveProject(ctx context.Context) (Project, error) {
 	activeProjectID := svc.activeProjectID
 	if activeProjectID.Compare(ulid.ULID{}) == 0 {
@@ -255,6 +397,57 @@ func (svc *Service) SetRequestLogFindFilter(ctx context.Context, filter reqlog.F
 	return nil
 }
 
+// SetRequestLogMaxBodySize sets the maximum number of bytes of request and
+// response bodies that are logged (and that match and replace rules are applied
+// to) for the active project. When `size` is zero,
+// `reqlog.DefaultMaxBodySize` is used.
+func (svc *Service) SetRequestLogMaxBodySize(ctx context.Context, size int64) error {
+	if size < 0 {
+		return ErrInvalidBodySize
+	}
+
+	project, err := svc.ActiveProject(ctx)
+	if err != nil {
+		return err
+	}
+
+	project.Settings.ReqLogMaxBodySize = size
+
+	err = svc.repo.UpsertProject(ctx, project)
+	if err != nil {
+		return fmt.Errorf("proj: failed to update project: %w", err)
+	}
+
+	svc.reqLogSvc.SetMaxBodySize(size)
+	svc.matchReplaceSvc.SetMaxBodySize(size)
+
+	return nil
+}
+
+// SetRequestLogFullTextIndex enables or disables the full-text index of request
+// and response bodies for the active project. When enabled, the index is built
+// from existing request logs.
+func (svc *Service) SetRequestLogFullTextIndex(ctx context.Context, enabled bool) error {
+	project, err := svc.ActiveProject(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = svc.reqLogSvc.SetFullTextIndex(ctx, project.ID, enabled)
+	if err != nil {
+		return fmt.Errorf("proj: failed to set full-text index: %w", err)
+	}
+
+	project.Settings.ReqLogFullTextIndex = enabled
+
+	err = svc.repo.UpsertProject(ctx, project)
+	if err != nil {
+		return fmt.Errorf("proj: failed to update project: %w", err)
+	}
+
+	return nil
+}
+
 func (svc *Service) SetSenderRequestFindFilter(ctx context.Context, filter sender.FindRequestsFilter) error {
 	project, err := svc.ActiveProject(ctx)
 	if err != nil {
@@ -288,6 +481,7 @@ func (svc *Service) UpdateInterceptSettings(ctx context.Context, settings interc
 
 	project.Settings.InterceptRequests = settings.RequestsEnabled
 	project.Settings.InterceptResponses = settings.ResponsesEnabled
+	project.Settings.InterceptWebSockets = settings.WebSocketsEnabled
 	project.Settings.InterceptRequestFilter = settings.RequestFilter
 	project.Settings.InterceptResponseFilter = settings.ResponseFilter
 
@@ -300,3 +494,341 @@ func (svc *Service) UpdateInterceptSettings(ctx context.Context, settings interc
 
 	return nil
 }
+
+// UpdateProxySettings updates the proxy settings of the active project. TLS
+// passthrough rules are ignored, these are set with SetTLSPassthroughRules.
+func (svc *Service) UpdateProxySettings(ctx context.Context, settings proxy.Settings) error {
+	project, err := svc.ActiveProject(ctx)
+	if err != nil {
+		return err
+	}
+
+	project.Settings.ProxyDowngradeHTTP2 = settings.DowngradeHTTP2
+	project.Settings.ProxyDisableDecompression = settings.DisableDecompression
+
+	err = svc.repo.UpsertProject(ctx, project)
+	if err != nil {
+		return fmt.Errorf("proj: failed to update project: %w", err)
+	}
+
+	svc.proxy.UpdateSettings(proxySettings(project.Settings))
+
+	return nil
+}
+
+func (svc *Service) SetTLSPassthroughRules(ctx context.Context, rules []proxy.PassthroughRule) error {
+	project, err := svc.ActiveProject(ctx)
+	if err != nil {
+		return err
+	}
+
+	project.Settings.ProxyTLSPassthrough = rules
+
+	err = svc.repo.UpsertProject(ctx, project)
+	if err != nil {
+		return fmt.Errorf("proj: failed to update project: %w", err)
+	}
+
+	svc.proxy.UpdateSettings(proxySettings(project.Settings))
+
+	return nil
+}
+
+// SetProxyModifiers reorders and enables or disables the proxy's modifiers for
+// the active project. It returns all modifiers, in chain order.
+func (svc *Service) SetProxyModifiers(ctx context.Context, modifiers []proxy.Modifier) ([]proxy.Modifier, error) {
+	project, err := svc.ActiveProject(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := svc.proxy.SetModifiers(modifiers); err != nil {
+		return nil, err
+	}
+
+	prevModifiers := project.Settings.ProxyModifiers
+	project.Settings.ProxyModifiers = svc.proxy.Modifiers()
+
+	err = svc.repo.UpsertProject(ctx, project)
+	if err != nil {
+		svc.setProxyModifiers(prevModifiers)
+		return nil, fmt.Errorf("proj: failed to update project: %w", err)
+	}
+
+	return project.Settings.ProxyModifiers, nil
+}
+
+func proxySettings(settings Settings) proxy.Settings {
+	return proxy.Settings{
+		DowngradeHTTP2:       settings.ProxyDowngradeHTTP2,
+		TLSPassthrough:       settings.ProxyTLSPassthrough,
+		DisableDecompression: settings.ProxyDisableDecompression,
+	}
+}
+
+func (svc *Service) UpdateUpstreamProxySettings(ctx context.Context, settings upstream.Settings) error {
+	if err := settings.Validate(); err != nil {
+		return fmt.Errorf("proj: invalid upstream proxy settings: %w", err)
+	}
+
+	project, err := svc.ActiveProject(ctx)
+	if err != nil {
+		return err
+	}
+
+	project.Settings.UpstreamProxy = settings
+
+	err = svc.repo.UpsertProject(ctx, project)
+	if err != nil {
+		return fmt.Errorf("proj: failed to update project: %w", err)
+	}
+
+	svc.upstream.UpdateSettings(settings)
+
+	return nil
+}
+
+func (svc *Service) ClientCertificates(ctx context.Context) ([]clientcert.Certificate, error) {
+	project, err := svc.ActiveProject(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return project.Settings.ClientCerts, nil
+}
+
+// AddClientCertificate adds a client certificate to the active project. It
+// returns the certificate with its ID set.
+func (svc *Service) AddClientCertificate(ctx context.Context, cert clientcert.Certificate) (clientcert.Certificate, error) {
+	if err := clientcert.ValidateHosts(cert.Hosts); err != nil {
+		return clientcert.Certificate{}, err
+	}
+
+	project, err := svc.ActiveProject(ctx)
+	if err != nil {
+		return clientcert.Certificate{}, err
+	}
+
+	cert.ID = ulid.MustNew(ulid.Timestamp(time.Now()), ulidEntropy)
+	certs := make([]clientcert.Certificate, 0, len(project.Settings.ClientCerts)+1)
+	certs = append(certs, project.Settings.ClientCerts...)
+	certs = append(certs, cert)
+
+	if err := svc.setClientCertificates(ctx, project, certs); err != nil {
+		return clientcert.Certificate{}, err
+	}
+
+	return cert, nil
+}
+
+func (svc *Service) DeleteClientCertificate(ctx context.Context, id ulid.ULID) error {
+	project, err := svc.ActiveProject(ctx)
+	if err != nil {
+		return err
+	}
+
+	certs := make([]clientcert.Certificate, 0, len(project.Settings.ClientCerts))
+
+	for _, cert := range project.Settings.ClientCerts {
+		if cert.ID.Compare(id) != 0 {
+			certs = append(certs, cert)
+		}
+	}
+
+	if len(certs) == len(project.Settings.ClientCerts) {
+		return clientcert.ErrCertificateNotFound
+	}
+
+	return svc.setClientCertificates(ctx, project, certs)
+}
+
+func (svc *Service) setClientCertificates(ctx context.Context, project Project, certs []clientcert.Certificate) error {
+	if err := svc.clientCerts.SetCertificates(certs); err != nil {
+		return err
+	}
+
+	prevCerts := project.Settings.ClientCerts
+	project.Settings.ClientCerts = certs
+
+	err := svc.repo.UpsertProject(ctx, project)
+	if err != nil {
+		// Restore the previous certificates, which were valid.
+		_ = svc.clientCerts.SetCertificates(prevCerts)
+		return fmt.Errorf("proj: failed to update project: %w", err)
+	}
+
+	return nil
+}
+
+func (svc *Service) MapRules() *maprule.Engine {
+	return svc.mapRules
+}
+
+// SetMapRemoteRules replaces the Map Remote rules of the active project. Rules
+// without an ID are assigned one. It returns the stored rules.
+func (svc *Service) SetMapRemoteRules(ctx context.Context, rules []maprule.RemoteRule) ([]maprule.RemoteRule, error) {
+	for i := range rules {
+		if err := rules[i].Validate(); err != nil {
+			return nil, err
+		}
+
+		if rules[i].ID.Compare(ulid.ULID{}) == 0 {
+			rules[i].ID = ulid.MustNew(ulid.Timestamp(time.Now()), ulidEntropy)
+		}
+	}
+
+	project, err := svc.ActiveProject(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	project.Settings.MapRemoteRules = rules
+
+	err = svc.repo.UpsertProject(ctx, project)
+	if err != nil {
+		return nil, fmt.Errorf("proj: failed to update project: %w", err)
+	}
+
+	svc.mapRules.SetRemoteRules(rules)
+
+	return rules, nil
+}
+
+// SetMapLocalRules replaces the Map Local rules of the active project. Rules
+// without an ID are assigned one. It returns the stored rules.
+func (svc *Service) SetMapLocalRules(ctx context.Context, rules []maprule.LocalRule) ([]maprule.LocalRule, error) {
+	for i := range rules {
+		if err := rules[i].Validate(); err != nil {
+			return nil, err
+		}
+
+		if rules[i].ID.Compare(ulid.ULID{}) == 0 {
+			rules[i].ID = ulid.MustNew(ulid.Timestamp(time.Now()), ulidEntropy)
+		}
+	}
+
+	project, err := svc.ActiveProject(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	project.Settings.MapLocalRules = rules
+
+	err = svc.repo.UpsertProject(ctx, project)
+	if err != nil {
+		return nil, fmt.Errorf("proj: failed to update project: %w", err)
+	}
+
+	svc.mapRules.SetLocalRules(rules)
+
+	return rules, nil
+}
+
+// SetMatchReplaceRules replaces the match and replace rules of the active
+// project. Rules without an ID are assigned one. It returns the stored rules.
+func (svc *Service) SetMatchReplaceRules(ctx context.Context, rules []matchreplace.Rule) ([]matchreplace.Rule, error) {
+	for i := range rules {
+		if rules[i].ID.Compare(ulid.ULID{}) == 0 {
+			rules[i].ID = ulid.MustNew(ulid.Timestamp(time.Now()), ulidEntropy)
+		}
+	}
+
+	project, err := svc.ActiveProject(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	prevRules := project.Settings.MatchReplaceRules
+
+	if err := svc.matchReplaceSvc.SetRules(rules); err != nil {
+		return nil, err
+	}
+
+	project.Settings.MatchReplaceRules = rules
+
+	err = svc.repo.UpsertProject(ctx, project)
+	if err != nil {
+		// Restore the previous rules, which were valid.
+		_ = svc.matchReplaceSvc.SetRules(prevRules)
+		return nil, fmt.Errorf("proj: failed to update project: %w", err)
+	}
+
+	return rules, nil
+}
+
+func (svc *Service) MatchReplace() *matchreplace.Service {
+	return svc.matchReplaceSvc
+}
+
+// SetScripts replaces the scripts of the active project. Scripts are reloaded
+// right away, so their hooks apply to the next proxied request. Scripts
+// without an ID are assigned one. It returns the stored scripts.
+func (svc *Service) SetScripts(ctx context.Context, scripts []script.Script) ([]script.Script, error) {
+	for i := range scripts {
+		if scripts[i].ID.Compare(ulid.ULID{}) == 0 {
+			scripts[i].ID = ulid.MustNew(ulid.Timestamp(time.Now()), ulidEntropy)
+		}
+	}
+
+	project, err := svc.ActiveProject(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	prevScripts := project.Settings.Scripts
+
+	if err := svc.scriptSvc.SetScripts(scripts); err != nil {
+		return nil, err
+	}
+
+	project.Settings.Scripts = scripts
+
+	err = svc.repo.UpsertProject(ctx, project)
+	if err != nil {
+		// Restore the previous scripts, which were valid.
+		_ = svc.scriptSvc.SetScripts(prevScripts)
+		return nil, fmt.Errorf("proj: failed to update project: %w", err)
+	}
+
+	return scripts, nil
+}
+
+func (svc *Service) Scripts() *script.Service {
+	return svc.scriptSvc
+}
+
+// SetThrottleRules replaces the network throttling rules of the active project.
+// Rules without an ID are assigned one. It returns the stored rules.
+func (svc *Service) SetThrottleRules(ctx context.Context, rules []throttle.Rule) ([]throttle.Rule, error) {
+	for i := range rules {
+		if rules[i].ID.Compare(ulid.ULID{}) == 0 {
+			rules[i].ID = ulid.MustNew(ulid.Timestamp(time.Now()), ulidEntropy)
+		}
+	}
+
+	project, err := svc.ActiveProject(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	prevRules := project.Settings.ThrottleRules
+
+	if err := svc.throttleSvc.SetRules(rules); err != nil {
+		return nil, err
+	}
+
+	project.Settings.ThrottleRules = rules
+
+	err = svc.repo.UpsertProject(ctx, project)
+	if err != nil {
+		// Restore the previous rules, which were valid.
+		_ = svc.throttleSvc.SetRules(prevRules)
+		return nil, fmt.Errorf("proj: failed to update project: %w", err)
+	}
+
+	return rules, nil
+}
+
+func (svc *Service) Throttle() *throttle.Service {
+	return svc.throttleSvc
+}
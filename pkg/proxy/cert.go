@@ -3,6 +3,8 @@ package proxy
 import (
 	"bytes"
 	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
 	"crypto/rand"
 	"crypto/rsa"
 	"crypto/sha1"
@@ -16,7 +18,10 @@ import (
 	"net"
 	"os"
 	"path/filepath"
+	"strings"
 	"time"
+
+	"golang.org/x/net/publicsuffix"
 )
 
 // MaxSerialNumber is the upper boundary that is used to create unique serial
@@ -24,41 +29,91 @@ import (
 // bytes (2^(8*20)-1).
 var MaxSerialNumber = big.NewInt(0).SetBytes(bytes.Repeat([]byte{255}, 20))
 
+const (
+	// leafCertValidity is the validity period of generated leaf certificates.
+	leafCertValidity = 7 * 24 * time.Hour
+	// leafCertRenewBefore is the period before expiry in which cached leaf
+	// certificates are no longer used, and a new one is generated.
+	leafCertRenewBefore = time.Hour
+	// leafCertCacheSize is the max amount of cached leaf certificates.
+	leafCertCacheSize = 1024
+)
+
+// KeyType is the type of private key used for generated leaf certificates.
+type KeyType int
+
+const (
+	KeyTypeRSA KeyType = iota
+	KeyTypeECDSA
+)
+
 // CertConfig is a set of configuration values that are used to build TLS configs
 // capable of MITM.
 type CertConfig struct {
 	ca     *x509.Certificate
 	caPriv crypto.PrivateKey
-	priv   *rsa.PrivateKey
-	keyID  []byte
+	keys   map[KeyType]leafKey
+
+	// certs caches generated leaf certificates, keyed by the name (a hostname,
+	// IP address or wildcard name) and key type.
+	certs *lruCache[leafCertKey, *tls.Certificate]
+	// parents maps a parent domain to the first subdomain a certificate was
+	// generated for, so a wildcard certificate can be generated when another
+	// subdomain of the same parent is seen.
+	parents *lruCache[string, string]
+}
+
+type leafKey struct {
+	priv  crypto.Signer
+	keyID []byte
+}
+
+type leafCertKey struct {
+	name    string
+	keyType KeyType
 }
 
 // NewCertConfig creates a MITM config using the CA certificate and
 // private key to generate on-the-fly certificates.
 func NewCertConfig(ca *x509.Certificate, caPrivKey crypto.PrivateKey) (*CertConfig, error) {
-	priv, err := rsa.GenerateKey(rand.Reader, 2048)
+	rsaPriv, err := rsa.GenerateKey(rand.Reader, 2048)
 	if err != nil {
 		return nil, err
 	}
 
-	pub := priv.Public()
-
-	// Subject Key Identifier support for end entity certificate.
-	// https://www.ietf.org/rfc/rfc3280.txt (section 4.2.1.2)
-	pkixPubKey, err := x509.MarshalPKIXPublicKey(pub)
+	ecdsaPriv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
 	if err != nil {
 		return nil, err
 	}
 
-	h := sha1.New()
-	h.Write(pkixPubKey)
-	keyID := h.Sum(nil)
+	keys := make(map[KeyType]leafKey, 2)
+
+	for keyType, priv := range map[KeyType]crypto.Signer{
+		KeyTypeRSA:   rsaPriv,
+		KeyTypeECDSA: ecdsaPriv,
+	} {
+		// Subject Key Identifier support for end entity certificate.
+		// https://www.ietf.org/rfc/rfc3280.txt (section 4.2.1.2)
+		pkixPubKey, err := x509.MarshalPKIXPublicKey(priv.Public())
+		if err != nil {
+			return nil, err
+		}
+
+		h := sha1.New()
+		h.Write(pkixPubKey)
+
+		keys[keyType] = leafKey{
+			priv:  priv,
+			keyID: h.Sum(nil),
+		}
+	}
 
 	return &CertConfig{
-		ca:     ca,
-		caPriv: caPrivKey,
-		priv:   priv,
-		keyID:  keyID,
+		ca:      ca,
+		caPriv:  caPrivKey,
+		keys:    keys,
+		certs:   newLRUCache[leafCertKey, *tls.Certificate](leafCertCacheSize),
+		parents: newLRUCache[string, string](leafCertCacheSize),
 	}, nil
 }
 
@@ -203,46 +258,134 @@ func (c *CertConfig) TLSConfig() *tls.Config {
 				return nil, errors.New("missing server name (SNI)")
 			}
 
-			return c.cert(clientHello.ServerName)
+			return c.certForClient(clientHello, clientHello.ServerName)
 		},
 		MinVersion: tls.VersionTLS12,
-		NextProtos: []string{"http/1.1"},
+		NextProtos: []string{"h2", "http/1.1"},
+	}
+}
+
+// certForClient returns a certificate for hostname. An ECDSA certificate is
+// preferred, with a fallback to RSA for clients that don't support it.
+func (c *CertConfig) certForClient(clientHello *tls.ClientHelloInfo, hostname string) (*tls.Certificate, error) {
+	cert, err := c.cert(hostname, KeyTypeECDSA)
+	if err != nil {
+		return nil, err
+	}
+
+	if clientHello.SupportsCertificate(cert) == nil {
+		return cert, nil
 	}
+
+	return c.cert(hostname, KeyTypeRSA)
 }
 
-func (c *CertConfig) cert(hostname string) (*tls.Certificate, error) {
+// cert returns a (cached) certificate for hostname. When certificates were
+// requested for multiple subdomains of the same parent domain, a wildcard
+// certificate is returned.
+func (c *CertConfig) cert(hostname string, keyType KeyType) (*tls.Certificate, error) {
 	// Remove the port if it exists.
 	host, _, err := net.SplitHostPort(hostname)
 	if err == nil {
 		hostname = host
 	}
 
+	hostname = strings.ToLower(hostname)
+
+	if cert, ok := c.cachedCert(leafCertKey{hostname, keyType}); ok {
+		return cert, nil
+	}
+
+	parent, ok := wildcardParent(hostname)
+	if !ok {
+		return c.newCachedCert(leafCertKey{hostname, keyType}, []string{hostname})
+	}
+
+	wildcard := "*." + parent
+
+	if cert, ok := c.cachedCert(leafCertKey{wildcard, keyType}); ok {
+		return cert, nil
+	}
+
+	if first, ok := c.parents.Get(parent); ok && first != hostname {
+		return c.newCachedCert(leafCertKey{wildcard, keyType}, []string{wildcard, parent})
+	}
+
+	c.parents.Add(parent, hostname)
+
+	return c.newCachedCert(leafCertKey{hostname, keyType}, []string{hostname})
+}
+
+// cachedCert returns a cached certificate, unless it's (about to be) expired.
+func (c *CertConfig) cachedCert(key leafCertKey) (*tls.Certificate, bool) {
+	cert, ok := c.certs.Get(key)
+	if !ok {
+		return nil, false
+	}
+
+	if time.Now().After(cert.Leaf.NotAfter.Add(-leafCertRenewBefore)) {
+		c.certs.Remove(key)
+		return nil, false
+	}
+
+	return cert, true
+}
+
+func (c *CertConfig) newCachedCert(key leafCertKey, names []string) (*tls.Certificate, error) {
+	cert, err := c.newCert(names, key.keyType)
+	if err != nil {
+		return nil, err
+	}
+
+	c.certs.Add(key, cert)
+
+	return cert, nil
+}
+
+// newCert creates a certificate, signed by the CA, that is valid for `names`
+// (hostnames, wildcard names or IP addresses). The first name is used as the
+// common name.
+func (c *CertConfig) newCert(names []string, keyType KeyType) (*tls.Certificate, error) {
+	key := c.keys[keyType]
+
 	serial, err := rand.Int(rand.Reader, MaxSerialNumber)
 	if err != nil {
 		return nil, err
 	}
 
+	notAfter := time.Now().Add(leafCertValidity)
+	if notAfter.After(c.ca.NotAfter) {
+		notAfter = c.ca.NotAfter
+	}
+
+	keyUsage := x509.KeyUsageDigitalSignature
+	if keyType == KeyTypeRSA {
+		keyUsage |= x509.KeyUsageKeyEncipherment
+	}
+
 	tmpl := &x509.Certificate{
 		SerialNumber: serial,
 		Subject: pkix.Name{
-			CommonName:   hostname,
+			CommonName:   names[0],
 			Organization: []string{"Hetty"},
 		},
-		SubjectKeyId:          c.keyID,
-		KeyUsage:              x509.KeyUsageKeyEncipherment | x509.KeyUsageDigitalSignature,
+		SubjectKeyId:          key.keyID,
+		KeyUsage:              keyUsage,
 		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
 		BasicConstraintsValid: true,
 		NotBefore:             time.Now().Add(-24 * time.Hour),
-		NotAfter:              time.Now().Add(24 * time.Hour),
+		NotAfter:              notAfter,
 	}
 
-	if ip := net.ParseIP(hostname); ip != nil {
-		tmpl.IPAddresses = []net.IP{ip}
-	} else {
-		tmpl.DNSNames = []string{hostname}
+	for _, name := range names {
+		if ip := net.ParseIP(name); ip != nil {
+			tmpl.IPAddresses = append(tmpl.IPAddresses, ip)
+		} else {
+			tmpl.DNSNames = append(tmpl.DNSNames, name)
+		}
 	}
 
-	raw, err := x509.CreateCertificate(rand.Reader, tmpl, c.ca, c.priv.Public(), c.caPriv)
+	raw, err := x509.CreateCertificate(rand.Reader, tmpl, c.ca, key.priv.Public(), c.caPriv)
 	if err != nil {
 		return nil, err
 	}
@@ -255,7 +398,29 @@ func (c *CertConfig) cert(hostname string) (*tls.Certificate, error) {
 
 	return &tls.Certificate{
 		Certificate: [][]byte{raw, c.ca.Raw},
-		PrivateKey:  c.priv,
+		PrivateKey:  key.priv,
 		Leaf:        x509c,
 	}, nil
 }
+
+// wildcardParent returns the parent domain of hostname, if a wildcard
+// certificate for the parent can be used. Wildcards are only used for parents
+// that are at least a registrable domain (e.g. `example.com`, not `co.uk`).
+func wildcardParent(hostname string) (string, bool) {
+	if net.ParseIP(hostname) != nil {
+		return "", false
+	}
+
+	i := strings.IndexByte(hostname, '.')
+	if i <= 0 {
+		return "", false
+	}
+
+	parent := hostname[i+1:]
+
+	if _, err := publicsuffix.EffectiveTLDPlusOne(parent); err != nil {
+		return "", false
+	}
+
+	return parent, true
+}
@@ -0,0 +1,312 @@
+package proxy
+
+import (
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func newCertConfigForTest(t *testing.T, validity time.Duration) *CertConfig {
+	t.Helper()
+
+	caCert, caKey, err := NewCA("Hetty Test CA", "Hetty", validity)
+	if err != nil {
+		t.Fatalf("failed to create CA: %v", err)
+	}
+
+	certConfig, err := NewCertConfig(caCert, caKey)
+	if err != nil {
+		t.Fatalf("failed to create cert config: %v", err)
+	}
+
+	return certConfig
+}
+
+func TestLRUCache(t *testing.T) {
+	t.Parallel()
+
+	cache := newLRUCache[string, int](2)
+
+	cache.Add("a", 1)
+	cache.Add("b", 2)
+
+	// Using `a` makes `b` the least recently used entry.
+	if v, ok := cache.Get("a"); !ok || v != 1 {
+		t.Errorf("expected value 1 for `a`, got: %v (ok: %v)", v, ok)
+	}
+
+	cache.Add("c", 3)
+
+	if _, ok := cache.Get("b"); ok {
+		t.Error("expected `b` to be evicted")
+	}
+
+	cache.Add("a", 10)
+
+	if v, ok := cache.Get("a"); !ok || v != 10 {
+		t.Errorf("expected value 10 for `a`, got: %v (ok: %v)", v, ok)
+	}
+
+	if v, ok := cache.Get("c"); !ok || v != 3 {
+		t.Errorf("expected value 3 for `c`, got: %v (ok: %v)", v, ok)
+	}
+
+	cache.Remove("c")
+	cache.Remove("foobar")
+
+	if _, ok := cache.Get("c"); ok {
+		t.Error("expected `c` to be removed")
+	}
+
+	if cache.ll.Len() != 1 || len(cache.items) != 1 {
+		t.Errorf("expected 1 entry, got: %v (items: %v)", cache.ll.Len(), len(cache.items))
+	}
+}
+
+func TestCertConfigCert(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		hostnames []string
+		// expNames are the names of the certificate for the last hostname.
+		expNames []string
+	}{
+		{
+			name:      "hostname",
+			hostnames: []string{"api.example.com"},
+			expNames:  []string{"api.example.com"},
+		},
+		{
+			name:      "port and case are ignored",
+			hostnames: []string{"API.Example.com:443"},
+			expNames:  []string{"api.example.com"},
+		},
+		{
+			name:      "same subdomain",
+			hostnames: []string{"api.example.com", "api.example.com"},
+			expNames:  []string{"api.example.com"},
+		},
+		{
+			name:      "wildcard for second subdomain",
+			hostnames: []string{"api.example.com", "www.example.com"},
+			expNames:  []string{"*.example.com", "example.com"},
+		},
+		{
+			name:      "wildcard for first subdomain after wildcard was issued",
+			hostnames: []string{"api.example.com", "www.example.com", "api.example.com"},
+			expNames:  []string{"api.example.com"},
+		},
+		{
+			name:      "wildcard for third subdomain",
+			hostnames: []string{"api.example.com", "www.example.com", "cdn.example.com"},
+			expNames:  []string{"*.example.com", "example.com"},
+		},
+		{
+			name:      "no wildcard for public suffix",
+			hostnames: []string{"foo.co.uk", "bar.co.uk"},
+			expNames:  []string{"bar.co.uk"},
+		},
+		{
+			name:      "no wildcard for single label",
+			hostnames: []string{"localhost"},
+			expNames:  []string{"localhost"},
+		},
+		{
+			name:      "IP address",
+			hostnames: []string{"127.0.0.1", "127.0.0.2"},
+			expNames:  []string{"127.0.0.2"},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			certConfig := newCertConfigForTest(t, 24*time.Hour)
+
+			var cert *tls.Certificate
+
+			for _, hostname := range tt.hostnames {
+				var err error
+
+				cert, err = certConfig.cert(hostname, KeyTypeECDSA)
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+			}
+
+			var gotNames []string
+
+			gotNames = append(gotNames, cert.Leaf.DNSNames...)
+			for _, ip := range cert.Leaf.IPAddresses {
+				gotNames = append(gotNames, ip.String())
+			}
+
+			if diff := cmp.Diff(tt.expNames, gotNames); diff != "" {
+				t.Errorf("certificate names not equal (-exp, +got):\n%v", diff)
+			}
+
+			if cert.Leaf.Subject.CommonName != tt.expNames[0] {
+				t.Errorf("expected common name %q, got: %q", tt.expNames[0], cert.Leaf.Subject.CommonName)
+			}
+		})
+	}
+}
+
+func TestCertConfigCertCache(t *testing.T) {
+	t.Parallel()
+
+	t.Run("cached", func(t *testing.T) {
+		t.Parallel()
+
+		certConfig := newCertConfigForTest(t, 24*time.Hour)
+
+		first, err := certConfig.cert("example.com", KeyTypeECDSA)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		second, err := certConfig.cert("example.com", KeyTypeECDSA)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if first != second {
+			t.Error("expected cached certificate")
+		}
+
+		rsaCert, err := certConfig.cert("example.com", KeyTypeRSA)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if rsaCert == first {
+			t.Error("expected separate certificate for key type")
+		}
+	})
+
+	t.Run("renewed before expiry", func(t *testing.T) {
+		t.Parallel()
+
+		// Leaf certificates don't outlive the CA, so these expire within
+		// `leafCertRenewBefore`.
+		certConfig := newCertConfigForTest(t, leafCertRenewBefore/2)
+
+		first, err := certConfig.cert("example.com", KeyTypeECDSA)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if !first.Leaf.NotAfter.Equal(certConfig.ca.NotAfter) {
+			t.Errorf("expected certificate to expire with CA at %v, got: %v", certConfig.ca.NotAfter, first.Leaf.NotAfter)
+		}
+
+		second, err := certConfig.cert("example.com", KeyTypeECDSA)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if first == second {
+			t.Error("expected new certificate")
+		}
+	})
+}
+
+func TestCertConfigKeyTypes(t *testing.T) {
+	t.Parallel()
+
+	certConfig := newCertConfigForTest(t, 24*time.Hour)
+
+	roots := x509.NewCertPool()
+	roots.AddCert(certConfig.ca)
+
+	for _, keyType := range []KeyType{KeyTypeECDSA, KeyTypeRSA} {
+		cert, err := certConfig.cert("example.com", keyType)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		_, err = cert.Leaf.Verify(x509.VerifyOptions{
+			DNSName: "example.com",
+			Roots:   roots,
+		})
+		if err != nil {
+			t.Errorf("key type %v: unexpected error verifying certificate: %v", keyType, err)
+		}
+
+		keyEncipherment := cert.Leaf.KeyUsage&x509.KeyUsageKeyEncipherment != 0
+
+		switch keyType {
+		case KeyTypeECDSA:
+			if _, ok := cert.Leaf.PublicKey.(*ecdsa.PublicKey); !ok {
+				t.Errorf("expected ECDSA public key, got: %T", cert.Leaf.PublicKey)
+			}
+
+			if keyEncipherment {
+				t.Error("expected no key encipherment usage for ECDSA certificate")
+			}
+		case KeyTypeRSA:
+			if _, ok := cert.Leaf.PublicKey.(*rsa.PublicKey); !ok {
+				t.Errorf("expected RSA public key, got: %T", cert.Leaf.PublicKey)
+			}
+
+			if !keyEncipherment {
+				t.Error("expected key encipherment usage for RSA certificate")
+			}
+		}
+	}
+}
+
+func TestCertConfigCertForClient(t *testing.T) {
+	t.Parallel()
+
+	certConfig := newCertConfigForTest(t, 24*time.Hour)
+
+	tests := []struct {
+		name         string
+		clientHello  *tls.ClientHelloInfo
+		expPublicKey any
+	}{
+		{
+			name: "ECDSA supported",
+			clientHello: &tls.ClientHelloInfo{
+				CipherSuites:      []uint16{tls.TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256, tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256},
+				SupportedVersions: []uint16{tls.VersionTLS12},
+				SupportedCurves:   []tls.CurveID{tls.CurveP256},
+				SupportedPoints:   []uint8{0},
+				SignatureSchemes:  []tls.SignatureScheme{tls.ECDSAWithP256AndSHA256, tls.PSSWithSHA256},
+			},
+			expPublicKey: &ecdsa.PublicKey{},
+		},
+		{
+			name: "RSA only",
+			clientHello: &tls.ClientHelloInfo{
+				CipherSuites:      []uint16{tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256},
+				SupportedVersions: []uint16{tls.VersionTLS12},
+				SupportedCurves:   []tls.CurveID{tls.CurveP256},
+				SupportedPoints:   []uint8{0},
+				SignatureSchemes:  []tls.SignatureScheme{tls.PSSWithSHA256, tls.PKCS1WithSHA256},
+			},
+			expPublicKey: &rsa.PublicKey{},
+		},
+	}
+
+	for _, tt := range tests {
+		cert, err := certConfig.certForClient(tt.clientHello, "example.com")
+		if err != nil {
+			t.Fatalf("%v: unexpected error: %v", tt.name, err)
+		}
+
+		if gotType, expType := fmt.Sprintf("%T", cert.Leaf.PublicKey), fmt.Sprintf("%T", tt.expPublicKey); gotType != expType {
+			t.Errorf("%v: expected public key of type %v, got: %v", tt.name, expType, gotType)
+		}
+	}
+}
@@ -0,0 +1,79 @@
+package proxy
+
+import (
+	"container/list"
+	"sync"
+)
+
+// lruCache is a bounded, least recently used cache. It's safe for concurrent
+// use.
+type lruCache[K comparable, V any] struct {
+	size  int
+	ll    *list.List
+	items map[K]*list.Element
+	mu    sync.Mutex
+}
+
+type lruEntry[K comparable, V any] struct {
+	key   K
+	value V
+}
+
+func newLRUCache[K comparable, V any](size int) *lruCache[K, V] {
+	return &lruCache[K, V]{
+		size:  size,
+		ll:    list.New(),
+		items: make(map[K]*list.Element),
+	}
+}
+
+// Get returns the value for `key`, and marks it as most recently used.
+func (c *lruCache[K, V]) Get(key K) (value V, ok bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	el, ok := c.items[key]
+	if !ok {
+		return value, false
+	}
+
+	c.ll.MoveToFront(el)
+
+	//nolint:forcetypeassert
+	return el.Value.(*lruEntry[K, V]).value, true
+}
+
+// Add adds or replaces the value for `key`. When the cache is full, the least
+// recently used entry is evicted.
+func (c *lruCache[K, V]) Add(key K, value V) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if el, ok := c.items[key]; ok {
+		c.ll.MoveToFront(el)
+		//nolint:forcetypeassert
+		el.Value.(*lruEntry[K, V]).value = value
+
+		return
+	}
+
+	c.items[key] = c.ll.PushFront(&lruEntry[K, V]{key: key, value: value})
+
+	if c.ll.Len() > c.size {
+		oldest := c.ll.Back()
+		c.ll.Remove(oldest)
+		//nolint:forcetypeassert
+		delete(c.items, oldest.Value.(*lruEntry[K, V]).key)
+	}
+}
+
+// Remove removes the value for `key`, if it exists.
+func (c *lruCache[K, V]) Remove(key K) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if el, ok := c.items[key]; ok {
+		c.ll.Remove(el)
+		delete(c.items, key)
+	}
+}
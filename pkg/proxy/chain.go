@@ -0,0 +1,264 @@
+package proxy
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+)
+
+var (
+	ErrModifierExists      = errors.New("proxy: modifier already exists")
+	ErrModifierNotFound    = errors.New("proxy: modifier not found")
+	ErrInvalidModifierKind = errors.New("proxy: invalid modifier kind")
+)
+
+// ModifierKind is the kind of a modifier chain.
+type ModifierKind string
+
+const (
+	ModifierKindRequest   ModifierKind = "request"
+	ModifierKindResponse  ModifierKind = "response"
+	ModifierKindWebSocket ModifierKind = "websocket"
+)
+
+// Modifier is a named modifier in one of the proxy's modifier chains.
+type Modifier struct {
+	Kind    ModifierKind
+	Name    string
+	Enabled bool
+}
+
+// modifierChains holds the request, response and WebSocket modifier chains.
+// Modifiers are called in order; the first modifier in a chain is the
+// outermost middleware. It's safe for concurrent use.
+type modifierChains struct {
+	chains map[ModifierKind][]modifierEntry
+	// seq is the number of modifiers that were added, used to restore the
+	// order in which modifiers were added.
+	seq int
+	mu  sync.RWMutex
+}
+
+// modifierEntry is a modifier with the middleware for its kind.
+type modifierEntry struct {
+	name    string
+	enabled bool
+	seq     int
+
+	req RequestModifyMiddleware
+	res ResponseModifyMiddleware
+	ws  WebSocketModifyMiddleware
+}
+
+func newModifierChains() *modifierChains {
+	return &modifierChains{
+		chains: map[ModifierKind][]modifierEntry{
+			ModifierKindRequest:   {},
+			ModifierKindResponse:  {},
+			ModifierKindWebSocket: {},
+		},
+	}
+}
+
+// UseRequestModifier appends a named request modifier to the request chain.
+func (p *Proxy) UseRequestModifier(name string, fn RequestModifyMiddleware) error {
+	return p.modifiers.add(ModifierKindRequest, modifierEntry{name: name, req: fn})
+}
+
+// UseResponseModifier appends a named response modifier to the response
+// chain.
+func (p *Proxy) UseResponseModifier(name string, fn ResponseModifyMiddleware) error {
+	return p.modifiers.add(ModifierKindResponse, modifierEntry{name: name, res: fn})
+}
+
+// UseWebSocketModifier appends a named WebSocket message modifier to the
+// WebSocket chain.
+func (p *Proxy) UseWebSocketModifier(name string, fn WebSocketModifyMiddleware) error {
+	return p.modifiers.add(ModifierKindWebSocket, modifierEntry{name: name, ws: fn})
+}
+
+// RemoveModifier removes a modifier from a chain.
+func (p *Proxy) RemoveModifier(kind ModifierKind, name string) error {
+	return p.modifiers.remove(kind, name)
+}
+
+// Modifiers returns the modifiers of all chains, in chain order.
+func (p *Proxy) Modifiers() []Modifier {
+	return p.modifiers.list()
+}
+
+// SetModifiers reorders and enables or disables modifiers. Within each chain,
+// the given modifiers are moved to the front in the given order, followed by
+// modifiers that weren't given, in their current order. Changes apply to
+// requests (and WebSocket messages) that are proxied afterwards.
+func (p *Proxy) SetModifiers(modifiers []Modifier) error {
+	return p.modifiers.set(modifiers)
+}
+
+// ResetModifiers restores the order in which modifiers were added, and enables
+// all modifiers.
+func (p *Proxy) ResetModifiers() {
+	p.modifiers.reset()
+}
+
+func (mc *modifierChains) add(kind ModifierKind, entry modifierEntry) error {
+	mc.mu.Lock()
+	defer mc.mu.Unlock()
+
+	if entry.name == "" {
+		return fmt.Errorf("proxy: modifier name must not be empty")
+	}
+
+	if mc.index(kind, entry.name) != -1 {
+		return fmt.Errorf("%w (kind: %v, name: %v)", ErrModifierExists, kind, entry.name)
+	}
+
+	mc.seq++
+	entry.seq = mc.seq
+	entry.enabled = true
+
+	mc.chains[kind] = append(mc.chains[kind], entry)
+
+	return nil
+}
+
+func (mc *modifierChains) remove(kind ModifierKind, name string) error {
+	mc.mu.Lock()
+	defer mc.mu.Unlock()
+
+	if _, ok := mc.chains[kind]; !ok {
+		return fmt.Errorf("%w: %q", ErrInvalidModifierKind, kind)
+	}
+
+	i := mc.index(kind, name)
+	if i == -1 {
+		return fmt.Errorf("%w (kind: %v, name: %v)", ErrModifierNotFound, kind, name)
+	}
+
+	chain := make([]modifierEntry, 0, len(mc.chains[kind])-1)
+	chain = append(chain, mc.chains[kind][:i]...)
+	chain = append(chain, mc.chains[kind][i+1:]...)
+	mc.chains[kind] = chain
+
+	return nil
+}
+
+func (mc *modifierChains) list() []Modifier {
+	mc.mu.RLock()
+	defer mc.mu.RUnlock()
+
+	var modifiers []Modifier
+
+	for _, kind := range []ModifierKind{ModifierKindRequest, ModifierKindResponse, ModifierKindWebSocket} {
+		for _, entry := range mc.chains[kind] {
+			modifiers = append(modifiers, Modifier{
+				Kind:    kind,
+				Name:    entry.name,
+				Enabled: entry.enabled,
+			})
+		}
+	}
+
+	return modifiers
+}
+
+func (mc *modifierChains) set(modifiers []Modifier) error {
+	mc.mu.Lock()
+	defer mc.mu.Unlock()
+
+	// Validate all modifiers first, so chains aren't partially updated.
+	seen := make(map[Modifier]bool)
+
+	for _, mod := range modifiers {
+		if _, ok := mc.chains[mod.Kind]; !ok {
+			return fmt.Errorf("%w: %q", ErrInvalidModifierKind, mod.Kind)
+		}
+
+		if mc.index(mod.Kind, mod.Name) == -1 {
+			return fmt.Errorf("%w (kind: %v, name: %v)", ErrModifierNotFound, mod.Kind, mod.Name)
+		}
+
+		key := Modifier{Kind: mod.Kind, Name: mod.Name}
+		if seen[key] {
+			return fmt.Errorf("%w (kind: %v, name: %v)", ErrModifierExists, mod.Kind, mod.Name)
+		}
+
+		seen[key] = true
+	}
+
+	for kind, chain := range mc.chains {
+		newChain := make([]modifierEntry, 0, len(chain))
+
+		for _, mod := range modifiers {
+			if mod.Kind != kind {
+				continue
+			}
+
+			entry := chain[mc.index(kind, mod.Name)]
+			entry.enabled = mod.Enabled
+			newChain = append(newChain, entry)
+		}
+
+		for _, entry := range chain {
+			if !seen[Modifier{Kind: kind, Name: entry.name}] {
+				newChain = append(newChain, entry)
+			}
+		}
+
+		mc.chains[kind] = newChain
+	}
+
+	return nil
+}
+
+func (mc *modifierChains) reset() {
+	mc.mu.Lock()
+	defer mc.mu.Unlock()
+
+	for kind, chain := range mc.chains {
+		newChain := make([]modifierEntry, len(chain))
+		copy(newChain, chain)
+
+		sort.Slice(newChain, func(i, j int) bool {
+			return newChain[i].seq < newChain[j].seq
+		})
+
+		for i := range newChain {
+			newChain[i].enabled = true
+		}
+
+		mc.chains[kind] = newChain
+	}
+}
+
+// enabled returns the enabled modifiers of a chain. Chains are replaced rather
+// than modified in place, so the returned slice is safe to use after the lock
+// is released.
+func (mc *modifierChains) enabled(kind ModifierKind) []modifierEntry {
+	mc.mu.RLock()
+	chain := mc.chains[kind]
+	mc.mu.RUnlock()
+
+	entries := make([]modifierEntry, 0, len(chain))
+
+	for _, entry := range chain {
+		if entry.enabled {
+			entries = append(entries, entry)
+		}
+	}
+
+	return entries
+}
+
+// index returns the index of a modifier in a chain, or -1 if it doesn't exist.
+// The caller must hold the lock.
+func (mc *modifierChains) index(kind ModifierKind, name string) int {
+	for i, entry := range mc.chains[kind] {
+		if entry.name == name {
+			return i
+		}
+	}
+
+	return -1
+}
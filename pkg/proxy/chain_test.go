@@ -0,0 +1,394 @@
+package proxy
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// newTestChains returns modifier chains with the given request and response
+// modifiers, added in order.
+func newTestChains(t *testing.T, reqNames, resNames []string) *modifierChains {
+	t.Helper()
+
+	mc := newModifierChains()
+
+	for _, name := range reqNames {
+		if err := mc.add(ModifierKindRequest, modifierEntry{name: name}); err != nil {
+			t.Fatalf("unexpected error adding request modifier: %v", err)
+		}
+	}
+
+	for _, name := range resNames {
+		if err := mc.add(ModifierKindResponse, modifierEntry{name: name}); err != nil {
+			t.Fatalf("unexpected error adding response modifier: %v", err)
+		}
+	}
+
+	return mc
+}
+
+// chainNames returns the names of the modifiers of a chain, in order, with
+// disabled modifiers suffixed by `-`.
+func chainNames(mc *modifierChains, kind ModifierKind) []string {
+	names := []string{}
+
+	for _, entry := range mc.chains[kind] {
+		if entry.enabled {
+			names = append(names, entry.name)
+		} else {
+			names = append(names, entry.name+"-")
+		}
+	}
+
+	return names
+}
+
+func TestModifierChainsSet(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		modifiers []Modifier
+		expReq    []string
+		expRes    []string
+		expErr    error
+	}{
+		{
+			name:      "no modifiers",
+			modifiers: nil,
+			expReq:    []string{"a", "b", "c", "d"},
+			expRes:    []string{"x", "y"},
+		},
+		{
+			name: "moves given modifiers to front",
+			modifiers: []Modifier{
+				{Kind: ModifierKindRequest, Name: "c", Enabled: true},
+				{Kind: ModifierKindRequest, Name: "a", Enabled: true},
+			},
+			expReq: []string{"c", "a", "b", "d"},
+			expRes: []string{"x", "y"},
+		},
+		{
+			name: "keeps remaining modifiers in order",
+			modifiers: []Modifier{
+				{Kind: ModifierKindRequest, Name: "d", Enabled: true},
+			},
+			expReq: []string{"d", "a", "b", "c"},
+			expRes: []string{"x", "y"},
+		},
+		{
+			name: "disables modifiers",
+			modifiers: []Modifier{
+				{Kind: ModifierKindRequest, Name: "b", Enabled: false},
+				{Kind: ModifierKindResponse, Name: "y", Enabled: true},
+				{Kind: ModifierKindResponse, Name: "x", Enabled: false},
+			},
+			expReq: []string{"b-", "a", "c", "d"},
+			expRes: []string{"y", "x-"},
+		},
+		{
+			name: "duplicate modifier",
+			modifiers: []Modifier{
+				{Kind: ModifierKindRequest, Name: "b", Enabled: true},
+				{Kind: ModifierKindRequest, Name: "b", Enabled: false},
+			},
+			expReq: []string{"a", "b", "c", "d"},
+			expRes: []string{"x", "y"},
+			expErr: ErrModifierExists,
+		},
+		{
+			name: "unknown modifier",
+			modifiers: []Modifier{
+				{Kind: ModifierKindRequest, Name: "b", Enabled: true},
+				{Kind: ModifierKindResponse, Name: "a", Enabled: true},
+			},
+			expReq: []string{"a", "b", "c", "d"},
+			expRes: []string{"x", "y"},
+			expErr: ErrModifierNotFound,
+		},
+		{
+			name: "invalid kind",
+			modifiers: []Modifier{
+				{Kind: "foobar", Name: "a", Enabled: true},
+			},
+			expReq: []string{"a", "b", "c", "d"},
+			expRes: []string{"x", "y"},
+			expErr: ErrInvalidModifierKind,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := newTestChains(t, []string{"a", "b", "c", "d"}, []string{"x", "y"})
+
+			err := mc.set(tt.modifiers)
+			if !errors.Is(err, tt.expErr) {
+				t.Fatalf("expected error %v, got: %v", tt.expErr, err)
+			}
+
+			if diff := cmp.Diff(tt.expReq, chainNames(mc, ModifierKindRequest)); diff != "" {
+				t.Errorf("request chain not equal (-exp, +got):\n%v", diff)
+			}
+
+			if diff := cmp.Diff(tt.expRes, chainNames(mc, ModifierKindResponse)); diff != "" {
+				t.Errorf("response chain not equal (-exp, +got):\n%v", diff)
+			}
+		})
+	}
+}
+
+func TestModifierChainsReset(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		modifiers []Modifier
+		remove    string
+		exp       []string
+	}{
+		{
+			name: "restores insertion order",
+			modifiers: []Modifier{
+				{Kind: ModifierKindRequest, Name: "c", Enabled: true},
+				{Kind: ModifierKindRequest, Name: "b", Enabled: true},
+			},
+			exp: []string{"a", "b", "c"},
+		},
+		{
+			name: "enables modifiers",
+			modifiers: []Modifier{
+				{Kind: ModifierKindRequest, Name: "a", Enabled: false},
+				{Kind: ModifierKindRequest, Name: "c", Enabled: false},
+			},
+			exp: []string{"a", "b", "c"},
+		},
+		{
+			name: "removed modifier",
+			modifiers: []Modifier{
+				{Kind: ModifierKindRequest, Name: "c", Enabled: true},
+			},
+			remove: "b",
+			exp:    []string{"a", "c"},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := newTestChains(t, []string{"a", "b", "c"}, nil)
+
+			if err := mc.set(tt.modifiers); err != nil {
+				t.Fatalf("unexpected error setting modifiers: %v", err)
+			}
+
+			if tt.remove != "" {
+				if err := mc.remove(ModifierKindRequest, tt.remove); err != nil {
+					t.Fatalf("unexpected error removing modifier: %v", err)
+				}
+			}
+
+			mc.reset()
+
+			if diff := cmp.Diff(tt.exp, chainNames(mc, ModifierKindRequest)); diff != "" {
+				t.Errorf("request chain not equal (-exp, +got):\n%v", diff)
+			}
+		})
+	}
+}
+
+func TestModifierChainsRemove(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		kind    ModifierKind
+		modName string
+		expReq  []string
+		expRes  []string
+		expErr  error
+	}{
+		{
+			name:    "first modifier",
+			kind:    ModifierKindRequest,
+			modName: "a",
+			expReq:  []string{"b", "c"},
+			expRes:  []string{"a"},
+		},
+		{
+			name:    "middle modifier",
+			kind:    ModifierKindRequest,
+			modName: "b",
+			expReq:  []string{"a", "c"},
+			expRes:  []string{"a"},
+		},
+		{
+			name:    "only removes modifier of kind",
+			kind:    ModifierKindResponse,
+			modName: "a",
+			expReq:  []string{"a", "b", "c"},
+			expRes:  []string{},
+		},
+		{
+			name:    "unknown modifier",
+			kind:    ModifierKindWebSocket,
+			modName: "a",
+			expReq:  []string{"a", "b", "c"},
+			expRes:  []string{"a"},
+			expErr:  ErrModifierNotFound,
+		},
+		{
+			name:    "invalid kind",
+			kind:    "foobar",
+			modName: "a",
+			expReq:  []string{"a", "b", "c"},
+			expRes:  []string{"a"},
+			expErr:  ErrInvalidModifierKind,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := newTestChains(t, []string{"a", "b", "c"}, []string{"a"})
+
+			err := mc.remove(tt.kind, tt.modName)
+			if !errors.Is(err, tt.expErr) {
+				t.Fatalf("expected error %v, got: %v", tt.expErr, err)
+			}
+
+			if diff := cmp.Diff(tt.expReq, chainNames(mc, ModifierKindRequest)); diff != "" {
+				t.Errorf("request chain not equal (-exp, +got):\n%v", diff)
+			}
+
+			if diff := cmp.Diff(tt.expRes, chainNames(mc, ModifierKindResponse)); diff != "" {
+				t.Errorf("response chain not equal (-exp, +got):\n%v", diff)
+			}
+		})
+	}
+}
+
+// TestModifierChainsConcurrent is meant to be run with `-race`. It reorders and
+// toggles request modifiers while requests are modified, and checks that each
+// request is modified by a consistent chain.
+func TestModifierChainsConcurrent(t *testing.T) {
+	t.Parallel()
+
+	caCert, caKey, err := NewCA("Hetty Test CA", "Hetty", time.Hour)
+	if err != nil {
+		t.Fatalf("failed to create CA: %v", err)
+	}
+
+	p, err := NewProxy(Config{CACert: caCert, CAKey: caKey})
+	if err != nil {
+		t.Fatalf("failed to create proxy: %v", err)
+	}
+
+	names := []string{"a", "b", "c", "d"}
+
+	for _, name := range names {
+		name := name
+
+		err := p.UseRequestModifier(name, func(next RequestModifyFunc) RequestModifyFunc {
+			return func(req *http.Request) {
+				req.Header.Add("X-Modifiers", name)
+				next(req)
+			}
+		})
+		if err != nil {
+			t.Fatalf("unexpected error adding request modifier: %v", err)
+		}
+	}
+
+	var setWG, reqWG sync.WaitGroup
+
+	done := make(chan struct{})
+
+	setWG.Add(1)
+
+	go func() {
+		defer setWG.Done()
+
+		for i := 0; ; i++ {
+			select {
+			case <-done:
+				return
+			default:
+			}
+
+			// Rotate the order, and disable the first modifier every other time.
+			var modifiers []Modifier
+
+			for j := range names {
+				modifiers = append(modifiers, Modifier{
+					Kind:    ModifierKindRequest,
+					Name:    names[(i+j)%len(names)],
+					Enabled: j != 0 || i%2 == 0,
+				})
+			}
+
+			if err := p.SetModifiers(modifiers); err != nil {
+				t.Errorf("unexpected error setting modifiers: %v", err)
+				return
+			}
+
+			if i%10 == 0 {
+				p.ResetModifiers()
+			}
+
+			_ = p.Modifiers()
+		}
+	}()
+
+	for i := 0; i < 4; i++ {
+		reqWG.Add(1)
+
+		go func() {
+			defer reqWG.Done()
+
+			for j := 0; j < 200; j++ {
+				req := httptest.NewRequest(http.MethodGet, "http://example.com/", nil)
+				p.modifyRequest(req)
+
+				got := req.Header.Values("X-Modifiers")
+				if err := validChainOrder(names, got); err != nil {
+					t.Errorf("unexpected modifier calls %v: %v", got, err)
+					return
+				}
+			}
+		}()
+	}
+
+	reqWG.Wait()
+	close(done)
+	setWG.Wait()
+}
+
+// validChainOrder returns an error if `got` isn't a rotation of `names`, with
+// at most its first modifier left out.
+func validChainOrder(names, got []string) error {
+	if len(got) < len(names)-1 || len(got) > len(names) {
+		return fmt.Errorf("expected %v or %v modifiers, got: %v", len(names)-1, len(names), len(got))
+	}
+
+	joined := strings.Join(append(names, names...), ",")
+	if !strings.Contains(joined, strings.Join(got, ",")) {
+		return errors.New("modifiers aren't in chain order")
+	}
+
+	return nil
+}
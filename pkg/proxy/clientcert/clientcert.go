@@ -0,0 +1,222 @@
+// Package clientcert provides a store of TLS client certificates, that are
+// presented to upstream servers based on host patterns (mutual TLS).
+package clientcert
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net"
+	"path"
+	"strings"
+	"sync"
+
+	"github.com/oklog/ulid"
+	"software.sslmate.com/src/go-pkcs12"
+)
+
+var (
+	ErrCertificateNotFound = errors.New("clientcert: certificate not found")
+	ErrInvalidHostPattern  = errors.New("clientcert: invalid host pattern")
+)
+
+type contextKey int
+
+const certNameKey contextKey = 0
+
+// Certificate represents a client certificate (chain) and private key, and the
+// host patterns (e.g. `api.example.com`, `*.example.com`) it's used for.
+type Certificate struct {
+	ID    ulid.ULID
+	Name  string
+	Hosts []string
+
+	// CertPEM contains the PEM encoded certificate chain, leaf first.
+	CertPEM []byte
+	// KeyPEM contains the PEM encoded private key.
+	KeyPEM []byte
+}
+
+// Store holds client certificates, and matches them to hosts. It's safe for
+// concurrent use.
+type Store struct {
+	certs  []Certificate
+	parsed map[ulid.ULID]tls.Certificate
+	gen    uint64
+	mu     sync.RWMutex
+}
+
+// ParsePEM parses a PEM encoded certificate chain and private key. Both can be
+// in the same PEM data.
+func ParsePEM(certPEM, keyPEM []byte) (Certificate, error) {
+	if len(keyPEM) == 0 {
+		keyPEM = certPEM
+	}
+
+	if _, err := tls.X509KeyPair(certPEM, keyPEM); err != nil {
+		return Certificate{}, fmt.Errorf("clientcert: failed to parse PEM key pair: %w", err)
+	}
+
+	cert := Certificate{}
+
+	// Only keep relevant PEM blocks, so certificate and key are stored apart.
+	for rest := certPEM; len(rest) > 0; {
+		var block *pem.Block
+
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+
+		if block.Type == "CERTIFICATE" {
+			cert.CertPEM = append(cert.CertPEM, pem.EncodeToMemory(block)...)
+		}
+	}
+
+	for rest := keyPEM; len(rest) > 0; {
+		var block *pem.Block
+
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+
+		if strings.HasSuffix(block.Type, "PRIVATE KEY") {
+			cert.KeyPEM = pem.EncodeToMemory(block)
+			break
+		}
+	}
+
+	return cert, nil
+}
+
+// ParsePKCS12 parses PKCS#12 (`.p12`, `.pfx`) data, containing a private key
+// and certificate chain.
+func ParsePKCS12(data []byte, password string) (Certificate, error) {
+	key, leaf, caCerts, err := pkcs12.DecodeChain(data, password)
+	if err != nil {
+		return Certificate{}, fmt.Errorf("clientcert: failed to decode PKCS#12 data: %w", err)
+	}
+
+	rawKey, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return Certificate{}, fmt.Errorf("clientcert: failed to marshal private key: %w", err)
+	}
+
+	cert := Certificate{
+		KeyPEM: pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: rawKey}),
+	}
+
+	for _, c := range append([]*x509.Certificate{leaf}, caCerts...) {
+		cert.CertPEM = append(cert.CertPEM, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: c.Raw})...)
+	}
+
+	return cert, nil
+}
+
+// Leaf returns the parsed leaf certificate.
+func (c Certificate) Leaf() (*x509.Certificate, error) {
+	tlsCert, err := tls.X509KeyPair(c.CertPEM, c.KeyPEM)
+	if err != nil {
+		return nil, fmt.Errorf("clientcert: failed to parse key pair: %w", err)
+	}
+
+	return x509.ParseCertificate(tlsCert.Certificate[0])
+}
+
+// ValidateHosts returns an error if any of the host patterns is invalid.
+func ValidateHosts(hosts []string) error {
+	for _, pattern := range hosts {
+		if _, err := path.Match(pattern, ""); err != nil || pattern == "" {
+			return fmt.Errorf("%w: %q", ErrInvalidHostPattern, pattern)
+		}
+	}
+
+	return nil
+}
+
+// MatchHost returns true if `host` (with or without port) matches any of the
+// certificate's host patterns.
+func (c Certificate) MatchHost(host string) bool {
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+
+	host = strings.ToLower(host)
+
+	for _, pattern := range c.Hosts {
+		if match, _ := path.Match(strings.ToLower(pattern), host); match {
+			return true
+		}
+	}
+
+	return false
+}
+
+// SetCertificates replaces the certificates in the store.
+func (s *Store) SetCertificates(certs []Certificate) error {
+	parsed := make(map[ulid.ULID]tls.Certificate, len(certs))
+
+	for _, cert := range certs {
+		tlsCert, err := tls.X509KeyPair(cert.CertPEM, cert.KeyPEM)
+		if err != nil {
+			return fmt.Errorf("clientcert: failed to parse key pair (name: %v): %w", cert.Name, err)
+		}
+
+		parsed[cert.ID] = tlsCert
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.certs = certs
+	s.parsed = parsed
+	s.gen++
+
+	return nil
+}
+
+// Certificates returns the certificates in the store.
+func (s *Store) Certificates() []Certificate {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.certs
+}
+
+// Match returns the first certificate that matches `host`.
+func (s *Store) Match(host string) (Certificate, tls.Certificate, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for _, cert := range s.certs {
+		if cert.MatchHost(host) {
+			return cert, s.parsed[cert.ID], true
+		}
+	}
+
+	return Certificate{}, tls.Certificate{}, false
+}
+
+func (s *Store) generation() uint64 {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.gen
+}
+
+// WithCertName returns a context with the name of the client certificate that
+// was presented for a request.
+func WithCertName(ctx context.Context, name string) context.Context {
+	return context.WithValue(ctx, certNameKey, name)
+}
+
+// CertNameFromContext returns the name of the client certificate that was
+// presented for a request, if any.
+func CertNameFromContext(ctx context.Context) (string, bool) {
+	name, ok := ctx.Value(certNameKey).(string)
+	return name, ok
+}
@@ -0,0 +1,189 @@
+package clientcert_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/oklog/ulid"
+
+	"github.com/dstotijn/hetty/pkg/proxy/clientcert"
+)
+
+func newCertPEM(t *testing.T, commonName string) ([]byte, []byte) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, key.Public(), key)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	rawKey, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: rawKey})
+}
+
+func TestParsePEM(t *testing.T) {
+	t.Parallel()
+
+	certPEM, keyPEM := newCertPEM(t, "alice")
+
+	// Certificate and key in a single PEM blob.
+	cert, err := clientcert.ParsePEM(append(certPEM, keyPEM...), nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if string(cert.CertPEM) != string(certPEM) {
+		t.Errorf("expected certificate PEM to contain certificate only")
+	}
+
+	if string(cert.KeyPEM) != string(keyPEM) {
+		t.Errorf("expected key PEM to contain private key only")
+	}
+
+	leaf, err := cert.Leaf()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if leaf.Subject.CommonName != "alice" {
+		t.Errorf("expected common name `alice`, got: %v", leaf.Subject.CommonName)
+	}
+
+	if _, err := clientcert.ParsePEM(certPEM, nil); err == nil {
+		t.Error("expected error for missing private key")
+	}
+}
+
+func TestCertificateMatchHost(t *testing.T) {
+	t.Parallel()
+
+	cert := clientcert.Certificate{
+		Hosts: []string{"api.example.com", "*.internal.example.com"},
+	}
+
+	tests := []struct {
+		host string
+		exp  bool
+	}{
+		{host: "api.example.com", exp: true},
+		{host: "API.example.com:443", exp: true},
+		{host: "foo.internal.example.com:8443", exp: true},
+		{host: "example.com", exp: false},
+		{host: "internal.example.com", exp: false},
+	}
+
+	for _, tt := range tests {
+		if got := cert.MatchHost(tt.host); got != tt.exp {
+			t.Errorf("MatchHost(%q): expected %v, got %v", tt.host, tt.exp, got)
+		}
+	}
+}
+
+func TestValidateHosts(t *testing.T) {
+	t.Parallel()
+
+	if err := clientcert.ValidateHosts([]string{"*.example.com"}); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	for _, hosts := range [][]string{{""}, {"[example.com"}} {
+		if err := clientcert.ValidateHosts(hosts); !errors.Is(err, clientcert.ErrInvalidHostPattern) {
+			t.Errorf("expected `clientcert.ErrInvalidHostPattern` for %q, got: %v", hosts, err)
+		}
+	}
+}
+
+func TestTransport(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if len(r.TLS.PeerCertificates) == 0 {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		_, _ = w.Write([]byte(r.TLS.PeerCertificates[0].Subject.CommonName))
+	}))
+	srv.TLS = &tls.Config{ClientAuth: tls.RequestClientCert}
+	srv.StartTLS()
+	defer srv.Close()
+
+	base, _ := srv.Client().Transport.(*http.Transport)
+	store := &clientcert.Store{}
+	transport := clientcert.NewTransport(base, store)
+
+	defer transport.CloseIdleConnections()
+
+	certPEM, keyPEM := newCertPEM(t, "alice")
+
+	cert, err := clientcert.ParsePEM(certPEM, keyPEM)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	cert.ID = ulid.MustNew(ulid.Now(), rand.Reader)
+	cert.Name = "Alice"
+	cert.Hosts = []string{"127.0.0.1"}
+
+	// Without matching certificates, no client certificate is presented.
+	req := httptest.NewRequest(http.MethodGet, srv.URL, nil)
+
+	res, err := transport.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	res.Body.Close()
+
+	if res.StatusCode != http.StatusUnauthorized {
+		t.Errorf("expected status code %v, got: %v", http.StatusUnauthorized, res.StatusCode)
+	}
+
+	if err := store.SetCertificates([]clientcert.Certificate{cert}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	req = httptest.NewRequest(http.MethodGet, srv.URL, nil)
+
+	res, err = transport.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		t.Errorf("expected status code %v, got: %v", http.StatusOK, res.StatusCode)
+	}
+
+	if name, _ := clientcert.CertNameFromContext(res.Request.Context()); name != "Alice" {
+		t.Errorf("expected certificate name `Alice` in context, got: %q", name)
+	}
+}
@@ -0,0 +1,100 @@
+package clientcert
+
+import (
+	"crypto/tls"
+	"net/http"
+	"sync"
+
+	"github.com/oklog/ulid"
+)
+
+// Transport implements http.RoundTripper. For HTTPS requests to hosts that
+// match a certificate in the store, it uses a clone of the base transport that
+// presents the client certificate. Because connections are pooled per
+// transport, connections made with and without a client certificate are never
+// mixed.
+type Transport struct {
+	base  *http.Transport
+	store *Store
+
+	transports map[ulid.ULID]*http.Transport
+	gen        uint64
+	mu         sync.Mutex
+}
+
+// NewTransport returns a new Transport.
+func NewTransport(base *http.Transport, store *Store) *Transport {
+	return &Transport{
+		base:       base,
+		store:      store,
+		transports: make(map[ulid.ULID]*http.Transport),
+	}
+}
+
+// RoundTrip implements http.RoundTripper. The name of the presented client
+// certificate (if any) is available via `CertNameFromContext` on the context of
+// `res.Request`.
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.URL.Scheme != "https" {
+		return t.base.RoundTrip(req)
+	}
+
+	cert, tlsCert, ok := t.store.Match(req.URL.Host)
+	if !ok {
+		return t.base.RoundTrip(req)
+	}
+
+	res, err := t.transport(cert.ID, tlsCert).RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	res.Request = res.Request.WithContext(WithCertName(res.Request.Context(), cert.Name))
+
+	return res, nil
+}
+
+// transport returns a (cached) clone of the base transport for a certificate.
+// Cached transports are discarded when the certificates in the store change.
+func (t *Transport) transport(certID ulid.ULID, tlsCert tls.Certificate) *http.Transport {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if gen := t.store.generation(); gen != t.gen {
+		for _, tr := range t.transports {
+			tr.CloseIdleConnections()
+		}
+
+		t.transports = make(map[ulid.ULID]*http.Transport)
+		t.gen = gen
+	}
+
+	if tr, ok := t.transports[certID]; ok {
+		return tr
+	}
+
+	tr := t.base.Clone()
+	if tr.TLSClientConfig == nil {
+		tr.TLSClientConfig = &tls.Config{
+			MinVersion: tls.VersionTLS12,
+		}
+	}
+
+	tr.TLSClientConfig.Certificates = []tls.Certificate{tlsCert}
+	t.transports[certID] = tr
+
+	return tr
+}
+
+// CloseIdleConnections closes idle connections of the base transport, and of
+// the transports used for client certificates.
+func (t *Transport) CloseIdleConnections() {
+	t.base.CloseIdleConnections()
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for _, tr := range t.transports {
+		tr.CloseIdleConnections()
+	}
+}
@@ -0,0 +1,273 @@
+package proxy
+
+import (
+	"bufio"
+	"bytes"
+	"compress/flate"
+	"compress/gzip"
+	"compress/zlib"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/andybalholm/brotli"
+	"github.com/klauspost/compress/zstd"
+)
+
+// maxEncodedBodySize is the maximum number of bytes of an encoded response
+// body that is kept after decoding.
+const maxEncodedBodySize = 10 << 20 // 10 MiB
+
+// EncodedBody contains a response body as received from the server, before it
+// was decoded.
+type EncodedBody struct {
+	ContentEncoding string
+	Body            []byte
+	// Truncated is true if the encoded body exceeded the maximum size that is
+	// kept, and only its first part is available.
+	Truncated bool
+}
+
+// encodedBodyCapture records (part of) an encoded body while it's streamed
+// through a decoder.
+type encodedBodyCapture struct {
+	contentEncoding string
+	buf             bytes.Buffer
+	truncated       bool
+	mu              sync.Mutex
+}
+
+// decodedBody reads a decoded body, and closes both the decoders and the
+// underlying body.
+type decodedBody struct {
+	io.Reader
+	closers []io.Closer
+}
+
+// lazyReader creates its reader on the first read, so that decoders don't block
+// (reading headers) before the body is read.
+type lazyReader struct {
+	newReader func() (io.Reader, error)
+	r         io.Reader
+	err       error
+}
+
+// supportedEncodings are the content codings that can be decoded. Clients can
+// keep these in `Accept-Encoding`.
+var supportedEncodings = map[string]bool{
+	"gzip":     true,
+	"x-gzip":   true,
+	"deflate":  true,
+	"br":       true,
+	"zstd":     true,
+	"identity": true,
+	"*":        true,
+}
+
+// stripUnsupportedEncodings removes content codings from the `Accept-Encoding`
+// header that can't be decoded.
+func stripUnsupportedEncodings(header http.Header) {
+	acceptEncs := header.Get("Accept-Encoding")
+	if acceptEncs == "" {
+		return
+	}
+
+	directives := strings.Split(acceptEncs, ",")
+	updated := make([]string, 0, len(directives))
+
+	for _, directive := range directives {
+		stripped := strings.TrimSpace(directive)
+		coding, _, _ := strings.Cut(stripped, ";")
+
+		if supportedEncodings[strings.ToLower(strings.TrimSpace(coding))] {
+			updated = append(updated, stripped)
+		}
+	}
+
+	if len(updated) == 0 {
+		header.Del("Accept-Encoding")
+	} else {
+		header.Set("Accept-Encoding", strings.Join(updated, ", "))
+	}
+}
+
+// DecodeResponseBody replaces the response body with a decoded stream, based
+// on the `Content-Encoding` header (gzip, deflate, br and zstd, or a
+// combination of these), and removes the header. Because the body is decoded
+// while it's read, streamed responses are passed through as they arrive. The
+// encoded body is available via `EncodedBodyFromContext` on the context of
+// `res.Request`, once the body is read. If any of the codings is unsupported,
+// the response is left as-is.
+func DecodeResponseBody(res *http.Response) error {
+	contentEncoding := res.Header.Get("Content-Encoding")
+	if contentEncoding == "" || res.Body == nil || res.Body == http.NoBody {
+		return nil
+	}
+
+	codings := strings.Split(contentEncoding, ",")
+
+	for i, coding := range codings {
+		codings[i] = strings.ToLower(strings.TrimSpace(coding))
+		if !supportedEncodings[codings[i]] {
+			return nil
+		}
+	}
+
+	capture := &encodedBodyCapture{contentEncoding: contentEncoding}
+	body := &decodedBody{
+		Reader:  io.TeeReader(res.Body, capture),
+		closers: []io.Closer{res.Body},
+	}
+
+	// Codings are listed in the order they were applied, so they're decoded
+	// in reverse order.
+	for i := len(codings) - 1; i >= 0; i-- {
+		r := newDecoder(codings[i], body.Reader)
+		body.Reader = r
+
+		if closer, ok := r.(io.Closer); ok {
+			body.closers = append(body.closers, closer)
+		}
+	}
+
+	res.Body = body
+	res.Header.Del("Content-Encoding")
+	res.Header.Del("Content-Length")
+	res.ContentLength = -1
+
+	if res.Request != nil {
+		ctx := context.WithValue(res.Request.Context(), encodedBodyKey, capture)
+		res.Request = res.Request.WithContext(ctx)
+	}
+
+	return nil
+}
+
+// EncodedBodyFromContext returns the encoded response body, if the response
+// body was decoded by DecodeResponseBody. It contains the part of the body that
+// was read so far.
+func EncodedBodyFromContext(ctx context.Context) (EncodedBody, bool) {
+	capture, ok := ctx.Value(encodedBodyKey).(*encodedBodyCapture)
+	if !ok {
+		return EncodedBody{}, false
+	}
+
+	capture.mu.Lock()
+	defer capture.mu.Unlock()
+
+	return EncodedBody{
+		ContentEncoding: capture.contentEncoding,
+		Body:            bytes.Clone(capture.buf.Bytes()),
+		Truncated:       capture.truncated,
+	}, true
+}
+
+func newDecoder(coding string, r io.Reader) io.Reader {
+	switch coding {
+	case "gzip", "x-gzip":
+		return &lazyReader{newReader: func() (io.Reader, error) {
+			return gzip.NewReader(r)
+		}}
+	case "deflate":
+		return &lazyReader{newReader: func() (io.Reader, error) {
+			// Despite its name, the `deflate` coding is zlib wrapped deflate
+			// data, but some servers send raw deflate data instead.
+			br := bufio.NewReader(r)
+
+			header, err := br.Peek(2)
+			if err != nil {
+				return nil, err
+			}
+
+			if isZlibHeader(header) {
+				return zlib.NewReader(br)
+			}
+
+			return flate.NewReader(br), nil
+		}}
+	case "br":
+		return brotli.NewReader(r)
+	case "zstd":
+		return &lazyReader{newReader: func() (io.Reader, error) {
+			zstdReader, err := zstd.NewReader(r, zstd.WithDecoderConcurrency(1))
+			if err != nil {
+				return nil, err
+			}
+
+			return zstdReader.IOReadCloser(), nil
+		}}
+	default:
+		return r
+	}
+}
+
+// isZlibHeader returns true if `header` is a valid zlib header for deflate
+// compressed data. See: https://datatracker.ietf.org/doc/html/rfc1950
+func isZlibHeader(header []byte) bool {
+	cmf, flg := header[0], header[1]
+	return cmf&0x0f == 8 && (uint16(cmf)<<8|uint16(flg))%31 == 0
+}
+
+func (c *encodedBodyCapture) Write(p []byte) (int, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if remaining := maxEncodedBodySize - c.buf.Len(); remaining < len(p) {
+		c.buf.Write(p[:max(remaining, 0)])
+		c.truncated = true
+	} else {
+		c.buf.Write(p)
+	}
+
+	return len(p), nil
+}
+
+func (b *decodedBody) Close() error {
+	var err error
+
+	// Decoders are closed first, the underlying body last.
+	for i := len(b.closers) - 1; i >= 0; i-- {
+		if closeErr := b.closers[i].Close(); closeErr != nil && err == nil {
+			err = closeErr
+		}
+	}
+
+	return err
+}
+
+func (r *lazyReader) Read(p []byte) (int, error) {
+	if r.r == nil && r.err == nil {
+		var dec io.Reader
+
+		// On error, decoders return a typed nil, which must not be closed.
+		dec, r.err = r.newReader()
+		if r.err == nil {
+			r.r = dec
+		}
+
+		// An empty body can't contain a header, but is a valid (empty) body.
+		if errors.Is(r.err, io.EOF) {
+			r.err = io.EOF
+		} else if r.err != nil {
+			r.err = fmt.Errorf("proxy: could not create decoder: %w", r.err)
+		}
+	}
+
+	if r.err != nil {
+		return 0, r.err
+	}
+
+	return r.r.Read(p)
+}
+
+func (r *lazyReader) Close() error {
+	if closer, ok := r.r.(io.Closer); ok {
+		return closer.Close()
+	}
+
+	return nil
+}
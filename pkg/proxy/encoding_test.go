@@ -0,0 +1,289 @@
+package proxy
+
+import (
+	"bytes"
+	"compress/flate"
+	"compress/gzip"
+	"compress/zlib"
+	"crypto/rand"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/andybalholm/brotli"
+	"github.com/klauspost/compress/zstd"
+)
+
+func encodeForTest(t *testing.T, coding string, data []byte) []byte {
+	t.Helper()
+
+	buf := &bytes.Buffer{}
+
+	var (
+		w   io.WriteCloser
+		err error
+	)
+
+	switch coding {
+	case "gzip":
+		w = gzip.NewWriter(buf)
+	case "zlib":
+		w = zlib.NewWriter(buf)
+	case "deflate":
+		w, err = flate.NewWriter(buf, flate.DefaultCompression)
+	case "br":
+		w = brotli.NewWriter(buf)
+	case "zstd":
+		w, err = zstd.NewWriter(buf)
+	default:
+		t.Fatalf("unsupported coding: %v", coding)
+	}
+
+	if err != nil {
+		t.Fatalf("failed to create %v writer: %v", coding, err)
+	}
+
+	if _, err := w.Write(data); err != nil {
+		t.Fatalf("failed to write %v data: %v", coding, err)
+	}
+
+	if err := w.Close(); err != nil {
+		t.Fatalf("failed to close %v writer: %v", coding, err)
+	}
+
+	return buf.Bytes()
+}
+
+func newEncodedResponse(contentEncoding string, body []byte) *http.Response {
+	req, _ := http.NewRequest(http.MethodGet, "https://example.com/", nil)
+
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header: http.Header{
+			"Content-Encoding": []string{contentEncoding},
+			"Content-Length":   []string{"1"},
+		},
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}
+}
+
+func TestDecodeResponseBody(t *testing.T) {
+	t.Parallel()
+
+	data := bytes.Repeat([]byte("Hello, world! "), 1000)
+
+	tests := []struct {
+		name            string
+		contentEncoding string
+		body            []byte
+		expBody         []byte
+		expDecoded      bool
+	}{
+		{
+			name:            "gzip",
+			contentEncoding: "gzip",
+			body:            encodeForTest(t, "gzip", data),
+			expBody:         data,
+			expDecoded:      true,
+		},
+		{
+			name:            "x-gzip",
+			contentEncoding: "x-gzip",
+			body:            encodeForTest(t, "gzip", data),
+			expBody:         data,
+			expDecoded:      true,
+		},
+		{
+			name:            "deflate with zlib wrapper",
+			contentEncoding: "deflate",
+			body:            encodeForTest(t, "zlib", data),
+			expBody:         data,
+			expDecoded:      true,
+		},
+		{
+			name:            "raw deflate",
+			contentEncoding: "deflate",
+			body:            encodeForTest(t, "deflate", data),
+			expBody:         data,
+			expDecoded:      true,
+		},
+		{
+			name:            "brotli",
+			contentEncoding: "br",
+			body:            encodeForTest(t, "br", data),
+			expBody:         data,
+			expDecoded:      true,
+		},
+		{
+			name:            "zstd",
+			contentEncoding: "zstd",
+			body:            encodeForTest(t, "zstd", data),
+			expBody:         data,
+			expDecoded:      true,
+		},
+		{
+			name:            "multiple codings",
+			contentEncoding: "gzip, BR",
+			body:            encodeForTest(t, "br", encodeForTest(t, "gzip", data)),
+			expBody:         data,
+			expDecoded:      true,
+		},
+		{
+			name:            "empty body",
+			contentEncoding: "gzip",
+			body:            []byte{},
+			expBody:         []byte{},
+			expDecoded:      true,
+		},
+		{
+			name:            "unsupported coding",
+			contentEncoding: "gzip, compress",
+			body:            []byte("foobar"),
+			expBody:         []byte("foobar"),
+			expDecoded:      false,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			res := newEncodedResponse(tt.contentEncoding, tt.body)
+
+			if err := DecodeResponseBody(res); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			got, err := io.ReadAll(res.Body)
+			if err != nil {
+				t.Fatalf("unexpected error reading body: %v", err)
+			}
+
+			if err := res.Body.Close(); err != nil {
+				t.Fatalf("unexpected error closing body: %v", err)
+			}
+
+			if !bytes.Equal(got, tt.expBody) {
+				t.Errorf("expected body of %v bytes, got %v bytes", len(tt.expBody), len(got))
+			}
+
+			encoded, ok := EncodedBodyFromContext(res.Request.Context())
+			if ok != tt.expDecoded {
+				t.Fatalf("expected encoded body in context: %v, got: %v", tt.expDecoded, ok)
+			}
+
+			if !tt.expDecoded {
+				if res.Header.Get("Content-Encoding") != tt.contentEncoding {
+					t.Errorf("expected `Content-Encoding` header to be unchanged, got: %q", res.Header.Get("Content-Encoding"))
+				}
+
+				return
+			}
+
+			if res.Header.Get("Content-Encoding") != "" || res.Header.Get("Content-Length") != "" || res.ContentLength != -1 {
+				t.Error("expected `Content-Encoding` and `Content-Length` headers to be removed")
+			}
+
+			if encoded.ContentEncoding != tt.contentEncoding {
+				t.Errorf("expected content encoding %q, got: %q", tt.contentEncoding, encoded.ContentEncoding)
+			}
+
+			if !bytes.Equal(encoded.Body, tt.body) || encoded.Truncated {
+				t.Errorf("expected complete encoded body (truncated: %v)", encoded.Truncated)
+			}
+		})
+	}
+}
+
+func TestDecodeResponseBodyMaxSize(t *testing.T) {
+	t.Parallel()
+
+	// Random data can't be compressed, so the encoded body exceeds the max
+	// size that's kept.
+	data := make([]byte, maxEncodedBodySize+1<<20)
+	if _, err := rand.Read(data); err != nil {
+		t.Fatalf("failed to read random data: %v", err)
+	}
+
+	body := encodeForTest(t, "gzip", data)
+	res := newEncodedResponse("gzip", body)
+
+	if err := DecodeResponseBody(res); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	got, err := io.ReadAll(res.Body)
+	if err != nil {
+		t.Fatalf("unexpected error reading body: %v", err)
+	}
+
+	if !bytes.Equal(got, data) {
+		t.Error("expected complete decoded body")
+	}
+
+	encoded, ok := EncodedBodyFromContext(res.Request.Context())
+	if !ok {
+		t.Fatal("expected encoded body in context")
+	}
+
+	if !encoded.Truncated {
+		t.Error("expected encoded body to be truncated")
+	}
+
+	if !bytes.Equal(encoded.Body, body[:maxEncodedBodySize]) {
+		t.Errorf("expected first %v bytes of encoded body, got %v bytes", maxEncodedBodySize, len(encoded.Body))
+	}
+}
+
+func TestDecodeResponseBodyInvalid(t *testing.T) {
+	t.Parallel()
+
+	for _, coding := range []string{"gzip", "deflate", "br", "zstd"} {
+		res := newEncodedResponse(coding, []byte("this is not encoded"))
+
+		if err := DecodeResponseBody(res); err != nil {
+			t.Fatalf("%v: unexpected error: %v", coding, err)
+		}
+
+		if _, err := io.ReadAll(res.Body); err == nil {
+			t.Errorf("%v: expected error reading invalid body", coding)
+		}
+
+		// Closing must not panic on decoders that couldn't be created.
+		_ = res.Body.Close()
+	}
+}
+
+func TestStripUnsupportedEncodings(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		acceptEncoding string
+		exp            string
+	}{
+		{acceptEncoding: "gzip, deflate, br, zstd", exp: "gzip, deflate, br, zstd"},
+		{acceptEncoding: "gzip;q=1.0, compress;q=0.5, BR", exp: "gzip;q=1.0, BR"},
+		{acceptEncoding: "compress, sdch", exp: ""},
+		{acceptEncoding: "", exp: ""},
+	}
+
+	for _, tt := range tests {
+		header := http.Header{}
+		if tt.acceptEncoding != "" {
+			header.Set("Accept-Encoding", tt.acceptEncoding)
+		}
+
+		stripUnsupportedEncodings(header)
+
+		if got := header.Get("Accept-Encoding"); got != tt.exp {
+			t.Errorf("%q: expected %q, got: %q", tt.acceptEncoding, tt.exp, got)
+		}
+
+		if _, ok := header["Accept-Encoding"]; ok && tt.exp == "" {
+			t.Errorf("%q: expected header to be removed", tt.acceptEncoding)
+		}
+	}
+}
@@ -0,0 +1,230 @@
+package proxy
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"html/template"
+	"io"
+	"net"
+	"net/http"
+	"syscall"
+)
+
+// ErrorKind classifies errors that occur while proxying a request.
+type ErrorKind string
+
+const (
+	ErrorKindDNS               ErrorKind = "dns"
+	ErrorKindConnectionRefused ErrorKind = "connection_refused"
+	ErrorKindTLS               ErrorKind = "tls"
+	ErrorKindTimeout           ErrorKind = "timeout"
+	ErrorKindCanceled          ErrorKind = "canceled"
+	ErrorKindConnectionReset   ErrorKind = "connection_reset"
+	ErrorKindUnknown           ErrorKind = "unknown"
+)
+
+// ErrAbortConnection can be used as (or wrapped by) the cause when cancelling
+// the context of a request, e.g. in a request modifier. Instead of returning an
+// error page, the proxy then closes the client connection (or resets the
+// stream, for HTTP/2).
+var ErrAbortConnection = errors.New("proxy: connection aborted")
+
+// ErrDropped matches `context.Canceled`, so the proxy handles a request or
+// response that was dropped (e.g. by an intercept, script or plugin) as a
+// cancelled request. Packages can wrap it to describe who dropped it.
+var ErrDropped error = droppedError{}
+
+type droppedError struct{}
+
+func (droppedError) Error() string {
+	return "proxy: request was dropped"
+}
+
+func (droppedError) Is(target error) bool {
+	return target == context.Canceled //nolint:errorlint
+}
+
+// DropRequest replaces the context of a request with a context that's
+// cancelled with `cause`, e.g. ErrDropped or ErrAbortConnection. When called
+// from a request modifier, the request isn't sent, but the proxy handles it as
+// an error, with the cause as its description.
+func DropRequest(req *http.Request, cause error) {
+	ctx, cancel := context.WithCancelCause(req.Context())
+	cancel(cause)
+
+	*req = *req.WithContext(ctx)
+}
+
+// Error represents a proxy-side error, i.e. an error that prevented a response
+// from the server being returned to the client.
+type Error struct {
+	Kind    ErrorKind
+	Message string
+}
+
+// ErrorResponseFunc is called with the response that is returned to the client
+// when a request couldn't be proxied. The proxy error is available via
+// `ErrorFromContext` on the context of `res.Request`.
+type ErrorResponseFunc func(res *http.Response)
+
+var errorPageTmpl = template.Must(template.New("error").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>Hetty: {{.Status}}</title>
+</head>
+<body>
+<h1>{{.Status}}</h1>
+<p>Hetty could not proxy the request to <code>{{.URL}}</code>.</p>
+<dl>
+<dt>Error</dt><dd><code>{{.Kind}}</code>: {{.Message}}</dd>
+<dt>Request ID</dt><dd><code>{{.RequestID}}</code></dd>
+</dl>
+</body>
+</html>
+`))
+
+// NewError classifies `err`, which occurred while proxying a request with
+// context `ctx`.
+func NewError(ctx context.Context, err error) Error {
+	proxyErr := Error{
+		Kind:    classifyError(err),
+		Message: err.Error(),
+	}
+
+	// When a request is cancelled on purpose (e.g. by intercept), the cause
+	// explains why.
+	if cause := context.Cause(ctx); cause != nil && cause != ctx.Err() { //nolint:errorlint
+		proxyErr.Kind = classifyError(cause)
+		proxyErr.Message = cause.Error()
+	}
+
+	return proxyErr
+}
+
+func (e Error) Error() string {
+	return fmt.Sprintf("proxy: %v: %v", e.Kind, e.Message)
+}
+
+// ErrorFromContext returns the proxy error that occurred for a request, if any.
+func ErrorFromContext(ctx context.Context) (Error, bool) {
+	proxyErr, ok := ctx.Value(errorKey).(Error)
+	return proxyErr, ok
+}
+
+// OnErrorResponse registers functions that are called when a request couldn't
+// be proxied, e.g. for logging.
+func (p *Proxy) OnErrorResponse(fn ...ErrorResponseFunc) {
+	p.errorResFuncs = append(p.errorResFuncs, fn...)
+}
+
+func classifyError(err error) ErrorKind {
+	var (
+		dnsErr         *net.DNSError
+		certErr        *tls.CertificateVerificationError
+		recordErr      tls.RecordHeaderError
+		alertErr       tls.AlertError
+		unknownAuthErr x509.UnknownAuthorityError
+		hostnameErr    x509.HostnameError
+		certInvalidErr x509.CertificateInvalidError
+		netErr         net.Error
+	)
+
+	switch {
+	case errors.Is(err, ErrAbortConnection):
+		return ErrorKindConnectionReset
+	case errors.Is(err, context.Canceled):
+		return ErrorKindCanceled
+	case errors.Is(err, context.DeadlineExceeded):
+		return ErrorKindTimeout
+	case errors.As(err, &dnsErr):
+		if dnsErr.IsTimeout {
+			return ErrorKindTimeout
+		}
+
+		return ErrorKindDNS
+	case errors.Is(err, syscall.ECONNREFUSED):
+		return ErrorKindConnectionRefused
+	case errors.As(err, &certErr), errors.As(err, &recordErr), errors.As(err, &alertErr),
+		errors.As(err, &unknownAuthErr), errors.As(err, &hostnameErr), errors.As(err, &certInvalidErr):
+		return ErrorKindTLS
+	case errors.As(err, &netErr) && netErr.Timeout():
+		return ErrorKindTimeout
+	default:
+		return ErrorKindUnknown
+	}
+}
+
+// errorResponse returns the response that is written to the client when a
+// request couldn't be proxied, with an HTML page describing the error.
+func errorResponse(req *http.Request, proxyErr Error) (*http.Response, []byte) {
+	statusCode := http.StatusBadGateway
+	if proxyErr.Kind == ErrorKindTimeout {
+		statusCode = http.StatusGatewayTimeout
+	}
+
+	reqID, _ := RequestIDFromContext(req.Context())
+	buf := &bytes.Buffer{}
+
+	_ = errorPageTmpl.Execute(buf, map[string]interface{}{
+		"Status":    fmt.Sprintf("%d %s", statusCode, http.StatusText(statusCode)),
+		"URL":       req.URL.String(),
+		"Kind":      proxyErr.Kind,
+		"Message":   proxyErr.Message,
+		"RequestID": reqID.String(),
+	})
+
+	res := &http.Response{
+		Status:     fmt.Sprintf("%d %s", statusCode, http.StatusText(statusCode)),
+		StatusCode: statusCode,
+		Proto:      "HTTP/1.1",
+		ProtoMajor: 1,
+		ProtoMinor: 1,
+		Header: http.Header{
+			"Content-Type":           []string{"text/html; charset=utf-8"},
+			"Content-Length":         []string{fmt.Sprint(buf.Len())},
+			"X-Content-Type-Options": []string{"nosniff"},
+		},
+		ContentLength: int64(buf.Len()),
+		Request:       req.WithContext(context.WithValue(req.Context(), errorKey, proxyErr)),
+	}
+
+	return res, buf.Bytes()
+}
+
+func (p *Proxy) errorHandler(w http.ResponseWriter, r *http.Request, err error) {
+	proxyErr := NewError(r.Context(), err)
+
+	switch proxyErr.Kind {
+	case ErrorKindCanceled, ErrorKindConnectionReset:
+		p.logger.Debugw("Proxy request was cancelled.",
+			"error", proxyErr.Message)
+	default:
+		p.logger.Errorw("Failed to proxy request.",
+			"error", err)
+	}
+
+	res, body := errorResponse(r, proxyErr)
+
+	for _, fn := range p.errorResFuncs {
+		res.Body = io.NopCloser(bytes.NewReader(body))
+		fn(res)
+	}
+
+	if proxyErr.Kind == ErrorKindConnectionReset {
+		// Makes the HTTP server close the connection, without writing a
+		// response.
+		panic(http.ErrAbortHandler)
+	}
+
+	for key, values := range res.Header {
+		w.Header()[key] = values
+	}
+
+	w.WriteHeader(res.StatusCode)
+	_, _ = w.Write(body)
+}
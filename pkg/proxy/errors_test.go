@@ -0,0 +1,281 @@
+package proxy
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"syscall"
+	"testing"
+
+	"github.com/oklog/ulid"
+)
+
+type timeoutError struct{}
+
+func (timeoutError) Error() string   { return "i/o timeout" }
+func (timeoutError) Timeout() bool   { return true }
+func (timeoutError) Temporary() bool { return true }
+
+func TestClassifyError(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		err  error
+		exp  ErrorKind
+	}{
+		{
+			name: "abort connection",
+			err:  fmt.Errorf("throttle: injected connection reset: %w", ErrAbortConnection),
+			exp:  ErrorKindConnectionReset,
+		},
+		{
+			name: "dropped",
+			err:  fmt.Errorf("script: dropped by script: %w", ErrDropped),
+			exp:  ErrorKindCanceled,
+		},
+		{
+			name: "context canceled",
+			err:  fmt.Errorf("foo: %w", context.Canceled),
+			exp:  ErrorKindCanceled,
+		},
+		{
+			name: "context deadline exceeded",
+			err:  context.DeadlineExceeded,
+			exp:  ErrorKindTimeout,
+		},
+		{
+			name: "DNS error",
+			err:  &net.OpError{Op: "dial", Err: &net.DNSError{Err: "no such host", Name: "example.test", IsNotFound: true}},
+			exp:  ErrorKindDNS,
+		},
+		{
+			name: "DNS timeout",
+			err:  &net.DNSError{Err: "i/o timeout", Name: "example.test", IsTimeout: true},
+			exp:  ErrorKindTimeout,
+		},
+		{
+			name: "connection refused",
+			err:  &net.OpError{Op: "dial", Err: &net.OpError{Op: "connect", Err: syscall.ECONNREFUSED}},
+			exp:  ErrorKindConnectionRefused,
+		},
+		{
+			name: "certificate verification error",
+			err:  &tls.CertificateVerificationError{Err: x509.UnknownAuthorityError{}},
+			exp:  ErrorKindTLS,
+		},
+		{
+			name: "hostname error",
+			err:  fmt.Errorf("foo: %w", x509.HostnameError{Host: "example.com", Certificate: &x509.Certificate{}}),
+			exp:  ErrorKindTLS,
+		},
+		{
+			name: "TLS record header error",
+			err:  tls.RecordHeaderError{Msg: "first record does not look like a TLS handshake"},
+			exp:  ErrorKindTLS,
+		},
+		{
+			name: "TLS alert",
+			err:  &net.OpError{Op: "remote error", Err: tls.AlertError(40)},
+			exp:  ErrorKindTLS,
+		},
+		{
+			name: "network timeout",
+			err:  &net.OpError{Op: "read", Err: timeoutError{}},
+			exp:  ErrorKindTimeout,
+		},
+		{
+			name: "unknown",
+			err:  errors.New("foobar"),
+			exp:  ErrorKindUnknown,
+		},
+	}
+
+	for _, tt := range tests {
+		if got := classifyError(tt.err); got != tt.exp {
+			t.Errorf("%v: expected kind %q, got: %q", tt.name, tt.exp, got)
+		}
+	}
+}
+
+func TestNewError(t *testing.T) {
+	t.Parallel()
+
+	t.Run("without cause", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		got := NewError(ctx, fmt.Errorf("foo: %w", context.Canceled))
+		exp := Error{Kind: ErrorKindCanceled, Message: "foo: context canceled"}
+
+		if got != exp {
+			t.Errorf("expected %+v, got: %+v", exp, got)
+		}
+	})
+
+	t.Run("with cause", func(t *testing.T) {
+		t.Parallel()
+
+		cause := fmt.Errorf("throttle: injected connection reset: %w", ErrAbortConnection)
+
+		ctx, cancel := context.WithCancelCause(context.Background())
+		cancel(cause)
+
+		got := NewError(ctx, context.Canceled)
+		exp := Error{Kind: ErrorKindConnectionReset, Message: cause.Error()}
+
+		if got != exp {
+			t.Errorf("expected %+v, got: %+v", exp, got)
+		}
+	})
+}
+
+func TestErrorResponse(t *testing.T) {
+	t.Parallel()
+
+	reqID := ulid.MustNew(ulid.Now(), ulidEntropy)
+
+	tests := []struct {
+		proxyErr      Error
+		expStatusCode int
+	}{
+		{
+			proxyErr:      Error{Kind: ErrorKindConnectionRefused, Message: "<connection refused>"},
+			expStatusCode: http.StatusBadGateway,
+		},
+		{
+			proxyErr:      Error{Kind: ErrorKindTimeout, Message: "<i/o timeout>"},
+			expStatusCode: http.StatusGatewayTimeout,
+		},
+	}
+
+	for _, tt := range tests {
+		req := httptest.NewRequest(http.MethodGet, "https://example.com/foo", nil)
+		req = req.WithContext(WithRequestID(req.Context(), reqID))
+
+		res, body := errorResponse(req, tt.proxyErr)
+
+		if res.StatusCode != tt.expStatusCode {
+			t.Errorf("%v: expected status code %v, got: %v", tt.proxyErr.Kind, tt.expStatusCode, res.StatusCode)
+		}
+
+		if res.ContentLength != int64(len(body)) {
+			t.Errorf("%v: expected content length %v, got: %v", tt.proxyErr.Kind, len(body), res.ContentLength)
+		}
+
+		if got, _ := ErrorFromContext(res.Request.Context()); got != tt.proxyErr {
+			t.Errorf("%v: expected proxy error in context, got: %+v", tt.proxyErr.Kind, got)
+		}
+
+		page := string(body)
+
+		for _, exp := range []string{
+			"https://example.com/foo",
+			string(tt.proxyErr.Kind),
+			reqID.String(),
+		} {
+			if !strings.Contains(page, exp) {
+				t.Errorf("%v: expected error page to contain %q", tt.proxyErr.Kind, exp)
+			}
+		}
+
+		if strings.Contains(page, tt.proxyErr.Message) {
+			t.Errorf("%v: expected error message to be escaped", tt.proxyErr.Kind)
+		}
+	}
+}
+
+func TestProxyErrorHandler(t *testing.T) {
+	t.Parallel()
+
+	// A closed listener yields an address that refuses connections.
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+
+	targetURL := "http://" + l.Addr().String() + "/foo"
+	l.Close()
+
+	p := newProxyForTest(t, Config{})
+
+	proxyErrs := make(chan Error, 2)
+
+	p.OnErrorResponse(func(res *http.Response) {
+		proxyErr, _ := ErrorFromContext(res.Request.Context())
+		proxyErrs <- proxyErr
+	})
+
+	err = p.UseRequestModifier("test", func(next RequestModifyFunc) RequestModifyFunc {
+		return func(req *http.Request) {
+			if req.URL.Query().Has("abort") {
+				DropRequest(req, ErrAbortConnection)
+			}
+
+			next(req)
+		}
+	})
+	if err != nil {
+		t.Fatalf("unexpected error adding request modifier: %v", err)
+	}
+
+	proxyServer := httptest.NewServer(p)
+	defer proxyServer.Close()
+
+	proxyURL, err := url.Parse(proxyServer.URL)
+	if err != nil {
+		t.Fatalf("failed to parse proxy URL: %v", err)
+	}
+
+	transport := &http.Transport{Proxy: http.ProxyURL(proxyURL)}
+	defer transport.CloseIdleConnections()
+
+	client := &http.Client{Transport: transport}
+
+	t.Run("error page", func(t *testing.T) {
+		res, err := client.Get(targetURL)
+		if err != nil {
+			t.Fatalf("unexpected error sending request: %v", err)
+		}
+		defer res.Body.Close()
+
+		body, err := io.ReadAll(res.Body)
+		if err != nil {
+			t.Fatalf("unexpected error reading response body: %v", err)
+		}
+
+		if res.StatusCode != http.StatusBadGateway {
+			t.Errorf("expected status code %v, got: %v", http.StatusBadGateway, res.StatusCode)
+		}
+
+		if !strings.Contains(string(body), string(ErrorKindConnectionRefused)) {
+			t.Errorf("expected error page for kind %q, got: %s", ErrorKindConnectionRefused, body)
+		}
+
+		if proxyErr := <-proxyErrs; proxyErr.Kind != ErrorKindConnectionRefused {
+			t.Errorf("expected proxy error of kind %q, got: %+v", ErrorKindConnectionRefused, proxyErr)
+		}
+	})
+
+	t.Run("abort connection", func(t *testing.T) {
+		res, err := client.Get(targetURL + "?abort")
+		if err == nil {
+			res.Body.Close()
+			t.Fatalf("expected connection to be closed, got response: %v", res.Status)
+		}
+
+		if proxyErr := <-proxyErrs; proxyErr.Kind != ErrorKindConnectionReset {
+			t.Errorf("expected proxy error of kind %q, got: %+v", ErrorKindConnectionReset, proxyErr)
+		}
+	})
+}
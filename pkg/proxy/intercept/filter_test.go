@@ -0,0 +1,83 @@
+package intercept_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/dstotijn/hetty/pkg/filter"
+	"github.com/dstotijn/hetty/pkg/proxy/intercept"
+)
+
+func TestMatchRequestFilterHeaders(t *testing.T) {
+	t.Parallel()
+
+	req := httptest.NewRequest(http.MethodGet, "https://example.com/", nil)
+	req.Header.Set("Authorization", "Bearer foo")
+	req.AddCookie(&http.Cookie{Name: "session", Value: "abc"})
+
+	tests := map[string]bool{
+		`header.authorization = "Bearer foo"`:         true,
+		`header.X-Foo != ""`:                          false,
+		`cookie.session = abc`:                        true,
+		`headers = "authorization: Bearer foo"`:       true,
+		`req.method = GET AND req.host = example.com`: true,
+		`req.headers = "authorization: Bearer foo"`:   true,
+		`res.statusCode = 200`:                        false,
+	}
+
+	for query, expMatch := range tests {
+		expr, err := filter.ParseQuery(query)
+		if err != nil {
+			t.Fatalf("unexpected error parsing query %q: %v", query, err)
+		}
+
+		got, err := intercept.MatchRequestFilter(req, expr)
+		if err != nil {
+			t.Fatalf("unexpected error matching query %q: %v", query, err)
+		}
+
+		if got != expMatch {
+			t.Errorf("query %q: expected match %v, got: %v", query, expMatch, got)
+		}
+	}
+}
+
+func TestMatchResponseFilterHeaders(t *testing.T) {
+	t.Parallel()
+
+	res := &http.Response{
+		StatusCode: http.StatusOK,
+		Status:     "200 OK",
+		Header: http.Header{
+			"Content-Type": []string{"application/json"},
+			"Set-Cookie":   []string{"session=abc; HttpOnly"},
+		},
+	}
+
+	tests := map[string]bool{
+		`header.content-type =~ "json"`:          true,
+		`cookie.session = abc`:                   true,
+		`cookie.theme = dark`:                    false,
+		`statusCode < 300 AND statusReason = OK`: true,
+		`res.statusCode >= 200`:                  true,
+		`statusCode > 50`:                        true,
+		`res.contentType = "application/json"`:   true,
+	}
+
+	for query, expMatch := range tests {
+		expr, err := filter.ParseQuery(query)
+		if err != nil {
+			t.Fatalf("unexpected error parsing query %q: %v", query, err)
+		}
+
+		got, err := intercept.MatchResponseFilter(res, expr)
+		if err != nil {
+			t.Fatalf("unexpected error matching query %q: %v", query, err)
+		}
+
+		if got != expMatch {
+			t.Errorf("query %q: expected match %v, got: %v", query, expMatch, got)
+		}
+	}
+}
@@ -15,8 +15,11 @@ import (
 	"github.com/dstotijn/hetty/pkg/proxy"
 )
 
+// ErrRequestAborted wraps proxy.ErrDropped, so the proxy handles an aborted
+// request or response as a cancelled request.
+var ErrRequestAborted = fmt.Errorf("intercept: request was aborted: %w", proxy.ErrDropped)
+
 var (
-	ErrRequestAborted   = errors.New("intercept: request was aborted")
 	ErrRequestNotFound  = errors.New("intercept: request not found")
 	ErrRequestDone      = errors.New("intercept: request is done")
 	ErrResponseNotFound = errors.New("intercept: response not found")
@@ -48,24 +51,28 @@ type Item struct {
 }
 
 type Service struct {
-	reqMu     *sync.RWMutex
-	resMu     *sync.RWMutex
-	requests  map[ulid.ULID]Request
-	responses map[ulid.ULID]Response
-	logger    log.Logger
-
-	requestsEnabled  bool
-	responsesEnabled bool
-	reqFilter        filter.Expression
-	resFilter        filter.Expression
+	reqMu      *sync.RWMutex
+	resMu      *sync.RWMutex
+	wsMu       *sync.RWMutex
+	requests   map[ulid.ULID]Request
+	responses  map[ulid.ULID]Response
+	wsMessages map[ulid.ULID]WebSocketMessage
+	logger     log.Logger
+
+	requestsEnabled   bool
+	responsesEnabled  bool
+	webSocketsEnabled bool
+	reqFilter         filter.Expression
+	resFilter         filter.Expression
 }
 
 type Config struct {
-	Logger           log.Logger
-	RequestsEnabled  bool
-	ResponsesEnabled bool
-	RequestFilter    filter.Expression
-	ResponseFilter   filter.Expression
+	Logger            log.Logger
+	RequestsEnabled   bool
+	ResponsesEnabled  bool
+	WebSocketsEnabled bool
+	RequestFilter     filter.Expression
+	ResponseFilter    filter.Expression
 }
 
 // RequestIDs implements sort.Interface.
@@ -73,15 +80,18 @@ type RequestIDs []ulid.ULID
 
 func NewService(cfg Config) *Service {
 	s := &Service{
-		reqMu:            &sync.RWMutex{},
-		resMu:            &sync.RWMutex{},
-		requests:         make(map[ulid.ULID]Request),
-		responses:        make(map[ulid.ULID]Response),
-		logger:           cfg.Logger,
-		requestsEnabled:  cfg.RequestsEnabled,
-		responsesEnabled: cfg.ResponsesEnabled,
-		reqFilter:        cfg.RequestFilter,
-		resFilter:        cfg.ResponseFilter,
+		reqMu:             &sync.RWMutex{},
+		resMu:             &sync.RWMutex{},
+		wsMu:              &sync.RWMutex{},
+		requests:          make(map[ulid.ULID]Request),
+		responses:         make(map[ulid.ULID]Response),
+		wsMessages:        make(map[ulid.ULID]WebSocketMessage),
+		logger:            cfg.Logger,
+		requestsEnabled:   cfg.RequestsEnabled,
+		responsesEnabled:  cfg.ResponsesEnabled,
+		webSocketsEnabled: cfg.WebSocketsEnabled,
+		reqFilter:         cfg.RequestFilter,
+		resFilter:         cfg.ResponseFilter,
 	}
 
 	if s.logger == nil {
@@ -102,12 +112,7 @@ func (svc *Service) RequestModifier(next proxy.RequestModifyFunc) proxy.RequestM
 		case errors.Is(err, ErrRequestAborted):
 			svc.logger.Debugw("Stopping intercept, request was aborted.")
 			// Prevent further processing by replacing req.Context with a cancelled context value.
-			// This will cause the http.Roundtripper in the `proxy` package to
-			// handle this request as an error.
-			ctx, cancel := context.WithCancel(context.Background())
-			cancel()
-
-			*req = *req.WithContext(ctx)
+			proxy.DropRequest(req, ErrRequestAborted)
 		case errors.Is(err, context.Canceled):
 			svc.logger.Debugw("Stopping intercept, context was cancelled.")
 		case err != nil:
@@ -284,8 +289,14 @@ func (svc *Service) UpdateSettings(settings Settings) {
 		svc.ClearResponses()
 	}
 
+	// When updating from WebSocket messages `enabled` -> `disabled`, relay any pending messages as-is.
+	if svc.webSocketsEnabled && !settings.WebSocketsEnabled {
+		svc.ClearWebSocketMessages()
+	}
+
 	svc.requestsEnabled = settings.RequestsEnabled
 	svc.responsesEnabled = settings.ResponsesEnabled
+	svc.webSocketsEnabled = settings.WebSocketsEnabled
 	svc.reqFilter = settings.RequestFilter
 	svc.resFilter = settings.ResponseFilter
 }
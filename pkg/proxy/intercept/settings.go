@@ -3,8 +3,9 @@ package intercept
 import "github.com/dstotijn/hetty/pkg/filter"
 
 type Settings struct {
-	RequestsEnabled  bool
-	ResponsesEnabled bool
-	RequestFilter    filter.Expression
-	ResponseFilter   filter.Expression
+	RequestsEnabled   bool
+	ResponsesEnabled  bool
+	WebSocketsEnabled bool
+	RequestFilter     filter.Expression
+	ResponseFilter    filter.Expression
 }
@@ -0,0 +1,150 @@
+package intercept
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/oklog/ulid"
+
+	"github.com/dstotijn/hetty/pkg/proxy"
+)
+
+var ErrWebSocketMessageNotFound = errors.New("intercept: websocket message not found")
+
+// WebSocketMessage represents a WebSocket message relayed by the proxy, alongside a channel for sending a modified
+// version of it to the routine that's awaiting it. Also contains a channel for receiving a cancellation signal.
+type WebSocketMessage struct {
+	msg  *proxy.WebSocketMessage
+	ch   chan<- *proxy.WebSocketMessage
+	done <-chan struct{}
+}
+
+// WebSocketModifier is a proxy.WebSocketModifyMiddleware for intercepting WebSocket messages.
+func (svc *Service) WebSocketModifier(next proxy.WebSocketModifyFunc) proxy.WebSocketModifyFunc {
+	return func(ctx context.Context, msg *proxy.WebSocketMessage) error {
+		// This is a blocking operation, that gets unblocked when either a modified message is returned or an error
+		// (typically `context.Canceled`).
+		modifiedMsg, err := svc.InterceptWebSocketMessage(ctx, msg)
+		if err != nil {
+			return fmt.Errorf("failed to intercept websocket message: %w", err)
+		}
+
+		*msg = *modifiedMsg
+
+		return next(ctx, msg)
+	}
+}
+
+// InterceptWebSocketMessage adds a WebSocket message to an array of pending intercepted messages, alongside channels
+// used for sending a cancellation signal and receiving a modified message. It's safe for concurrent use.
+func (svc *Service) InterceptWebSocketMessage(
+	ctx context.Context,
+	msg *proxy.WebSocketMessage,
+) (*proxy.WebSocketMessage, error) {
+	if !svc.webSocketsEnabled {
+		// If WebSocket message intercept is disabled, return the incoming message as-is.
+		svc.logger.Debugw("Bypassed websocket message interception: feature disabled.")
+		return msg, nil
+	}
+
+	ch := make(chan *proxy.WebSocketMessage)
+	done := make(chan struct{})
+
+	svc.wsMu.Lock()
+	svc.wsMessages[msg.ID] = WebSocketMessage{
+		msg:  msg,
+		ch:   ch,
+		done: done,
+	}
+	svc.wsMu.Unlock()
+
+	// Whatever happens next (modified message returned, or a context cancelled error), any blocked channel senders
+	// should be unblocked, and the message should be removed from the messages queue.
+	defer func() {
+		close(done)
+		svc.wsMu.Lock()
+		defer svc.wsMu.Unlock()
+		delete(svc.wsMessages, msg.ID)
+	}()
+
+	select {
+	case modMsg := <-ch:
+		if modMsg == nil {
+			return nil, ErrRequestAborted
+		}
+
+		return modMsg, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// ModifyWebSocketMessage sends a modified payload of an intercepted WebSocket message to the related channel, or
+// returns ErrRequestDone when the message was cancelled. Passing a nil payload drops the message. It's safe for
+// concurrent use.
+func (svc *Service) ModifyWebSocketMessage(msgID ulid.ULID, payload []byte) error {
+	svc.wsMu.RLock()
+	msg, ok := svc.wsMessages[msgID]
+	svc.wsMu.RUnlock()
+
+	if !ok {
+		return ErrWebSocketMessageNotFound
+	}
+
+	var modMsg *proxy.WebSocketMessage
+
+	if payload != nil {
+		modified := *msg.msg
+		modified.Payload = payload
+		modMsg = &modified
+	}
+
+	select {
+	case <-msg.done:
+		return ErrRequestDone
+	case msg.ch <- modMsg:
+		return nil
+	}
+}
+
+// CancelWebSocketMessage ensures an intercepted WebSocket message is dropped.
+func (svc *Service) CancelWebSocketMessage(msgID ulid.ULID) error {
+	return svc.ModifyWebSocketMessage(msgID, nil)
+}
+
+func (svc *Service) ClearWebSocketMessages() {
+	svc.wsMu.Lock()
+	defer svc.wsMu.Unlock()
+
+	for _, msg := range svc.wsMessages {
+		select {
+		case <-msg.done:
+		case msg.ch <- msg.msg:
+		}
+	}
+}
+
+// WebSocketMessages returns a list of pending intercepted WebSocket messages, sorted by ID. It's safe for concurrent
+// use.
+func (svc *Service) WebSocketMessages() []proxy.WebSocketMessage {
+	svc.wsMu.RLock()
+	defer svc.wsMu.RUnlock()
+
+	msgIDs := make([]ulid.ULID, 0, len(svc.wsMessages))
+
+	for id := range svc.wsMessages {
+		msgIDs = append(msgIDs, id)
+	}
+
+	sort.Sort(RequestIDs(msgIDs))
+
+	msgs := make([]proxy.WebSocketMessage, len(msgIDs))
+
+	for i, id := range msgIDs {
+		msgs[i] = *svc.wsMessages[id].msg
+	}
+
+	return msgs
+}
@@ -0,0 +1,138 @@
+package intercept_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/oklog/ulid"
+	"go.uber.org/zap"
+
+	"github.com/dstotijn/hetty/pkg/proxy"
+	"github.com/dstotijn/hetty/pkg/proxy/intercept"
+)
+
+func TestWebSocketModifier(t *testing.T) {
+	t.Parallel()
+
+	t.Run("modify websocket message that's not found", func(t *testing.T) {
+		t.Parallel()
+
+		logger, _ := zap.NewDevelopment()
+		svc := intercept.NewService(intercept.Config{
+			Logger:            logger.Sugar(),
+			WebSocketsEnabled: true,
+		})
+
+		msgID := ulid.MustNew(ulid.Timestamp(time.Now()), ulidEntropy)
+
+		err := svc.ModifyWebSocketMessage(msgID, []byte("foo"))
+		if !errors.Is(err, intercept.ErrWebSocketMessageNotFound) {
+			t.Fatalf("expected `intercept.ErrWebSocketMessageNotFound`, got: %v", err)
+		}
+	})
+
+	t.Run("modify intercepted websocket message", func(t *testing.T) {
+		t.Parallel()
+
+		logger, _ := zap.NewDevelopment()
+		svc := intercept.NewService(intercept.Config{
+			Logger:            logger.Sugar(),
+			WebSocketsEnabled: true,
+		})
+
+		msg := &proxy.WebSocketMessage{
+			ID:         ulid.MustNew(ulid.Timestamp(time.Now()), ulidEntropy),
+			Opcode:     proxy.WebSocketOpText,
+			Payload:    []byte("foo"),
+			FromClient: true,
+		}
+
+		var got *proxy.WebSocketMessage
+
+		next := func(ctx context.Context, msg *proxy.WebSocketMessage) error {
+			got = msg
+			return nil
+		}
+
+		var modErr error
+		var wg sync.WaitGroup
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+			modErr = svc.WebSocketModifier(next)(context.Background(), msg)
+		}()
+
+		// Wait shortly, to allow the modifier goroutine to add `msg` to the
+		// array of intercepted messages.
+		time.Sleep(10 * time.Millisecond)
+
+		if pending := svc.WebSocketMessages(); len(pending) != 1 {
+			t.Fatalf("expected 1 pending websocket message, got: %v", len(pending))
+		}
+
+		err := svc.ModifyWebSocketMessage(msg.ID, []byte("bar"))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		wg.Wait()
+
+		if modErr != nil {
+			t.Fatalf("unexpected error: %v", modErr)
+		}
+
+		if got == nil {
+			t.Fatal("expected `got` not to be nil")
+		}
+
+		if exp := "bar"; exp != string(got.Payload) {
+			t.Fatalf("incorrect modified payload (expected: %v, got: %v)", exp, string(got.Payload))
+		}
+	})
+
+	t.Run("cancel intercepted websocket message", func(t *testing.T) {
+		t.Parallel()
+
+		logger, _ := zap.NewDevelopment()
+		svc := intercept.NewService(intercept.Config{
+			Logger:            logger.Sugar(),
+			WebSocketsEnabled: true,
+		})
+
+		msg := &proxy.WebSocketMessage{
+			ID:      ulid.MustNew(ulid.Timestamp(time.Now()), ulidEntropy),
+			Opcode:  proxy.WebSocketOpText,
+			Payload: []byte("foo"),
+		}
+
+		next := func(ctx context.Context, msg *proxy.WebSocketMessage) error {
+			t.Fatal("next modifier must not be called for a cancelled message")
+			return nil
+		}
+
+		var modErr error
+		var wg sync.WaitGroup
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+			modErr = svc.WebSocketModifier(next)(context.Background(), msg)
+		}()
+
+		time.Sleep(10 * time.Millisecond)
+
+		if err := svc.CancelWebSocketMessage(msg.ID); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		wg.Wait()
+
+		if !errors.Is(modErr, intercept.ErrRequestAborted) {
+			t.Fatalf("expected `intercept.ErrRequestAborted`, got: %v", modErr)
+		}
+	})
+}
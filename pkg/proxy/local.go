@@ -0,0 +1,52 @@
+package proxy
+
+import (
+	"context"
+	"net/http"
+)
+
+// LocalResponseFunc returns a response for a request, that is used instead of
+// sending the request to the server.
+type LocalResponseFunc func(req *http.Request) (*http.Response, error)
+
+// localTransport returns local responses for requests that have a
+// LocalResponseFunc in their context, and uses the underlying transport for
+// all other requests.
+type localTransport struct {
+	base http.RoundTripper
+}
+
+// WithLocalResponse returns a context that makes the proxy answer a request
+// with the response returned by `fn`, without contacting the server. It's meant
+// to be used by request modifiers, e.g. for serving local files.
+func WithLocalResponse(ctx context.Context, fn LocalResponseFunc) context.Context {
+	return context.WithValue(ctx, localResponseKey, fn)
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *localTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	fn, ok := req.Context().Value(localResponseKey).(LocalResponseFunc)
+	if !ok {
+		return t.base.RoundTrip(req)
+	}
+
+	res, err := fn(req)
+	if err != nil {
+		return nil, err
+	}
+
+	res.Request = req
+
+	return res, nil
+}
+
+// CloseIdleConnections closes idle connections of the underlying transport.
+func (t *localTransport) CloseIdleConnections() {
+	type closeIdler interface {
+		CloseIdleConnections()
+	}
+
+	if tr, ok := t.base.(closeIdler); ok {
+		tr.CloseIdleConnections()
+	}
+}
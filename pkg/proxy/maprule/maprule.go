@@ -0,0 +1,170 @@
+// Package maprule provides Map Remote and Map Local rules. Map Remote rules
+// rewrite the URL of matching requests, so they're sent to another server. Map
+// Local rules answer matching requests with a local file or body, without
+// contacting the server.
+package maprule
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+
+	"github.com/oklog/ulid"
+
+	"github.com/dstotijn/hetty/pkg/proxy"
+)
+
+var ErrInvalidRule = errors.New("maprule: invalid rule")
+
+type contextKey int
+
+const matchKey contextKey = 0
+
+// RuleType is the type of a rule.
+type RuleType string
+
+const (
+	RuleTypeRemote RuleType = "remote"
+	RuleTypeLocal  RuleType = "local"
+)
+
+// Match describes the rule that was applied to a request.
+type Match struct {
+	Type     RuleType
+	RuleID   ulid.ULID
+	RuleName string
+	// OriginalURL is the URL of the request before the rule was applied.
+	OriginalURL string
+}
+
+// Engine holds Map Remote and Map Local rules, and applies these to requests.
+// It's safe for concurrent use.
+type Engine struct {
+	remoteRules []RemoteRule
+	localRules  []LocalRule
+	mu          sync.RWMutex
+}
+
+// RemoteRules returns the Map Remote rules.
+func (e *Engine) RemoteRules() []RemoteRule {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	return e.remoteRules
+}
+
+// SetRemoteRules replaces the Map Remote rules.
+func (e *Engine) SetRemoteRules(rules []RemoteRule) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.remoteRules = rules
+}
+
+// LocalRules returns the Map Local rules.
+func (e *Engine) LocalRules() []LocalRule {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	return e.localRules
+}
+
+// SetLocalRules replaces the Map Local rules.
+func (e *Engine) SetLocalRules(rules []LocalRule) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.localRules = rules
+}
+
+// RequestModifier applies the first enabled rule that matches the request URL.
+// Map Local rules are matched before Map Remote rules, because a local
+// response makes a rewritten URL irrelevant. The applied rule is available
+// via `FromContext` on the request context.
+func (e *Engine) RequestModifier(next proxy.RequestModifyFunc) proxy.RequestModifyFunc {
+	return func(req *http.Request) {
+		e.apply(req)
+		next(req)
+	}
+}
+
+func (e *Engine) apply(req *http.Request) {
+	rawURL := req.URL.String()
+
+	if rule, ok := e.matchLocalRule(rawURL); ok {
+		ctx := WithMatch(req.Context(), Match{
+			Type:        RuleTypeLocal,
+			RuleID:      rule.ID,
+			RuleName:    rule.Name,
+			OriginalURL: rawURL,
+		})
+		ctx = proxy.WithLocalResponse(ctx, rule.Response)
+
+		*req = *req.WithContext(ctx)
+
+		return
+	}
+
+	if rule, ok := e.matchRemoteRule(rawURL); ok {
+		rule.Apply(req)
+
+		*req = *req.WithContext(WithMatch(req.Context(), Match{
+			Type:        RuleTypeRemote,
+			RuleID:      rule.ID,
+			RuleName:    rule.Name,
+			OriginalURL: rawURL,
+		}))
+	}
+}
+
+func (e *Engine) matchLocalRule(rawURL string) (LocalRule, bool) {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	for _, rule := range e.localRules {
+		if rule.Enabled && rule.URL != nil && rule.URL.MatchString(rawURL) {
+			return rule, true
+		}
+	}
+
+	return LocalRule{}, false
+}
+
+func (e *Engine) matchRemoteRule(rawURL string) (RemoteRule, bool) {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	for _, rule := range e.remoteRules {
+		if rule.Enabled && rule.URL != nil && rule.URL.MatchString(rawURL) {
+			return rule, true
+		}
+	}
+
+	return RemoteRule{}, false
+}
+
+// WithMatch returns a context with the rule that was applied to a request.
+func WithMatch(ctx context.Context, match Match) context.Context {
+	return context.WithValue(ctx, matchKey, match)
+}
+
+// FromContext returns the rule that was applied to a request, if any.
+func FromContext(ctx context.Context) (Match, bool) {
+	match, ok := ctx.Value(matchKey).(Match)
+	return match, ok
+}
+
+func validatePort(port string) error {
+	if port == "" {
+		return nil
+	}
+
+	if n, err := strconv.Atoi(port); err != nil || n < 1 || n > 65535 {
+		return fmt.Errorf("%w: invalid port %q", ErrInvalidRule, port)
+	}
+
+	return nil
+}
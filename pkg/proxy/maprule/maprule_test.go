@@ -0,0 +1,332 @@
+package maprule_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/oklog/ulid"
+
+	"github.com/dstotijn/hetty/pkg/proxy/maprule"
+)
+
+func TestRemoteRuleApply(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		rule       maprule.RemoteRule
+		url        string
+		expURL     string
+		expReqHost string
+	}{
+		{
+			name: "host and port",
+			rule: maprule.RemoteRule{
+				URL:  regexp.MustCompile(`^https://example\.com/`),
+				Host: "localhost",
+				Port: "8080",
+			},
+			url:        "https://example.com/foo?bar=baz",
+			expURL:     "https://localhost:8080/foo?bar=baz",
+			expReqHost: "localhost:8080",
+		},
+		{
+			name: "scheme keeps port",
+			rule: maprule.RemoteRule{
+				URL:    regexp.MustCompile(`^https://example\.com:8443/`),
+				Scheme: "http",
+			},
+			url:        "https://example.com:8443/foo",
+			expURL:     "http://example.com:8443/foo",
+			expReqHost: "example.com:8443",
+		},
+		{
+			name: "path with capture group",
+			rule: maprule.RemoteRule{
+				URL:  regexp.MustCompile(`^https://example\.com/api/v1/([^?]*)`),
+				Path: "/v2/$1",
+			},
+			url:        "https://example.com/api/v1/users/1?debug=1",
+			expURL:     "https://example.com/v2/users/1?debug=1",
+			expReqHost: "example.com",
+		},
+		{
+			name: "path with query string",
+			rule: maprule.RemoteRule{
+				URL:  regexp.MustCompile(`^https://example\.com/search\?q=(\w+)`),
+				Path: "/find?query=$1",
+			},
+			url:        "https://example.com/search?q=hetty",
+			expURL:     "https://example.com/find?query=hetty",
+			expReqHost: "example.com",
+		},
+		{
+			name: "preserve host",
+			rule: maprule.RemoteRule{
+				URL:          regexp.MustCompile(`^https://example\.com/`),
+				Host:         "staging.example.com",
+				PreserveHost: true,
+			},
+			url:        "https://example.com/",
+			expURL:     "https://staging.example.com/",
+			expReqHost: "example.com",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if err := tt.rule.Validate(); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			req := httptest.NewRequest(http.MethodGet, tt.url, nil)
+			tt.rule.Apply(req)
+
+			if got := req.URL.String(); got != tt.expURL {
+				t.Errorf("expected URL %q, got: %q", tt.expURL, got)
+			}
+
+			if req.Host != tt.expReqHost {
+				t.Errorf("expected host %q, got: %q", tt.expReqHost, req.Host)
+			}
+		})
+	}
+}
+
+func TestLocalRuleResponse(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	if err := os.WriteFile(filepath.Join(dir, "app.js"), []byte("console.log(1)"), 0o600); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := os.Mkdir(filepath.Join(dir, "docs"), 0o700); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, "docs", "index.html"), []byte("<h1>Docs</h1>"), 0o600); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		name           string
+		rule           maprule.LocalRule
+		url            string
+		expStatusCode  int
+		expContentType string
+		expBody        string
+	}{
+		{
+			name: "inline body",
+			rule: maprule.LocalRule{
+				Body:       []byte(`{"ok":true}`),
+				StatusCode: http.StatusCreated,
+				Header:     http.Header{"Content-Type": []string{"application/json"}},
+			},
+			url:            "https://example.com/",
+			expStatusCode:  http.StatusCreated,
+			expContentType: "application/json",
+			expBody:        `{"ok":true}`,
+		},
+		{
+			name:           "file",
+			rule:           maprule.LocalRule{FilePath: filepath.Join(dir, "app.js")},
+			url:            "https://example.com/static/app.min.js",
+			expStatusCode:  http.StatusOK,
+			expContentType: "text/javascript; charset=utf-8",
+			expBody:        "console.log(1)",
+		},
+		{
+			name:           "directory index",
+			rule:           maprule.LocalRule{FilePath: dir},
+			url:            "https://example.com/docs/",
+			expStatusCode:  http.StatusOK,
+			expContentType: "text/html; charset=utf-8",
+			expBody:        "<h1>Docs</h1>",
+		},
+		{
+			name:           "directory traversal",
+			rule:           maprule.LocalRule{FilePath: filepath.Join(dir, "docs")},
+			url:            "https://example.com/../app.js",
+			expStatusCode:  http.StatusNotFound,
+			expContentType: "text/plain; charset=utf-8",
+			expBody:        "Hetty: Map Local file not found.\n",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			tt.rule.URL = regexp.MustCompile(`.*`)
+
+			if err := tt.rule.Validate(); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			req := httptest.NewRequest(http.MethodGet, tt.url, nil)
+
+			res, err := tt.rule.Response(req)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			defer res.Body.Close()
+
+			body, err := io.ReadAll(res.Body)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if res.StatusCode != tt.expStatusCode {
+				t.Errorf("expected status code %v, got: %v", tt.expStatusCode, res.StatusCode)
+			}
+
+			if got := res.Header.Get("Content-Type"); got != tt.expContentType {
+				t.Errorf("expected content type %q, got: %q", tt.expContentType, got)
+			}
+
+			if string(body) != tt.expBody {
+				t.Errorf("expected body %q, got: %q", tt.expBody, body)
+			}
+
+			if res.ContentLength != int64(len(body)) {
+				t.Errorf("expected content length %v, got: %v", len(body), res.ContentLength)
+			}
+		})
+	}
+}
+
+func TestEngineRequestModifier(t *testing.T) {
+	t.Parallel()
+
+	remoteID := ulid.MustNew(ulid.Now(), nil)
+	localID := ulid.MustNew(ulid.Now()+1, nil)
+
+	engine := &maprule.Engine{}
+	engine.SetRemoteRules([]maprule.RemoteRule{
+		{
+			Name:    "Disabled",
+			Enabled: false,
+			URL:     regexp.MustCompile(`example\.com`),
+			Host:    "disabled.example.com",
+		},
+		{
+			ID:      remoteID,
+			Name:    "Staging",
+			Enabled: true,
+			URL:     regexp.MustCompile(`^https://example\.com/`),
+			Host:    "staging.example.com",
+		},
+	})
+	engine.SetLocalRules([]maprule.LocalRule{
+		{
+			ID:      localID,
+			Name:    "Mock API",
+			Enabled: true,
+			URL:     regexp.MustCompile(`^https://example\.com/api/`),
+			Body:    []byte("mocked"),
+		},
+	})
+
+	var modifiedReq *http.Request
+
+	fn := engine.RequestModifier(func(req *http.Request) {
+		modifiedReq = req
+	})
+
+	// Map Local rules take precedence.
+	req := httptest.NewRequest(http.MethodGet, "https://example.com/api/users", nil)
+	fn(req)
+
+	got, ok := maprule.FromContext(modifiedReq.Context())
+	if !ok {
+		t.Fatal("expected match in context")
+	}
+
+	exp := maprule.Match{
+		Type:        maprule.RuleTypeLocal,
+		RuleID:      localID,
+		RuleName:    "Mock API",
+		OriginalURL: "https://example.com/api/users",
+	}
+	if diff := cmp.Diff(exp, got); diff != "" {
+		t.Fatalf("match not equal (-exp, +got):\n%v", diff)
+	}
+
+	if modifiedReq.URL.Host != "example.com" {
+		t.Errorf("expected URL of Map Local request to be unchanged, got: %v", modifiedReq.URL)
+	}
+
+	req = httptest.NewRequest(http.MethodGet, "https://example.com/", nil)
+	fn(req)
+
+	got, _ = maprule.FromContext(modifiedReq.Context())
+
+	exp = maprule.Match{
+		Type:        maprule.RuleTypeRemote,
+		RuleID:      remoteID,
+		RuleName:    "Staging",
+		OriginalURL: "https://example.com/",
+	}
+	if diff := cmp.Diff(exp, got); diff != "" {
+		t.Fatalf("match not equal (-exp, +got):\n%v", diff)
+	}
+
+	if got := modifiedReq.URL.String(); got != "https://staging.example.com/" {
+		t.Errorf("expected rewritten URL, got: %v", got)
+	}
+
+	req = httptest.NewRequest(http.MethodGet, "https://hetty.xyz/", nil)
+	fn(req)
+
+	if _, ok := maprule.FromContext(modifiedReq.Context()); ok {
+		t.Error("expected no match in context")
+	}
+}
+
+func TestRuleValidate(t *testing.T) {
+	t.Parallel()
+
+	u := regexp.MustCompile(`.*`)
+
+	invalidRemoteRules := []maprule.RemoteRule{
+		{},
+		{URL: u, Scheme: "ftp"},
+		{URL: u, Host: "example.com:8080"},
+		{URL: u, Port: "http"},
+		{URL: u, Path: "foo"},
+	}
+
+	for _, rule := range invalidRemoteRules {
+		if err := rule.Validate(); err == nil {
+			t.Errorf("expected error for Map Remote rule: %+v", rule)
+		}
+	}
+
+	invalidLocalRules := []maprule.LocalRule{
+		{},
+		{URL: u, FilePath: "relative/path"},
+		{URL: u, FilePath: "/tmp/foo", Body: []byte("foo")},
+		{URL: u, StatusCode: 1000},
+	}
+
+	for _, rule := range invalidLocalRules {
+		if err := rule.Validate(); err == nil {
+			t.Errorf("expected error for Map Local rule: %+v", rule)
+		}
+	}
+}
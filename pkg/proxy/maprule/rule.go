@@ -0,0 +1,375 @@
+package maprule
+
+import (
+	"bytes"
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"mime"
+	"net"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/oklog/ulid"
+)
+
+// RemoteRule rewrites the URL of matching requests (Map Remote). Empty URL
+// parts are left as-is.
+type RemoteRule struct {
+	ID      ulid.ULID
+	Name    string
+	Enabled bool
+	// URL is matched against the full request URL.
+	URL *regexp.Regexp
+
+	Scheme string
+	Host   string
+	Port   string
+	// Path can reference capture groups of URL, e.g. `/v2/$1`. When it
+	// contains a query string, the request query string is replaced as well.
+	Path string
+	// PreserveHost keeps the original `Host` header, instead of setting it to
+	// the rewritten host.
+	PreserveHost bool
+}
+
+// LocalRule answers matching requests with a local file or body, without
+// contacting the server (Map Local).
+type LocalRule struct {
+	ID      ulid.ULID
+	Name    string
+	Enabled bool
+	// URL is matched against the full request URL.
+	URL *regexp.Regexp
+
+	// FilePath is the file that is served. When it's a directory, the file at
+	// the request path (relative to the directory) is served.
+	FilePath string
+	// Body is served when FilePath is empty.
+	Body []byte
+	// StatusCode defaults to `200 OK`.
+	StatusCode int
+	// Header contains response headers. When `Content-Type` is missing, it's
+	// detected from the file extension or body.
+	Header http.Header
+}
+
+type remoteRuleDTO struct {
+	ID           ulid.ULID
+	Name         string
+	Enabled      bool
+	URL          string
+	Scheme       string
+	Host         string
+	Port         string
+	Path         string
+	PreserveHost bool
+}
+
+type localRuleDTO struct {
+	ID         ulid.ULID
+	Name       string
+	Enabled    bool
+	URL        string
+	FilePath   string
+	Body       []byte
+	StatusCode int
+	Header     http.Header
+}
+
+// Validate returns an error if the rule can't be applied.
+func (r RemoteRule) Validate() error {
+	if r.URL == nil {
+		return fmt.Errorf("%w: URL pattern is required", ErrInvalidRule)
+	}
+
+	if r.Scheme != "" && r.Scheme != "http" && r.Scheme != "https" {
+		return fmt.Errorf("%w: invalid scheme %q", ErrInvalidRule, r.Scheme)
+	}
+
+	if strings.ContainsAny(r.Host, "/:?#@") {
+		return fmt.Errorf("%w: invalid host %q", ErrInvalidRule, r.Host)
+	}
+
+	if r.Path != "" && !strings.HasPrefix(r.Path, "/") && !strings.HasPrefix(r.Path, "$") {
+		return fmt.Errorf("%w: path must start with `/`", ErrInvalidRule)
+	}
+
+	return validatePort(r.Port)
+}
+
+// Apply rewrites the request URL, and unless PreserveHost is set, the `Host`
+// header.
+func (r RemoteRule) Apply(req *http.Request) {
+	rawURL := req.URL.String()
+	u := *req.URL
+
+	if r.Scheme != "" {
+		u.Scheme = r.Scheme
+	}
+
+	if r.Host != "" || r.Port != "" {
+		host, port := u.Hostname(), u.Port()
+
+		if r.Host != "" {
+			host = r.Host
+		}
+
+		if r.Port != "" {
+			port = r.Port
+		}
+
+		if port == "" {
+			u.Host = host
+		} else {
+			u.Host = net.JoinHostPort(host, port)
+		}
+	}
+
+	if r.Path != "" {
+		submatches := r.URL.FindStringSubmatchIndex(rawURL)
+		expanded := string(r.URL.ExpandString(nil, r.Path, rawURL, submatches))
+
+		p, query, hasQuery := strings.Cut(expanded, "?")
+		u.Path = p
+		u.RawPath = ""
+
+		if hasQuery {
+			u.RawQuery = query
+		}
+	}
+
+	req.URL = &u
+
+	if !r.PreserveHost {
+		req.Host = u.Host
+	}
+}
+
+// Validate returns an error if the rule can't be applied.
+func (r LocalRule) Validate() error {
+	if r.URL == nil {
+		return fmt.Errorf("%w: URL pattern is required", ErrInvalidRule)
+	}
+
+	if r.FilePath != "" && len(r.Body) > 0 {
+		return fmt.Errorf("%w: file path and body are mutually exclusive", ErrInvalidRule)
+	}
+
+	if r.FilePath != "" && !filepath.IsAbs(r.FilePath) {
+		return fmt.Errorf("%w: file path must be absolute", ErrInvalidRule)
+	}
+
+	if r.StatusCode != 0 && (r.StatusCode < 100 || r.StatusCode > 599) {
+		return fmt.Errorf("%w: invalid status code %v", ErrInvalidRule, r.StatusCode)
+	}
+
+	return nil
+}
+
+// Response returns the response for a request. When the file to serve doesn't
+// exist, a `404 Not Found` response is returned.
+func (r LocalRule) Response(req *http.Request) (*http.Response, error) {
+	body := r.Body
+	statusCode := r.StatusCode
+	contentType := ""
+
+	if statusCode == 0 {
+		statusCode = http.StatusOK
+	}
+
+	if r.FilePath != "" {
+		filename, err := r.filename(req)
+		if err != nil {
+			return nil, err
+		}
+
+		body, err = os.ReadFile(filename)
+
+		switch {
+		case errors.Is(err, fs.ErrNotExist):
+			return newResponse(req, http.StatusNotFound, http.Header{
+				"Content-Type": []string{"text/plain; charset=utf-8"},
+			}, []byte("Hetty: Map Local file not found.\n")), nil
+		case err != nil:
+			return nil, fmt.Errorf("maprule: failed to read file: %w", err)
+		}
+
+		contentType = mime.TypeByExtension(filepath.Ext(filename))
+	}
+
+	header := r.Header.Clone()
+	if header == nil {
+		header = http.Header{}
+	}
+
+	if header.Get("Content-Type") == "" {
+		if contentType == "" && len(body) > 0 {
+			contentType = http.DetectContentType(body)
+		}
+
+		if contentType != "" {
+			header.Set("Content-Type", contentType)
+		}
+	}
+
+	return newResponse(req, statusCode, header, body), nil
+}
+
+// filename returns the file to serve for a request. When FilePath is a
+// directory, the request path is resolved within it; `index.html` is served
+// for directories.
+func (r LocalRule) filename(req *http.Request) (string, error) {
+	info, err := os.Stat(r.FilePath)
+	if err != nil || !info.IsDir() {
+		return r.FilePath, nil //nolint:nilerr
+	}
+
+	// Cleaning the path as an absolute path prevents traversal outside the
+	// directory.
+	filename := filepath.Join(r.FilePath, filepath.FromSlash(path.Clean("/"+req.URL.Path)))
+
+	info, err = os.Stat(filename)
+	if err == nil && info.IsDir() {
+		filename = filepath.Join(filename, "index.html")
+	}
+
+	return filename, nil
+}
+
+func newResponse(req *http.Request, statusCode int, header http.Header, body []byte) *http.Response {
+	header.Set("Content-Length", strconv.Itoa(len(body)))
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", statusCode, http.StatusText(statusCode)),
+		StatusCode:    statusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}
+}
+
+func (r RemoteRule) MarshalBinary() ([]byte, error) {
+	dto := remoteRuleDTO{
+		ID:           r.ID,
+		Name:         r.Name,
+		Enabled:      r.Enabled,
+		Scheme:       r.Scheme,
+		Host:         r.Host,
+		Port:         r.Port,
+		Path:         r.Path,
+		PreserveHost: r.PreserveHost,
+	}
+
+	if r.URL != nil {
+		dto.URL = r.URL.String()
+	}
+
+	buf := bytes.Buffer{}
+
+	err := gob.NewEncoder(&buf).Encode(dto)
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func (r *RemoteRule) UnmarshalBinary(data []byte) error {
+	dto := remoteRuleDTO{}
+
+	err := gob.NewDecoder(bytes.NewReader(data)).Decode(&dto)
+	if err != nil {
+		return err
+	}
+
+	u, err := compileOptional(dto.URL)
+	if err != nil {
+		return err
+	}
+
+	*r = RemoteRule{
+		ID:           dto.ID,
+		Name:         dto.Name,
+		Enabled:      dto.Enabled,
+		URL:          u,
+		Scheme:       dto.Scheme,
+		Host:         dto.Host,
+		Port:         dto.Port,
+		Path:         dto.Path,
+		PreserveHost: dto.PreserveHost,
+	}
+
+	return nil
+}
+
+func (r LocalRule) MarshalBinary() ([]byte, error) {
+	dto := localRuleDTO{
+		ID:         r.ID,
+		Name:       r.Name,
+		Enabled:    r.Enabled,
+		FilePath:   r.FilePath,
+		Body:       r.Body,
+		StatusCode: r.StatusCode,
+		Header:     r.Header,
+	}
+
+	if r.URL != nil {
+		dto.URL = r.URL.String()
+	}
+
+	buf := bytes.Buffer{}
+
+	err := gob.NewEncoder(&buf).Encode(dto)
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func (r *LocalRule) UnmarshalBinary(data []byte) error {
+	dto := localRuleDTO{}
+
+	err := gob.NewDecoder(bytes.NewReader(data)).Decode(&dto)
+	if err != nil {
+		return err
+	}
+
+	u, err := compileOptional(dto.URL)
+	if err != nil {
+		return err
+	}
+
+	*r = LocalRule{
+		ID:         dto.ID,
+		Name:       dto.Name,
+		Enabled:    dto.Enabled,
+		URL:        u,
+		FilePath:   dto.FilePath,
+		Body:       dto.Body,
+		StatusCode: dto.StatusCode,
+		Header:     dto.Header,
+	}
+
+	return nil
+}
+
+func compileOptional(expr string) (*regexp.Regexp, error) {
+	if expr == "" {
+		return nil, nil
+	}
+
+	return regexp.Compile(expr)
+}
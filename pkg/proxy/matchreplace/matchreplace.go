@@ -0,0 +1,434 @@
+// Package matchreplace provides match and replace rules, that automatically
+// rewrite parts of proxied requests and responses (e.g. stripping a header, or
+// replacing a value in a body).
+package matchreplace
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sync"
+
+	"github.com/oklog/ulid"
+
+	"github.com/dstotijn/hetty/pkg/filter"
+	"github.com/dstotijn/hetty/pkg/log"
+	"github.com/dstotijn/hetty/pkg/proxy"
+)
+
+var ErrInvalidRule = errors.New("matchreplace: invalid rule")
+
+// DefaultMaxBodySize is the maximum number of bytes of a request or response
+// body that rules are applied to, when no limit is configured. Larger bodies
+// are streamed as-is.
+const DefaultMaxBodySize = 10 << 20 // 10 MiB
+
+type contextKey int
+
+const (
+	originalRequestKey contextKey = iota
+	originalResponseKey
+)
+
+// Target is the part of a request or response that a rule applies to.
+type Target string
+
+const (
+	// TargetRequestLine matches the request line, e.g.
+	// `GET https://example.com/ HTTP/1.1`.
+	TargetRequestLine Target = "request_line"
+	// TargetRequestHeader matches each request header line, e.g.
+	// `User-Agent: curl/7.79.1`, including the `Host` header.
+	TargetRequestHeader Target = "request_header"
+	TargetRequestBody   Target = "request_body"
+	// TargetResponseHeader matches each response header line.
+	TargetResponseHeader Target = "response_header"
+	TargetResponseBody   Target = "response_body"
+)
+
+// Rule replaces occurrences of Match in the target part of a request or
+// response with Replace.
+type Rule struct {
+	ID      ulid.ULID
+	Name    string
+	Enabled bool
+	Target  Target
+	// Match is a literal string, or a regular expression when IsRegexp is
+	// true. For header targets, an empty Match adds Replace as a new header.
+	Match    string
+	IsRegexp bool
+	// Replace can reference capture groups (e.g. `$1`) when IsRegexp is true.
+	// For header targets, a header line that is replaced by an empty string is
+	// removed.
+	Replace string
+	// Condition is an optional filter expression. When set, the rule only
+	// applies to requests (or responses) that match it.
+	Condition filter.Expression
+}
+
+// Request is a request as it was before rules were applied.
+type Request struct {
+	Method string
+	URL    *url.URL
+	Proto  string
+	Header http.Header
+	// Body is only set if rules modified it.
+	Body         []byte
+	BodyModified bool
+	// RuleIDs are the IDs of the rules that modified the request.
+	RuleIDs []ulid.ULID
+}
+
+// Response is a response as it was before rules were applied.
+type Response struct {
+	Proto      string
+	StatusCode int
+	Status     string
+	Header     http.Header
+	// Body is only set if rules modified it.
+	Body         []byte
+	BodyModified bool
+	// RuleIDs are the IDs of the rules that modified the response.
+	RuleIDs []ulid.ULID
+}
+
+// Service applies match and replace rules to requests and responses. It's safe
+// for concurrent use.
+type Service struct {
+	rules       []compiledRule
+	maxBodySize int64
+	logger      log.Logger
+	mu          sync.RWMutex
+}
+
+type Config struct {
+	Logger log.Logger
+}
+
+type compiledRule struct {
+	Rule
+	re *regexp.Regexp
+}
+
+// NewService returns a new Service.
+func NewService(cfg Config) *Service {
+	svc := &Service{
+		logger: cfg.Logger,
+	}
+
+	if svc.logger == nil {
+		svc.logger = log.NewNopLogger()
+	}
+
+	return svc
+}
+
+// Validate returns an error if the rule can't be applied.
+func (r Rule) Validate() error {
+	switch r.Target {
+	case TargetRequestLine, TargetRequestHeader, TargetRequestBody, TargetResponseHeader, TargetResponseBody:
+	default:
+		return fmt.Errorf("%w: invalid target %q", ErrInvalidRule, r.Target)
+	}
+
+	if r.Match == "" && r.Target != TargetRequestHeader && r.Target != TargetResponseHeader {
+		return fmt.Errorf("%w: match must be set for target %q", ErrInvalidRule, r.Target)
+	}
+
+	if r.IsRegexp {
+		if _, err := regexp.Compile(r.Match); err != nil {
+			return fmt.Errorf("%w: invalid regular expression: %v", ErrInvalidRule, err)
+		}
+	}
+
+	return nil
+}
+
+// Rules returns the rules.
+func (svc *Service) Rules() []Rule {
+	svc.mu.RLock()
+	defer svc.mu.RUnlock()
+
+	rules := make([]Rule, len(svc.rules))
+	for i, rule := range svc.rules {
+		rules[i] = rule.Rule
+	}
+
+	return rules
+}
+
+// SetRules replaces the rules. Rules are applied in order, so a rule operates
+// on the output of the rules before it.
+func (svc *Service) SetRules(rules []Rule) error {
+	compiled := make([]compiledRule, len(rules))
+
+	for i, rule := range rules {
+		if err := rule.Validate(); err != nil {
+			return err
+		}
+
+		compiled[i] = compiledRule{Rule: rule}
+
+		if rule.IsRegexp {
+			compiled[i].re = regexp.MustCompile(rule.Match)
+		}
+	}
+
+	svc.mu.Lock()
+	defer svc.mu.Unlock()
+
+	svc.rules = compiled
+
+	return nil
+}
+
+// RequestModifier is a proxy.RequestModifyMiddleware that applies the request
+// rules. When any rule modified the request, the original request is available
+// via `OriginalRequestFromContext`.
+func (svc *Service) RequestModifier(next proxy.RequestModifyFunc) proxy.RequestModifyFunc {
+	return func(req *http.Request) {
+		if err := svc.modifyRequest(req); err != nil {
+			svc.logger.Errorw("Failed to apply match and replace rules to request.",
+				"error", err)
+		}
+
+		next(req)
+	}
+}
+
+// ResponseModifier is a proxy.ResponseModifyMiddleware that applies the
+// response rules. When any rule modified the response, the original response
+// is available via `OriginalResponseFromContext` on the context of
+// `res.Request`.
+func (svc *Service) ResponseModifier(next proxy.ResponseModifyFunc) proxy.ResponseModifyFunc {
+	return func(res *http.Response) error {
+		if err := svc.modifyResponse(res); err != nil {
+			svc.logger.Errorw("Failed to apply match and replace rules to response.",
+				"error", err)
+		}
+
+		return next(res)
+	}
+}
+
+// SetMaxBodySize sets the maximum number of bytes of request and response
+// bodies that rules are applied to. When `size` is zero, DefaultMaxBodySize is
+// used.
+func (svc *Service) SetMaxBodySize(size int64) {
+	svc.mu.Lock()
+	defer svc.mu.Unlock()
+
+	svc.maxBodySize = size
+}
+
+func (svc *Service) MaxBodySize() int64 {
+	svc.mu.RLock()
+	defer svc.mu.RUnlock()
+
+	if svc.maxBodySize <= 0 {
+		return DefaultMaxBodySize
+	}
+
+	return svc.maxBodySize
+}
+
+func (svc *Service) rulesFor(targets ...Target) []compiledRule {
+	svc.mu.RLock()
+	defer svc.mu.RUnlock()
+
+	var rules []compiledRule
+
+	for _, rule := range svc.rules {
+		if !rule.Enabled {
+			continue
+		}
+
+		for _, target := range targets {
+			if rule.Target == target {
+				rules = append(rules, rule)
+				break
+			}
+		}
+	}
+
+	return rules
+}
+
+func (svc *Service) modifyRequest(req *http.Request) error {
+	rules := svc.rulesFor(TargetRequestLine, TargetRequestHeader, TargetRequestBody)
+	if len(rules) == 0 {
+		return nil
+	}
+
+	orig := Request{
+		Method: req.Method,
+		URL:    cloneURL(req.URL),
+		Proto:  req.Proto,
+		Header: req.Header.Clone(),
+	}
+
+	for _, rule := range rules {
+		if rule.Condition != nil {
+			match, err := filter.Evaluate(rule.Condition, requestFields(req, svc.MaxBodySize()))
+			if err != nil {
+				return fmt.Errorf("failed to match condition (rule: %v): %w", rule.Name, err)
+			}
+
+			if !match {
+				continue
+			}
+		}
+
+		var (
+			modified bool
+			err      error
+		)
+
+		switch rule.Target {
+		case TargetRequestLine:
+			modified, err = rule.replaceRequestLine(req)
+		case TargetRequestHeader:
+			modified, err = rule.replaceRequestHeader(req)
+		case TargetRequestBody:
+			var body []byte
+
+			body, modified, err = rule.replaceRequestBody(req, svc.MaxBodySize())
+			if modified && !orig.BodyModified {
+				orig.Body = body
+				orig.BodyModified = true
+			}
+		}
+
+		if err != nil {
+			return fmt.Errorf("failed to apply rule (name: %v): %w", rule.Name, err)
+		}
+
+		if modified {
+			orig.RuleIDs = append(orig.RuleIDs, rule.ID)
+		}
+	}
+
+	if len(orig.RuleIDs) > 0 {
+		*req = *req.WithContext(context.WithValue(req.Context(), originalRequestKey, orig))
+	}
+
+	return nil
+}
+
+func (svc *Service) modifyResponse(res *http.Response) error {
+	rules := svc.rulesFor(TargetResponseHeader, TargetResponseBody)
+	if len(rules) == 0 {
+		return nil
+	}
+
+	orig := Response{
+		Proto:      res.Proto,
+		StatusCode: res.StatusCode,
+		Status:     res.Status,
+		Header:     res.Header.Clone(),
+	}
+
+	for _, rule := range rules {
+		if rule.Condition != nil {
+			match, err := filter.Evaluate(rule.Condition, responseFields(res, svc.MaxBodySize()))
+			if err != nil {
+				return fmt.Errorf("failed to match condition (rule: %v): %w", rule.Name, err)
+			}
+
+			if !match {
+				continue
+			}
+		}
+
+		var (
+			modified bool
+			err      error
+		)
+
+		switch rule.Target {
+		case TargetResponseHeader:
+			modified, err = rule.replaceHeader(res.Header, nil)
+		case TargetResponseBody:
+			var body []byte
+
+			body, modified, err = rule.replaceResponseBody(res, svc.MaxBodySize())
+			if modified && !orig.BodyModified {
+				orig.Body = body
+				orig.BodyModified = true
+			}
+		}
+
+		if err != nil {
+			return fmt.Errorf("failed to apply rule (name: %v): %w", rule.Name, err)
+		}
+
+		if modified {
+			orig.RuleIDs = append(orig.RuleIDs, rule.ID)
+		}
+	}
+
+	if len(orig.RuleIDs) > 0 && res.Request != nil {
+		res.Request = res.Request.WithContext(context.WithValue(res.Request.Context(), originalResponseKey, orig))
+	}
+
+	return nil
+}
+
+// requestFields returns the filter fields for the condition of a request rule.
+// Unprefixed keys (e.g. `method`) resolve to request fields.
+func requestFields(req *http.Request, maxBodySize int64) filter.Fields {
+	return filter.PrefixedFields{
+		HTTPFields: filter.HTTPFields{
+			Request: filter.NewHTTPRequest(req, conditionBody(&req.Body, req.Header, maxBodySize)),
+		},
+		Prefix: "req.",
+	}
+}
+
+// responseFields returns the filter fields for the condition of a response
+// rule. Unprefixed keys (e.g. `statusCode`) resolve to response fields, and the
+// request (e.g. `req.host`) can be matched with prefixed keys. The request body
+// was already sent, so `req.body` resolves to an empty body.
+func responseFields(res *http.Response, maxBodySize int64) filter.Fields {
+	fields := filter.HTTPFields{
+		Response: filter.NewHTTPResponse(res, conditionBody(&res.Body, res.Header, maxBodySize)),
+	}
+
+	if res.Request != nil {
+		fields.Request = filter.NewHTTPRequest(res.Request, nil)
+	}
+
+	return filter.PrefixedFields{HTTPFields: fields, Prefix: "res."}
+}
+
+// OriginalRequestFromContext returns the request as it was before rules were
+// applied, if any rule modified it.
+func OriginalRequestFromContext(ctx context.Context) (Request, bool) {
+	orig, ok := ctx.Value(originalRequestKey).(Request)
+	return orig, ok
+}
+
+// OriginalResponseFromContext returns the response as it was before rules were
+// applied, if any rule modified it.
+func OriginalResponseFromContext(ctx context.Context) (Response, bool) {
+	orig, ok := ctx.Value(originalResponseKey).(Response)
+	return orig, ok
+}
+
+func cloneURL(u *url.URL) *url.URL {
+	if u == nil {
+		return nil
+	}
+
+	clone := *u
+
+	if u.User != nil {
+		user := *u.User
+		clone.User = &user
+	}
+
+	return &clone
+}
@@ -0,0 +1,422 @@
+package matchreplace_test
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/oklog/ulid"
+
+	"github.com/dstotijn/hetty/pkg/filter"
+	"github.com/dstotijn/hetty/pkg/proxy/matchreplace"
+)
+
+func TestRequestModifier(t *testing.T) {
+	t.Parallel()
+
+	uaRuleID := ulid.MustNew(1, nil)
+	lineRuleID := ulid.MustNew(2, nil)
+	bodyRuleID := ulid.MustNew(3, nil)
+
+	svc := matchreplace.NewService(matchreplace.Config{})
+
+	err := svc.SetRules([]matchreplace.Rule{
+		{
+			ID:       uaRuleID,
+			Enabled:  true,
+			Target:   matchreplace.TargetRequestHeader,
+			Match:    `^User-Agent: .*$`,
+			IsRegexp: true,
+			Replace:  "User-Agent: hetty",
+		},
+		{
+			ID:      ulid.MustNew(4, nil),
+			Enabled: true,
+			Target:  matchreplace.TargetRequestHeader,
+			Replace: "X-Added: foobar",
+		},
+		{
+			ID:      lineRuleID,
+			Enabled: true,
+			Target:  matchreplace.TargetRequestLine,
+			Match:   "/v1/",
+			Replace: "/v2/",
+		},
+		{
+			ID:       bodyRuleID,
+			Enabled:  true,
+			Target:   matchreplace.TargetRequestBody,
+			Match:    `"role":"(\w+)"`,
+			IsRegexp: true,
+			Replace:  `"role":"admin","was":"$1"`,
+		},
+		{
+			Enabled: false,
+			Target:  matchreplace.TargetRequestBody,
+			Match:   "admin",
+			Replace: "disabled",
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	req := httptest.NewRequest(http.MethodPost, "https://example.com/v1/users", strings.NewReader(`{"role":"user"}`))
+	req.Header.Set("User-Agent", "curl/7.79.1")
+
+	var modifiedReq *http.Request
+
+	svc.RequestModifier(func(req *http.Request) {
+		modifiedReq = req
+	})(req)
+
+	if got := modifiedReq.URL.String(); got != "https://example.com/v2/users" {
+		t.Errorf("expected rewritten URL, got: %v", got)
+	}
+
+	expHeader := http.Header{
+		"User-Agent": []string{"hetty"},
+		"X-Added":    []string{"foobar"},
+	}
+	if diff := cmp.Diff(expHeader, modifiedReq.Header); diff != "" {
+		t.Errorf("header not equal (-exp, +got):\n%v", diff)
+	}
+
+	if modifiedReq.Host != "example.com" {
+		t.Errorf("expected host to be preserved, got: %v", modifiedReq.Host)
+	}
+
+	body, err := io.ReadAll(modifiedReq.Body)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if expBody := `{"role":"admin","was":"user"}`; string(body) != expBody {
+		t.Errorf("expected body %q, got: %q", expBody, body)
+	}
+
+	if modifiedReq.ContentLength != int64(len(body)) {
+		t.Errorf("expected content length %v, got: %v", len(body), modifiedReq.ContentLength)
+	}
+
+	orig, ok := matchreplace.OriginalRequestFromContext(modifiedReq.Context())
+	if !ok {
+		t.Fatal("expected original request in context")
+	}
+
+	if got := orig.URL.String(); got != "https://example.com/v1/users" {
+		t.Errorf("expected original URL, got: %v", got)
+	}
+
+	if got := orig.Header.Get("User-Agent"); got != "curl/7.79.1" {
+		t.Errorf("expected original user agent, got: %v", got)
+	}
+
+	if !orig.BodyModified || string(orig.Body) != `{"role":"user"}` {
+		t.Errorf("expected original body, got: %q (modified: %v)", orig.Body, orig.BodyModified)
+	}
+
+	if len(orig.RuleIDs) != 4 {
+		t.Errorf("expected 4 applied rules, got: %v", len(orig.RuleIDs))
+	}
+}
+
+func TestResponseModifier(t *testing.T) {
+	t.Parallel()
+
+	cond, err := filter.ParseQuery(`statusCode = 200`)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	svc := matchreplace.NewService(matchreplace.Config{})
+
+	err = svc.SetRules([]matchreplace.Rule{
+		{
+			Enabled:  true,
+			Target:   matchreplace.TargetResponseHeader,
+			Match:    `(?i)^Content-Security-Policy:.*$`,
+			IsRegexp: true,
+			Replace:  "",
+		},
+		{
+			Enabled:   true,
+			Target:    matchreplace.TargetResponseBody,
+			Match:     `"isAdmin":false`,
+			Replace:   `"isAdmin":true`,
+			Condition: cond,
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		name        string
+		statusCode  int
+		expBody     string
+		expModified bool
+	}{
+		{
+			name:        "condition matches",
+			statusCode:  http.StatusOK,
+			expBody:     `{"isAdmin":true}`,
+			expModified: true,
+		},
+		{
+			name:        "condition doesn't match",
+			statusCode:  http.StatusForbidden,
+			expBody:     `{"isAdmin":false}`,
+			expModified: false,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := httptest.NewRequest(http.MethodGet, "https://example.com/", nil)
+			res := &http.Response{
+				StatusCode: tt.statusCode,
+				Status:     http.StatusText(tt.statusCode),
+				Proto:      "HTTP/1.1",
+				Header: http.Header{
+					"Content-Security-Policy": []string{"default-src 'self'"},
+					"Content-Length":          []string{"17"},
+				},
+				Body:    io.NopCloser(strings.NewReader(`{"isAdmin":false}`)),
+				Request: req,
+			}
+
+			err := svc.ResponseModifier(func(*http.Response) error { return nil })(res)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if got := res.Header.Get("Content-Security-Policy"); got != "" {
+				t.Errorf("expected header to be removed, got: %v", got)
+			}
+
+			body, err := io.ReadAll(res.Body)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if string(body) != tt.expBody {
+				t.Errorf("expected body %q, got: %q", tt.expBody, body)
+			}
+
+			if got := res.Header.Get("Content-Length"); got != strconv.Itoa(len(body)) {
+				t.Errorf("expected content length %v, got: %v", len(body), got)
+			}
+
+			orig, ok := matchreplace.OriginalResponseFromContext(res.Request.Context())
+			if !ok {
+				t.Fatal("expected original response in context")
+			}
+
+			if got := orig.Header.Get("Content-Security-Policy"); got == "" {
+				t.Error("expected original header")
+			}
+
+			if orig.BodyModified != tt.expModified {
+				t.Errorf("expected body modified to be %v, got: %v", tt.expModified, orig.BodyModified)
+			}
+		})
+	}
+}
+
+func TestResponseModifierRequestCondition(t *testing.T) {
+	t.Parallel()
+
+	cond, err := filter.ParseQuery(`req.host = "api.example.com" AND statusCode = 200 AND res.body =~ "isAdmin"`)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	svc := matchreplace.NewService(matchreplace.Config{})
+
+	err = svc.SetRules([]matchreplace.Rule{
+		{
+			Enabled:   true,
+			Target:    matchreplace.TargetResponseBody,
+			Match:     `"isAdmin":false`,
+			Replace:   `"isAdmin":true`,
+			Condition: cond,
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		url     string
+		expBody string
+	}{
+		{
+			url:     "https://api.example.com/me",
+			expBody: `{"isAdmin":true}`,
+		},
+		{
+			url:     "https://www.example.com/me",
+			expBody: `{"isAdmin":false}`,
+		},
+	}
+
+	for _, tt := range tests {
+		res := &http.Response{
+			StatusCode: http.StatusOK,
+			Status:     "200 OK",
+			Proto:      "HTTP/1.1",
+			Header:     http.Header{},
+			Body:       io.NopCloser(strings.NewReader(`{"isAdmin":false}`)),
+			Request:    httptest.NewRequest(http.MethodGet, tt.url, nil),
+		}
+
+		err := svc.ResponseModifier(func(*http.Response) error { return nil })(res)
+		if err != nil {
+			t.Fatalf("%v: unexpected error: %v", tt.url, err)
+		}
+
+		body, err := io.ReadAll(res.Body)
+		if err != nil {
+			t.Fatalf("%v: unexpected error: %v", tt.url, err)
+		}
+
+		if string(body) != tt.expBody {
+			t.Errorf("%v: expected body %q, got: %q", tt.url, tt.expBody, body)
+		}
+	}
+}
+
+func TestSetRulesInvalid(t *testing.T) {
+	t.Parallel()
+
+	svc := matchreplace.NewService(matchreplace.Config{})
+
+	rules := [][]matchreplace.Rule{
+		{{Target: "foobar", Match: "foo"}},
+		{{Target: matchreplace.TargetRequestBody, Match: ""}},
+		{{Target: matchreplace.TargetRequestLine, Match: "(", IsRegexp: true}},
+	}
+
+	for _, r := range rules {
+		if err := svc.SetRules(r); !errors.Is(err, matchreplace.ErrInvalidRule) {
+			t.Errorf("expected `matchreplace.ErrInvalidRule` for %+v, got: %v", r, err)
+		}
+	}
+}
+
+func TestResponseModifierBodyLimits(t *testing.T) {
+	t.Parallel()
+
+	svc := matchreplace.NewService(matchreplace.Config{})
+	svc.SetMaxBodySize(16)
+
+	err := svc.SetRules([]matchreplace.Rule{
+		{
+			Enabled: true,
+			Target:  matchreplace.TargetResponseBody,
+			Match:   "foo",
+			Replace: "bar",
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	errRead := errors.New("read error")
+
+	tests := []struct {
+		name        string
+		contentType string
+		body        func() io.ReadCloser
+		endless     bool
+		expBody     string
+		expErr      error
+	}{
+		{
+			name:    "within limit",
+			body:    func() io.ReadCloser { return io.NopCloser(strings.NewReader("foo")) },
+			expBody: "bar",
+		},
+		{
+			name:    "exceeds limit",
+			body:    func() io.ReadCloser { return io.NopCloser(strings.NewReader("foo foo foo foo foo")) },
+			expBody: "foo foo foo foo foo",
+		},
+		{
+			name:        "streaming content type",
+			contentType: "text/event-stream",
+			body: func() io.ReadCloser {
+				// The body never ends, so it must not be buffered.
+				pr, pw := io.Pipe()
+				go func() { _, _ = io.WriteString(pw, "data: foo\n\n") }()
+				return pr
+			},
+			endless: true,
+			expBody: "data: foo\n\n",
+		},
+		{
+			name: "read error",
+			body: func() io.ReadCloser {
+				return io.NopCloser(io.MultiReader(strings.NewReader("foo"), iotest.ErrReader(errRead)))
+			},
+			expBody: "foo",
+			expErr:  errRead,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			res := &http.Response{
+				StatusCode: http.StatusOK,
+				Proto:      "HTTP/1.1",
+				Header:     http.Header{"Content-Type": []string{tt.contentType}},
+				Body:       tt.body(),
+				Request:    httptest.NewRequest(http.MethodGet, "https://example.com/", nil),
+			}
+
+			err := svc.ResponseModifier(func(*http.Response) error { return nil })(res)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if tt.endless {
+				defer res.Body.Close()
+
+				body := make([]byte, len(tt.expBody))
+				if _, err := io.ReadFull(res.Body, body); err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+
+				if string(body) != tt.expBody {
+					t.Errorf("expected body %q, got: %q", tt.expBody, body)
+				}
+
+				return
+			}
+
+			body, err := io.ReadAll(res.Body)
+			if !errors.Is(err, tt.expErr) {
+				t.Fatalf("expected error %v, got: %v", tt.expErr, err)
+			}
+
+			if string(body) != tt.expBody {
+				t.Errorf("expected body %q, got: %q", tt.expBody, body)
+			}
+		})
+	}
+}
@@ -0,0 +1,266 @@
+package matchreplace
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/dstotijn/hetty/pkg/proxy"
+)
+
+func (r compiledRule) replace(s string) string {
+	if r.re != nil {
+		return r.re.ReplaceAllString(s, r.Replace)
+	}
+
+	return strings.ReplaceAll(s, r.Match, r.Replace)
+}
+
+// replaceRequestLine applies the rule to the request line, and updates the
+// request method, URL and protocol.
+func (r compiledRule) replaceRequestLine(req *http.Request) (bool, error) {
+	line := fmt.Sprintf("%v %v %v", req.Method, req.URL, req.Proto)
+
+	replaced := r.replace(line)
+	if replaced == line {
+		return false, nil
+	}
+
+	parts := strings.Fields(replaced)
+	if len(parts) != 3 {
+		return false, fmt.Errorf("invalid request line %q", replaced)
+	}
+
+	u, err := url.Parse(parts[1])
+	if err != nil {
+		return false, fmt.Errorf("invalid URL in request line: %w", err)
+	}
+
+	if !u.IsAbs() || u.Host == "" {
+		return false, fmt.Errorf("URL in request line must be absolute, got %q", parts[1])
+	}
+
+	major, minor, ok := http.ParseHTTPVersion(parts[2])
+	if !ok {
+		return false, fmt.Errorf("invalid protocol in request line %q", parts[2])
+	}
+
+	if u.Host != req.URL.Host {
+		req.Host = u.Host
+	}
+
+	req.Method = parts[0]
+	req.URL = u
+	req.Proto, req.ProtoMajor, req.ProtoMinor = parts[2], major, minor
+
+	return true, nil
+}
+
+func (r compiledRule) replaceRequestHeader(req *http.Request) (bool, error) {
+	return r.replaceHeader(req.Header, &req.Host)
+}
+
+// replaceHeader applies the rule to each header line (`<key>: <value>`). When
+// `host` is not nil, it's included as a `Host` header.
+func (r compiledRule) replaceHeader(header http.Header, host *string) (bool, error) {
+	var lines []string
+
+	if host != nil && *host != "" {
+		lines = append(lines, "Host: "+*host)
+	}
+
+	keys := make([]string, 0, len(header))
+	for key := range header {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		for _, value := range header[key] {
+			lines = append(lines, key+": "+value)
+		}
+	}
+
+	modified := false
+	replaced := make([]string, 0, len(lines)+1)
+
+	if r.Match == "" {
+		if r.Replace != "" {
+			replaced = append(replaced, lines...)
+			replaced = append(replaced, r.Replace)
+			modified = true
+		}
+	} else {
+		for _, line := range lines {
+			newLine := r.replace(line)
+			if newLine != line {
+				modified = true
+			}
+
+			if newLine != "" {
+				replaced = append(replaced, newLine)
+			}
+		}
+	}
+
+	if !modified {
+		return false, nil
+	}
+
+	newHeader := make(http.Header, len(replaced))
+	newHost := ""
+
+	for _, line := range replaced {
+		key, value, ok := strings.Cut(line, ":")
+		key = strings.TrimSpace(key)
+
+		if !ok || key == "" || strings.ContainsAny(key, " \t") {
+			return false, fmt.Errorf("invalid header line %q", line)
+		}
+
+		if host != nil && strings.EqualFold(key, "Host") {
+			newHost = strings.TrimSpace(value)
+			continue
+		}
+
+		newHeader.Add(key, strings.TrimSpace(value))
+	}
+
+	for key := range header {
+		delete(header, key)
+	}
+
+	for key, values := range newHeader {
+		header[key] = values
+	}
+
+	if host != nil {
+		*host = newHost
+	}
+
+	return true, nil
+}
+
+// conditionBody returns a function that reads up to `maxSize` bytes of `body`
+// for evaluating a condition, and puts them back in front of the unread body.
+// Streaming bodies aren't read, and resolve to an empty body.
+func conditionBody(body *io.ReadCloser, header http.Header, maxSize int64) func() ([]byte, error) {
+	if *body == nil || *body == http.NoBody || proxy.IsStreamingBody(header) {
+		return nil
+	}
+
+	var (
+		buf  []byte
+		err  error
+		read bool
+	)
+
+	return func() ([]byte, error) {
+		if read {
+			return buf, err
+		}
+
+		read = true
+		buf, err = io.ReadAll(io.LimitReader(*body, maxSize))
+
+		*body = struct {
+			io.Reader
+			io.Closer
+		}{io.MultiReader(bytes.NewReader(buf), *body), *body}
+
+		return buf, err
+	}
+}
+
+// replaceRequestBody applies the rule to the request body. It returns the
+// original body. Bodies larger than `maxSize` are left as-is.
+func (r compiledRule) replaceRequestBody(req *http.Request, maxSize int64) ([]byte, bool, error) {
+	if req.Body == nil || req.Body == http.NoBody || proxy.IsStreamingBody(req.Header) {
+		return nil, false, nil
+	}
+
+	body, newBody, ok, err := r.replaceBody(&req.Body, maxSize)
+	if err != nil || !ok {
+		return nil, false, err
+	}
+
+	if bytes.Equal(body, newBody) {
+		req.Body = io.NopCloser(bytes.NewReader(body))
+		return nil, false, nil
+	}
+
+	req.Body = io.NopCloser(bytes.NewReader(newBody))
+	req.ContentLength = int64(len(newBody))
+
+	if req.Header.Get("Content-Length") != "" {
+		req.Header.Set("Content-Length", strconv.Itoa(len(newBody)))
+	}
+
+	return body, true, nil
+}
+
+// replaceResponseBody applies the rule to the response body. It returns the
+// original body. Bodies that are still encoded (e.g. gzip) because they
+// couldn't be decoded, or that are larger than `maxSize`, are left as-is.
+func (r compiledRule) replaceResponseBody(res *http.Response, maxSize int64) ([]byte, bool, error) {
+	if res.Body == nil || res.Body == http.NoBody || res.StatusCode == http.StatusSwitchingProtocols ||
+		res.Header.Get("Content-Encoding") != "" || proxy.IsStreamingBody(res.Header) {
+		return nil, false, nil
+	}
+
+	body, newBody, ok, err := r.replaceBody(&res.Body, maxSize)
+	if err != nil || !ok {
+		return nil, false, err
+	}
+
+	if bytes.Equal(body, newBody) {
+		res.Body = io.NopCloser(bytes.NewReader(body))
+		return nil, false, nil
+	}
+
+	res.Body = io.NopCloser(bytes.NewReader(newBody))
+	res.ContentLength = int64(len(newBody))
+
+	if res.Header.Get("Content-Length") != "" {
+		res.Header.Set("Content-Length", strconv.Itoa(len(newBody)))
+	}
+
+	return body, true, nil
+}
+
+// replaceBody reads up to `maxSize` bytes of `body`, and returns both the
+// original and the replaced body. If the body is larger, or can't be read, the
+// bytes read so far are put back in front of the unread body, and the boolean
+// is false.
+func (r compiledRule) replaceBody(body *io.ReadCloser, maxSize int64) ([]byte, []byte, bool, error) {
+	buf, err := io.ReadAll(io.LimitReader(*body, maxSize+1))
+
+	if err != nil || int64(len(buf)) > maxSize {
+		*body = struct {
+			io.Reader
+			io.Closer
+		}{io.MultiReader(bytes.NewReader(buf), *body), *body}
+
+		if err != nil {
+			return nil, nil, false, fmt.Errorf("failed to read body: %w", err)
+		}
+
+		return nil, nil, false, nil
+	}
+
+	if err := (*body).Close(); err != nil {
+		return nil, nil, false, fmt.Errorf("failed to close body: %w", err)
+	}
+
+	if r.re != nil {
+		return buf, r.re.ReplaceAll(buf, []byte(r.Replace)), true, nil
+	}
+
+	return buf, bytes.ReplaceAll(buf, []byte(r.Match), []byte(r.Replace)), true, nil
+}
@@ -1,12 +1,36 @@
 package proxy
 
-import "net/http"
+import (
+	"context"
+	"mime"
+	"net/http"
+)
 
 var (
 	nopReqModifier = func(req *http.Request) {}
 	nopResModifier = func(res *http.Response) error { return nil }
+	nopWSModifier  = func(ctx context.Context, msg *WebSocketMessage) error { return nil }
 )
 
+// streamingMediaTypes are media types of bodies that are typically streamed
+// indefinitely (e.g. server-sent events).
+var streamingMediaTypes = map[string]bool{
+	"text/event-stream":         true,
+	"application/x-ndjson":      true,
+	"application/stream+json":   true,
+	"application/grpc":          true,
+	"multipart/x-mixed-replace": true,
+}
+
+// IsStreamingBody returns true if the `Content-Type` header is of a body that's
+// typically streamed indefinitely (e.g. server-sent events). Modifiers
+// shouldn't buffer these bodies, because reading them blocks until the stream
+// ends.
+func IsStreamingBody(header http.Header) bool {
+	mediaType, _, _ := mime.ParseMediaType(header.Get("Content-Type"))
+	return streamingMediaTypes[mediaType]
+}
+
 // RequestModifyFunc defines a type for a function that can modify a HTTP
 // request before it's proxied.
 type RequestModifyFunc func(req *http.Request)
@@ -22,3 +46,13 @@ type ResponseModifyFunc func(res *http.Response) error
 // ResponseModifyMiddleware defines a type for chaining response modifier
 // middleware.
 type ResponseModifyMiddleware func(ResponseModifyFunc) ResponseModifyFunc
+
+// WebSocketModifyFunc defines a type for a function that can modify a
+// WebSocket message before it's relayed. The context is the one of the HTTP
+// request that initiated the WebSocket handshake. Returning an error drops the
+// message.
+type WebSocketModifyFunc func(ctx context.Context, msg *WebSocketMessage) error
+
+// WebSocketModifyMiddleware defines a type for chaining WebSocket message
+// modifier middleware.
+type WebSocketModifyMiddleware func(next WebSocketModifyFunc) WebSocketModifyFunc
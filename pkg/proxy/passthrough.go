@@ -0,0 +1,217 @@
+package proxy
+
+import (
+	"bytes"
+	"context"
+	"encoding/gob"
+	"io"
+	"net"
+	"net/http"
+	"path"
+	"regexp"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/oklog/ulid"
+)
+
+// PassthroughRule matches tunnels that must not be intercepted. Matching
+// tunnels are relayed as-is, without TLS termination.
+type PassthroughRule struct {
+	// Host is a regular expression that is matched against the hostname.
+	Host *regexp.Regexp
+	// HostGlob is a glob pattern (e.g. `*.apple.com`) that is matched against
+	// the hostname, case-insensitively. When set, it's used instead of Host.
+	HostGlob string
+	// Port is optional. When empty, any port matches.
+	Port string
+}
+
+// PassthroughTunnel represents a tunnel that was relayed without TLS
+// termination. Because its contents are opaque, only connection-level
+// details are available.
+type PassthroughTunnel struct {
+	ID            ulid.ULID
+	Host          string
+	Port          string
+	BytesSent     int64
+	BytesReceived int64
+	StartedAt     time.Time
+	Duration      time.Duration
+}
+
+// PassthroughTunnelFunc is called after a passthrough tunnel is closed.
+type PassthroughTunnelFunc func(ctx context.Context, tunnel PassthroughTunnel)
+
+// Match returns true if the rule matches `hostport` (e.g. `example.com:443`).
+func (r PassthroughRule) Match(hostport string) bool {
+	host, port, err := net.SplitHostPort(hostport)
+	if err != nil {
+		host = hostport
+	}
+
+	if r.Port != "" && r.Port != port {
+		return false
+	}
+
+	if r.HostGlob != "" {
+		match, _ := path.Match(strings.ToLower(r.HostGlob), strings.ToLower(host))
+		return match
+	}
+
+	return r.Host != nil && r.Host.MatchString(host)
+}
+
+type passthroughRuleDTO struct {
+	Host     string
+	HostGlob string
+	Port     string
+}
+
+func (r PassthroughRule) MarshalBinary() ([]byte, error) {
+	dto := passthroughRuleDTO{
+		HostGlob: r.HostGlob,
+		Port:     r.Port,
+	}
+
+	if r.Host != nil {
+		dto.Host = r.Host.String()
+	}
+
+	buf := bytes.Buffer{}
+
+	err := gob.NewEncoder(&buf).Encode(dto)
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func (r *PassthroughRule) UnmarshalBinary(data []byte) error {
+	dto := passthroughRuleDTO{}
+
+	err := gob.NewDecoder(bytes.NewReader(data)).Decode(&dto)
+	if err != nil {
+		return err
+	}
+
+	var host *regexp.Regexp
+
+	if dto.Host != "" {
+		host, err = regexp.Compile(dto.Host)
+		if err != nil {
+			return err
+		}
+	}
+
+	*r = PassthroughRule{
+		Host:     host,
+		HostGlob: dto.HostGlob,
+		Port:     dto.Port,
+	}
+
+	return nil
+}
+
+// OnPassthroughTunnel registers functions that are called after a passthrough
+// tunnel is closed, e.g. for logging.
+func (p *Proxy) OnPassthroughTunnel(fn ...PassthroughTunnelFunc) {
+	p.passthroughFuncs = append(p.passthroughFuncs, fn...)
+}
+
+func (p *Proxy) isPassthrough(hostport string) bool {
+	for _, rule := range p.Settings().TLSPassthrough {
+		if rule.Match(hostport) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// handlePassthrough sets up a tunnel for a CONNECT request to the requested
+// host, and relays data between client and server as-is.
+func (p *Proxy) handlePassthrough(w http.ResponseWriter, r *http.Request) {
+	hj, ok := w.(http.Hijacker)
+	if !ok {
+		p.logger.Errorw("ResponseWriter is not a http.Hijacker.")
+		writeError(w, http.StatusServiceUnavailable)
+
+		return
+	}
+
+	serverConn, err := p.upstream.DialContext(r.Context(), "tcp", r.Host)
+	if err != nil {
+		p.logger.Errorw("Dialing passthrough tunnel host failed.",
+			"error", err,
+			"host", r.Host)
+		writeError(w, http.StatusBadGateway)
+
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+
+	clientConn, _, err := hj.Hijack()
+	if err != nil {
+		p.logger.Errorw("Hijacking client connection failed.",
+			"error", err)
+		serverConn.Close()
+
+		return
+	}
+
+	p.relayPassthrough(clientConn, serverConn, r.Host)
+}
+
+// relayPassthrough copies data between the client and server connections until
+// either side closes. It blocks, and closes both connections when done.
+func (p *Proxy) relayPassthrough(clientConn, serverConn net.Conn, hostport string) {
+	defer clientConn.Close()
+	defer serverConn.Close()
+
+	tunnel := PassthroughTunnel{
+		ID:        ulid.MustNew(ulid.Timestamp(time.Now()), ulidEntropy),
+		StartedAt: time.Now(),
+	}
+
+	tunnel.Host, tunnel.Port, _ = net.SplitHostPort(hostport)
+
+	var sent, received atomic.Int64
+
+	errc := make(chan error, 2)
+
+	go func() {
+		n, err := io.Copy(serverConn, clientConn)
+		sent.Add(n)
+		errc <- err
+	}()
+
+	go func() {
+		n, err := io.Copy(clientConn, serverConn)
+		received.Add(n)
+		errc <- err
+	}()
+
+	// When one side is done, close both connections so the other copy returns.
+	<-errc
+	clientConn.Close()
+	serverConn.Close()
+	<-errc
+
+	tunnel.BytesSent = sent.Load()
+	tunnel.BytesReceived = received.Load()
+	tunnel.Duration = time.Since(tunnel.StartedAt)
+
+	p.logger.Debugw("Closed passthrough tunnel.",
+		"host", hostport,
+		"bytesSent", tunnel.BytesSent,
+		"bytesReceived", tunnel.BytesReceived,
+		"duration", tunnel.Duration.String())
+
+	for _, fn := range p.passthroughFuncs {
+		fn(context.Background(), tunnel)
+	}
+}
@@ -0,0 +1,259 @@
+package proxy
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestPassthroughRuleMatch(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		rule     PassthroughRule
+		hostport string
+		exp      bool
+	}{
+		{
+			name:     "regexp",
+			rule:     PassthroughRule{Host: regexp.MustCompile(`^api\.example\.com$`)},
+			hostport: "api.example.com:443",
+			exp:      true,
+		},
+		{
+			name:     "regexp mismatch",
+			rule:     PassthroughRule{Host: regexp.MustCompile(`^api\.example\.com$`)},
+			hostport: "www.example.com:443",
+			exp:      false,
+		},
+		{
+			name:     "glob",
+			rule:     PassthroughRule{HostGlob: "*.apple.com"},
+			hostport: "gateway.icloud.apple.com:443",
+			exp:      true,
+		},
+		{
+			name:     "glob is case-insensitive",
+			rule:     PassthroughRule{HostGlob: "*.Apple.com"},
+			hostport: "GATEWAY.apple.COM",
+			exp:      true,
+		},
+		{
+			name:     "glob doesn't match parent domain",
+			rule:     PassthroughRule{HostGlob: "*.apple.com"},
+			hostport: "apple.com:443",
+			exp:      false,
+		},
+		{
+			name:     "glob takes precedence over regexp",
+			rule:     PassthroughRule{Host: regexp.MustCompile(`.*`), HostGlob: "*.apple.com"},
+			hostport: "example.com:443",
+			exp:      false,
+		},
+		{
+			name:     "port",
+			rule:     PassthroughRule{HostGlob: "example.com", Port: "8443"},
+			hostport: "example.com:443",
+			exp:      false,
+		},
+		{
+			name:     "no host pattern",
+			rule:     PassthroughRule{},
+			hostport: "example.com:443",
+			exp:      false,
+		},
+	}
+
+	for _, tt := range tests {
+		if got := tt.rule.Match(tt.hostport); got != tt.exp {
+			t.Errorf("%v: expected %v, got: %v", tt.name, tt.exp, got)
+		}
+	}
+}
+
+func TestPassthroughRuleBinary(t *testing.T) {
+	t.Parallel()
+
+	for _, rule := range []PassthroughRule{
+		{Host: regexp.MustCompile(`^example\.com$`), Port: "443"},
+		{HostGlob: "*.apple.com"},
+	} {
+		buf, err := rule.MarshalBinary()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		var got PassthroughRule
+		if err := got.UnmarshalBinary(buf); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if got.HostGlob != rule.HostGlob || got.Port != rule.Port ||
+			(got.Host == nil) != (rule.Host == nil) ||
+			(got.Host != nil && got.Host.String() != rule.Host.String()) {
+			t.Errorf("expected %+v, got: %+v", rule, got)
+		}
+	}
+}
+
+func TestSniffHost(t *testing.T) {
+	t.Parallel()
+
+	t.Run("TLS", func(t *testing.T) {
+		t.Parallel()
+
+		clientConn, serverConn := net.Pipe()
+		defer serverConn.Close()
+
+		go func() {
+			defer clientConn.Close()
+
+			//nolint:gosec
+			_ = tls.Client(clientConn, &tls.Config{
+				ServerName:         "example.com",
+				InsecureSkipVerify: true,
+			}).Handshake()
+		}()
+
+		if got := sniffHost(serverConn, true); got != "example.com" {
+			t.Errorf("expected server name `example.com`, got: %q", got)
+		}
+	})
+
+	t.Run("HTTP", func(t *testing.T) {
+		t.Parallel()
+
+		r := strings.NewReader("GET / HTTP/1.1\r\nHost: example.com:8080\r\n\r\n")
+
+		if got := sniffHost(r, false); got != "example.com:8080" {
+			t.Errorf("expected host `example.com:8080`, got: %q", got)
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		t.Parallel()
+
+		if got := sniffHost(strings.NewReader("\x16foobar"), true); got != "" {
+			t.Errorf("expected empty host, got: %q", got)
+		}
+	})
+}
+
+func TestTunnelHostport(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		host   string
+		target string
+		isTLS  bool
+		exp    string
+	}{
+		{host: "example.com", target: "", isTLS: true, exp: "example.com:443"},
+		{host: "example.com", target: "", isTLS: false, exp: "example.com:80"},
+		{host: "example.com", target: "10.0.0.1:8443", isTLS: true, exp: "example.com:8443"},
+		{host: "example.com:8080", target: "10.0.0.1:80", isTLS: false, exp: "example.com:8080"},
+	}
+
+	for _, tt := range tests {
+		if got := tunnelHostport(tt.host, tt.target, tt.isTLS); got != tt.exp {
+			t.Errorf("tunnelHostport(%q, %q, %v): expected %q, got: %q", tt.host, tt.target, tt.isTLS, tt.exp, got)
+		}
+	}
+}
+
+func TestServeTunnelPassthrough(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {}))
+	defer server.Close()
+
+	serverCert := server.Certificate()
+	_, port, _ := net.SplitHostPort(server.Listener.Addr().String())
+
+	caCert, caKey, err := NewCA("Hetty Test CA", "Hetty", time.Hour)
+	if err != nil {
+		t.Fatalf("failed to create CA: %v", err)
+	}
+
+	p, err := NewProxy(Config{CACert: caCert, CAKey: caKey})
+	if err != nil {
+		t.Fatalf("failed to create proxy: %v", err)
+	}
+
+	tunnels := make(chan PassthroughTunnel, 2)
+
+	p.OnPassthroughTunnel(func(_ context.Context, tunnel PassthroughTunnel) {
+		tunnels <- tunnel
+	})
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+	defer l.Close()
+
+	// Like a transparent or SOCKS5 connection by IP address, the tunnel target
+	// has no hostname, so passthrough rules must match the server name (SNI).
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+
+			go p.serveTunnel(conn, net.JoinHostPort("127.0.0.1", port))
+		}
+	}()
+
+	peerCert := func(t *testing.T) []byte {
+		t.Helper()
+
+		//nolint:gosec
+		conn, err := tls.Dial("tcp", l.Addr().String(), &tls.Config{
+			ServerName:         "localhost",
+			InsecureSkipVerify: true,
+		})
+		if err != nil {
+			t.Fatalf("unexpected error dialing tunnel: %v", err)
+		}
+		defer conn.Close()
+
+		return conn.ConnectionState().PeerCertificates[0].Raw
+	}
+
+	t.Run("passthrough", func(t *testing.T) {
+		p.UpdateSettings(Settings{
+			TLSPassthrough: []PassthroughRule{{HostGlob: "local*"}},
+		})
+
+		if got := peerCert(t); string(got) != string(serverCert.Raw) {
+			t.Error("expected certificate of server")
+		}
+
+		select {
+		case tunnel := <-tunnels:
+			if tunnel.Host != "localhost" || tunnel.Port != port {
+				t.Errorf("unexpected tunnel host and port: %v:%v", tunnel.Host, tunnel.Port)
+			}
+		case <-time.After(5 * time.Second):
+			t.Error("expected passthrough tunnel")
+		}
+	})
+
+	t.Run("intercepted", func(t *testing.T) {
+		p.UpdateSettings(Settings{
+			TLSPassthrough: []PassthroughRule{{HostGlob: "*.apple.com"}},
+		})
+
+		if got := peerCert(t); string(got) == string(serverCert.Raw) {
+			t.Error("expected certificate issued by Hetty")
+		}
+	})
+}
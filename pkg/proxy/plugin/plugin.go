@@ -0,0 +1,399 @@
+// Package plugin runs external plugin processes as part of the proxy's request
+// and response modifier chain. Plugins can be written in any language: Hetty
+// launches a plugin's command, and exchanges JSON-RPC 2.0 messages with it
+// over stdio, one JSON message per line.
+//
+// After starting a plugin, Hetty calls `initialize` (see InitializeParams).
+// Then, depending on the hooks in the plugin's manifest, it calls `onRequest`
+// (see Request and RequestResult) for proxied requests before these are sent
+// to the server, and `onResponse` (see Response and ResponseResult) for
+// responses before these are sent to the client. Plugins can modify requests
+// and responses, drop them, annotate their log entries and report findings.
+// Hetty sends a `shutdown` notification, and closes stdin when it exits.
+//
+// Each call has a timeout. A plugin that times out, crashes or returns an error
+// doesn't affect the request or response, which is passed on unmodified. A
+// plugin that keeps failing is restarted.
+package plugin
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+
+	"github.com/dstotijn/hetty/pkg/log"
+	"github.com/dstotijn/hetty/pkg/proxy"
+)
+
+var (
+	ErrInvalidManifest = errors.New("plugin: invalid manifest")
+	ErrPluginNotFound  = errors.New("plugin: plugin not found")
+)
+
+// ErrDropped wraps proxy.ErrDropped, so the proxy handles a request or response
+// that was dropped by a plugin as a cancelled request.
+var ErrDropped = fmt.Errorf("plugin: dropped by plugin: %w", proxy.ErrDropped)
+
+type contextKey int
+
+const (
+	reqResultKey contextKey = iota
+	resResultKey
+)
+
+// Result contains the annotations and findings that plugins added to a request
+// or response.
+type Result struct {
+	Annotations []string
+	Findings    []Finding
+}
+
+// Service runs the enabled plugins for proxied requests and responses. Plugins
+// are called in order of their name. It's safe for concurrent use.
+type Service struct {
+	plugins   []Plugin
+	processes []*process
+	logger    log.Logger
+	closeOnce sync.Once
+}
+
+type Config struct {
+	// Dir is the plugins directory.
+	Dir string
+	// Version is the Hetty version, which is sent to plugins.
+	Version string
+	Logger  log.Logger
+}
+
+// NewService returns a new Service for the enabled plugins in the plugins
+// directory. Plugin processes are started when they're first called.
+func NewService(cfg Config) (*Service, error) {
+	svc := &Service{
+		logger: cfg.Logger,
+	}
+
+	if svc.logger == nil {
+		svc.logger = log.NewNopLogger()
+	}
+
+	plugins, err := List(cfg.Dir)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, p := range plugins {
+		if !p.Enabled {
+			continue
+		}
+
+		svc.plugins = append(svc.plugins, p)
+		svc.processes = append(svc.processes, newProcess(p, cfg.Version, svc.logger))
+	}
+
+	return svc, nil
+}
+
+// Plugins returns the enabled plugins.
+func (svc *Service) Plugins() []Plugin {
+	return svc.plugins
+}
+
+// Close stops all plugin processes.
+func (svc *Service) Close() {
+	svc.closeOnce.Do(func() {
+		var wg sync.WaitGroup
+
+		for _, p := range svc.processes {
+			wg.Add(1)
+
+			go func(p *process) {
+				defer wg.Done()
+				p.close()
+			}(p)
+		}
+
+		wg.Wait()
+	})
+}
+
+// RequestModifier is a proxy.RequestModifyMiddleware that calls the
+// `onRequest` hook of plugins. When a plugin drops the request, it's not sent
+// to the server, and the proxy responds with an error.
+func (svc *Service) RequestModifier(next proxy.RequestModifyFunc) proxy.RequestModifyFunc {
+	return func(req *http.Request) {
+		var result Result
+
+		dropped := false
+
+		for _, p := range svc.processes {
+			if !p.plugin.Manifest.HasHook(HookRequest) {
+				continue
+			}
+
+			pluginResult, err := svc.callOnRequest(p, req)
+			if err != nil {
+				svc.logger.Errorw("Failed to call plugin.",
+					"plugin", p.plugin.Manifest.Name,
+					"hook", HookRequest,
+					"error", err)
+
+				continue
+			}
+
+			result.add(p.plugin.Manifest.Name, pluginResult.Annotations, pluginResult.Findings)
+
+			if pluginResult.Drop {
+				dropped = true
+				break
+			}
+		}
+
+		if len(result.Annotations) > 0 || len(result.Findings) > 0 {
+			*req = *req.WithContext(context.WithValue(req.Context(), reqResultKey, result))
+		}
+
+		if dropped {
+			svc.logger.Debugw("Request was dropped by plugin.")
+			proxy.DropRequest(req, ErrDropped)
+
+			return
+		}
+
+		next(req)
+	}
+}
+
+// ResponseModifier is a proxy.ResponseModifyMiddleware that calls the
+// `onResponse` hook of plugins. When a plugin drops the response, the proxy
+// responds with an error instead.
+func (svc *Service) ResponseModifier(next proxy.ResponseModifyFunc) proxy.ResponseModifyFunc {
+	return func(res *http.Response) error {
+		var result Result
+
+		dropped := false
+
+		for _, p := range svc.processes {
+			if !p.plugin.Manifest.HasHook(HookResponse) {
+				continue
+			}
+
+			pluginResult, err := svc.callOnResponse(p, res)
+			if err != nil {
+				svc.logger.Errorw("Failed to call plugin.",
+					"plugin", p.plugin.Manifest.Name,
+					"hook", HookResponse,
+					"error", err)
+
+				continue
+			}
+
+			result.add(p.plugin.Manifest.Name, pluginResult.Annotations, pluginResult.Findings)
+
+			if pluginResult.Drop {
+				dropped = true
+				break
+			}
+		}
+
+		if (len(result.Annotations) > 0 || len(result.Findings) > 0) && res.Request != nil {
+			res.Request = res.Request.WithContext(context.WithValue(res.Request.Context(), resResultKey, result))
+		}
+
+		if dropped {
+			return ErrDropped
+		}
+
+		return next(res)
+	}
+}
+
+func (svc *Service) callOnRequest(p *process, req *http.Request) (RequestResult, error) {
+	body, omitted, err := readBody(&req.Body, p.plugin.Manifest.MaxBodySize)
+	if err != nil {
+		return RequestResult{}, err
+	}
+
+	params := Request{
+		Method:      req.Method,
+		URL:         req.URL.String(),
+		Proto:       req.Proto,
+		Header:      req.Header,
+		Body:        body,
+		BodyOmitted: omitted,
+	}
+
+	if id, ok := proxy.RequestIDFromContext(req.Context()); ok {
+		params.ID = id.String()
+	}
+
+	var result RequestResult
+
+	if err := p.call(req.Context(), string(HookRequest), params, &result); err != nil {
+		return RequestResult{}, err
+	}
+
+	// Validate the result first, so the request isn't partially modified.
+	var u *url.URL
+
+	if result.URL != nil {
+		u, err = url.Parse(*result.URL)
+		if err != nil {
+			return RequestResult{}, fmt.Errorf("invalid url: %w", err)
+		}
+
+		if !u.IsAbs() || u.Host == "" {
+			return RequestResult{}, fmt.Errorf("url must be absolute, got %q", *result.URL)
+		}
+	}
+
+	if result.Method != nil && *result.Method == "" {
+		return RequestResult{}, errors.New("method must not be empty")
+	}
+
+	if result.Method != nil {
+		req.Method = *result.Method
+	}
+
+	if u != nil {
+		if u.Host != req.URL.Host {
+			req.Host = u.Host
+		}
+
+		req.URL = u
+	}
+
+	if result.Header != nil {
+		req.Header = result.Header
+	}
+
+	if result.Body != nil && !omitted {
+		req.Body = io.NopCloser(bytes.NewReader(*result.Body))
+		req.ContentLength = int64(len(*result.Body))
+		setContentLength(req.Header, len(*result.Body))
+	}
+
+	return result, nil
+}
+
+func (svc *Service) callOnResponse(p *process, res *http.Response) (ResponseResult, error) {
+	params := Response{
+		Proto:      res.Proto,
+		StatusCode: res.StatusCode,
+		Header:     res.Header,
+	}
+
+	// The body of a protocol switch (e.g. WebSockets) is the upgraded
+	// connection, so it's never read.
+	omitted := true
+
+	if res.StatusCode != http.StatusSwitchingProtocols {
+		body, bodyOmitted, err := readBody(&res.Body, p.plugin.Manifest.MaxBodySize)
+		if err != nil {
+			return ResponseResult{}, err
+		}
+
+		params.Body, params.BodyOmitted, omitted = body, bodyOmitted, bodyOmitted
+	}
+
+	ctx := context.Background()
+
+	if res.Request != nil {
+		ctx = res.Request.Context()
+		params.Method = res.Request.Method
+		params.URL = res.Request.URL.String()
+
+		if id, ok := proxy.RequestIDFromContext(ctx); ok {
+			params.RequestID = id.String()
+		}
+	}
+
+	var result ResponseResult
+
+	if err := p.call(ctx, string(HookResponse), params, &result); err != nil {
+		return ResponseResult{}, err
+	}
+
+	if result.StatusCode != nil {
+		if *result.StatusCode < 100 || *result.StatusCode > 599 {
+			return ResponseResult{}, fmt.Errorf("invalid status code %v", *result.StatusCode)
+		}
+
+		res.StatusCode = *result.StatusCode
+		res.Status = fmt.Sprintf("%d %s", res.StatusCode, http.StatusText(res.StatusCode))
+	}
+
+	if result.Header != nil {
+		res.Header = result.Header
+	}
+
+	if result.Body != nil && !omitted {
+		res.Body = io.NopCloser(bytes.NewReader(*result.Body))
+		res.ContentLength = int64(len(*result.Body))
+		setContentLength(res.Header, len(*result.Body))
+	}
+
+	return result, nil
+}
+
+// readBody reads up to `maxSize` bytes of the body. When the body is larger,
+// it's omitted. Either way, the body is replaced so it can be read again.
+func readBody(body *io.ReadCloser, maxSize int64) ([]byte, bool, error) {
+	if *body == nil || *body == http.NoBody {
+		return nil, false, nil
+	}
+
+	buf, err := io.ReadAll(io.LimitReader(*body, maxSize+1))
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to read body: %w", err)
+	}
+
+	if int64(len(buf)) > maxSize {
+		*body = struct {
+			io.Reader
+			io.Closer
+		}{io.MultiReader(bytes.NewReader(buf), *body), *body}
+
+		return nil, true, nil
+	}
+
+	(*body).Close()
+	*body = io.NopCloser(bytes.NewReader(buf))
+
+	return buf, false, nil
+}
+
+func setContentLength(header http.Header, n int) {
+	if header.Get("Content-Length") != "" {
+		header.Set("Content-Length", strconv.Itoa(n))
+	}
+}
+
+func (r *Result) add(pluginName string, annotations []string, findings []Finding) {
+	r.Annotations = append(r.Annotations, annotations...)
+
+	for _, f := range findings {
+		f.Plugin = pluginName
+		f.Severity = normalizeSeverity(f.Severity)
+		r.Findings = append(r.Findings, f)
+	}
+}
+
+// RequestResultFromContext returns the annotations and findings that plugins
+// added to a request.
+func RequestResultFromContext(ctx context.Context) (Result, bool) {
+	result, ok := ctx.Value(reqResultKey).(Result)
+	return result, ok
+}
+
+// ResponseResultFromContext returns the annotations and findings that plugins
+// added to a response. Use the context of `res.Request`.
+func ResponseResultFromContext(ctx context.Context) (Result, bool) {
+	result, ok := ctx.Value(resResultKey).(Result)
+	return result, ok
+}
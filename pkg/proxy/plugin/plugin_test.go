@@ -0,0 +1,396 @@
+package plugin_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/dstotijn/hetty/pkg/proxy/plugin"
+)
+
+const helperPluginArg = "hetty-test-plugin"
+
+// TestHelperPlugin isn't a real test. It's run as a plugin process by other
+// tests, via the test binary.
+func TestHelperPlugin(t *testing.T) {
+	args := flag.Args()
+	if len(args) != 2 || args[0] != helperPluginArg {
+		t.Skip("only runs as a plugin process")
+	}
+
+	mode := args[1]
+	scanner := bufio.NewScanner(os.Stdin)
+	scanner.Buffer(nil, 1<<20)
+
+	for scanner.Scan() {
+		var msg struct {
+			ID     *uint64         `json:"id"`
+			Method string          `json:"method"`
+			Params json.RawMessage `json:"params"`
+		}
+
+		if err := json.Unmarshal(scanner.Bytes(), &msg); err != nil {
+			fmt.Fprintf(os.Stderr, "invalid message: %v\n", err)
+			os.Exit(1)
+		}
+
+		if msg.ID == nil {
+			continue
+		}
+
+		var result interface{}
+
+		if mode == "stuck" && msg.Method == "onRequest" {
+			// Stop reading stdin, so writes by Hetty block once the pipe buffer
+			// is full.
+			time.Sleep(time.Hour)
+		}
+
+		switch msg.Method {
+		case "onRequest":
+			if mode == "slow" {
+				time.Sleep(time.Second)
+			}
+
+			var req plugin.Request
+			_ = json.Unmarshal(msg.Params, &req)
+
+			header := req.Header
+			header.Set("X-Plugin", "1")
+
+			body := []byte(strings.ToUpper(string(req.Body)))
+
+			result = plugin.RequestResult{
+				Drop:        strings.HasSuffix(req.URL, "/drop"),
+				Header:      header,
+				Body:        &body,
+				Annotations: []string{"seen by plugin"},
+				Findings: []plugin.Finding{
+					{Title: "Foobar", Severity: "high"},
+					{Title: "Unknown severity", Severity: "foobar"},
+				},
+			}
+		case "onResponse":
+			var res plugin.Response
+			_ = json.Unmarshal(msg.Params, &res)
+
+			statusCode := http.StatusTeapot
+			result = plugin.ResponseResult{
+				StatusCode:  &statusCode,
+				Annotations: []string{fmt.Sprintf("%v %v", res.Method, res.StatusCode)},
+			}
+		}
+
+		out, _ := json.Marshal(map[string]interface{}{
+			"jsonrpc": "2.0",
+			"id":      msg.ID,
+			"result":  result,
+		})
+		fmt.Fprintf(os.Stdout, "%s\n", out)
+	}
+
+	os.Exit(0)
+}
+
+func writePlugin(t *testing.T, dir string, manifest plugin.Manifest) {
+	t.Helper()
+
+	pluginDir := filepath.Join(dir, manifest.Name)
+	if err := os.MkdirAll(pluginDir, 0o755); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	buf, err := json.Marshal(manifest)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := os.WriteFile(filepath.Join(pluginDir, "plugin.json"), buf, 0o600); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func helperPlugin(name, mode, timeout string) plugin.Manifest {
+	return plugin.Manifest{
+		Name:    name,
+		Command: []string{os.Args[0], "-test.run=^TestHelperPlugin$", "--", helperPluginArg, mode},
+		Hooks:   []plugin.Hook{plugin.HookRequest, plugin.HookResponse},
+		Timeout: timeout,
+	}
+}
+
+func newService(t *testing.T, manifests ...plugin.Manifest) *plugin.Service {
+	t.Helper()
+
+	dir := t.TempDir()
+
+	for _, m := range manifests {
+		writePlugin(t, dir, m)
+
+		if err := plugin.SetEnabled(dir, m.Name, true); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	svc, err := plugin.NewService(plugin.Config{Dir: dir})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	t.Cleanup(svc.Close)
+
+	return svc
+}
+
+func TestListAndSetEnabled(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	writePlugin(t, dir, plugin.Manifest{
+		Name:    "foo",
+		Command: []string{"foo"},
+		Hooks:   []plugin.Hook{plugin.HookRequest},
+	})
+	writePlugin(t, dir, plugin.Manifest{
+		Name:    "bar",
+		Command: []string{"bar"},
+		Hooks:   []plugin.Hook{plugin.HookResponse},
+		Timeout: "1s",
+	})
+
+	if err := plugin.SetEnabled(dir, "foo", true); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := plugin.SetEnabled(dir, "baz", true); !errors.Is(err, plugin.ErrPluginNotFound) {
+		t.Errorf("expected `plugin.ErrPluginNotFound`, got: %v", err)
+	}
+
+	plugins, err := plugin.List(dir)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	got := make(map[string]bool)
+	for _, p := range plugins {
+		got[p.Manifest.Name] = p.Enabled
+	}
+
+	if diff := cmp.Diff(map[string]bool{"bar": false, "foo": true}, got); diff != "" {
+		t.Errorf("plugins not equal (-exp, +got):\n%v", diff)
+	}
+
+	if err := plugin.SetEnabled(dir, "foo", false); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	plugins, err = plugin.List(dir)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, p := range plugins {
+		if p.Enabled {
+			t.Errorf("expected plugin %q to be disabled", p.Manifest.Name)
+		}
+	}
+
+	plugins, err = plugin.List(filepath.Join(dir, "does-not-exist"))
+	if err != nil || len(plugins) != 0 {
+		t.Errorf("expected no plugins and no error, got: %v, %v", plugins, err)
+	}
+}
+
+func TestManifestValidate(t *testing.T) {
+	t.Parallel()
+
+	manifests := []plugin.Manifest{
+		{Name: "foo bar", Command: []string{"foo"}, Hooks: []plugin.Hook{plugin.HookRequest}},
+		{Name: "foo", Hooks: []plugin.Hook{plugin.HookRequest}},
+		{Name: "foo", Command: []string{"foo"}},
+		{Name: "foo", Command: []string{"foo"}, Hooks: []plugin.Hook{"onFoo"}},
+		{Name: "foo", Command: []string{"foo"}, Hooks: []plugin.Hook{plugin.HookRequest}, Timeout: "1"},
+	}
+
+	for _, m := range manifests {
+		if err := m.Validate(); !errors.Is(err, plugin.ErrInvalidManifest) {
+			t.Errorf("expected `plugin.ErrInvalidManifest` for %+v, got: %v", m, err)
+		}
+	}
+}
+
+func TestRequestModifier(t *testing.T) {
+	t.Parallel()
+
+	svc := newService(t, helperPlugin("modify", "modify", "5s"))
+
+	req := httptest.NewRequest(http.MethodPost, "https://example.com/", strings.NewReader("foobar"))
+	req.Header.Set("Content-Length", "6")
+
+	var modifiedReq *http.Request
+
+	svc.RequestModifier(func(req *http.Request) {
+		modifiedReq = req
+	})(req)
+
+	if modifiedReq == nil {
+		t.Fatal("expected next modifier to be called")
+	}
+
+	if got := modifiedReq.Header.Get("X-Plugin"); got != "1" {
+		t.Errorf("expected header to be set by plugin, got: %q", got)
+	}
+
+	body, err := io.ReadAll(modifiedReq.Body)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if string(body) != "FOOBAR" {
+		t.Errorf("expected body to be modified by plugin, got: %q", body)
+	}
+
+	result, ok := plugin.RequestResultFromContext(modifiedReq.Context())
+	if !ok {
+		t.Fatal("expected plugin result in context")
+	}
+
+	exp := plugin.Result{
+		Annotations: []string{"seen by plugin"},
+		Findings: []plugin.Finding{
+			{Plugin: "modify", Title: "Foobar", Severity: plugin.SeverityHigh},
+			{Plugin: "modify", Title: "Unknown severity", Severity: plugin.SeverityInfo},
+		},
+	}
+	if diff := cmp.Diff(exp, result); diff != "" {
+		t.Errorf("result not equal (-exp, +got):\n%v", diff)
+	}
+
+	// Dropped requests aren't passed on.
+	req = httptest.NewRequest(http.MethodGet, "https://example.com/drop", nil)
+	called := false
+
+	svc.RequestModifier(func(*http.Request) {
+		called = true
+	})(req)
+
+	if called {
+		t.Error("expected next modifier not to be called")
+	}
+
+	if !errors.Is(context.Cause(req.Context()), plugin.ErrDropped) {
+		t.Errorf("expected context cause `plugin.ErrDropped`, got: %v", context.Cause(req.Context()))
+	}
+}
+
+func TestResponseModifier(t *testing.T) {
+	t.Parallel()
+
+	svc := newService(t, helperPlugin("modify", "modify", "5s"))
+
+	res := &http.Response{
+		StatusCode: http.StatusOK,
+		Status:     "200 OK",
+		Proto:      "HTTP/1.1",
+		Header:     http.Header{},
+		Body:       io.NopCloser(strings.NewReader("foobar")),
+		Request:    httptest.NewRequest(http.MethodGet, "https://example.com/", nil),
+	}
+
+	err := svc.ResponseModifier(func(*http.Response) error { return nil })(res)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if res.Status != "418 I'm a teapot" {
+		t.Errorf("expected status to be modified by plugin, got: %q", res.Status)
+	}
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if string(body) != "foobar" {
+		t.Errorf("expected body to be unmodified, got: %q", body)
+	}
+
+	result, _ := plugin.ResponseResultFromContext(res.Request.Context())
+	if diff := cmp.Diff([]string{"GET 200"}, result.Annotations); diff != "" {
+		t.Errorf("annotations not equal (-exp, +got):\n%v", diff)
+	}
+}
+
+func TestRequestModifierTimeout(t *testing.T) {
+	t.Parallel()
+
+	svc := newService(t,
+		helperPlugin("a-slow", "slow", "100ms"),
+		helperPlugin("b-modify", "modify", "5s"),
+	)
+
+	req := httptest.NewRequest(http.MethodGet, "https://example.com/", nil)
+
+	var modifiedReq *http.Request
+
+	svc.RequestModifier(func(req *http.Request) {
+		modifiedReq = req
+	})(req)
+
+	if modifiedReq == nil {
+		t.Fatal("expected next modifier to be called")
+	}
+
+	// The slow plugin timed out, but the other plugin was still called.
+	result, _ := plugin.RequestResultFromContext(modifiedReq.Context())
+	for _, f := range result.Findings {
+		if f.Plugin != "b-modify" {
+			t.Errorf("expected findings of plugin `b-modify` only, got: %v", f.Plugin)
+		}
+	}
+
+	if len(result.Findings) == 0 {
+		t.Error("expected findings")
+	}
+}
+
+func TestRequestModifierStalledWrite(t *testing.T) {
+	t.Parallel()
+
+	svc := newService(t, helperPlugin("stuck", "stuck", "200ms"))
+
+	// Bodies larger than the pipe buffer block writes to a plugin that doesn't
+	// read its stdin.
+	body := strings.Repeat("a", 512<<10)
+
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+
+		for i := 0; i < 3; i++ {
+			req := httptest.NewRequest(http.MethodPost, "https://example.com/", strings.NewReader(body))
+			svc.RequestModifier(func(*http.Request) {})(req)
+		}
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected requests not to be blocked by a stalled plugin")
+	}
+}
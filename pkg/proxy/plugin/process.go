@@ -0,0 +1,434 @@
+package plugin
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os/exec"
+	"sync"
+	"time"
+
+	"github.com/dstotijn/hetty/pkg/log"
+)
+
+const (
+	// maxConsecutiveFailures is the number of failed calls (e.g. timeouts)
+	// after which a plugin process is restarted.
+	maxConsecutiveFailures = 3
+	// restartBackoff is the minimum duration between starts of a plugin, so a
+	// crashing plugin isn't restarted for every request.
+	restartBackoff = 10 * time.Second
+	// shutdownTimeout is how long a plugin gets to exit after its stdin was
+	// closed, before it's killed.
+	shutdownTimeout = 2 * time.Second
+	// maxMessageSize is the maximum size of a single message from a plugin.
+	maxMessageSize = 64 << 20
+)
+
+var errUnavailable = errors.New("plugin is unavailable")
+
+// process manages the process of a plugin. The process is started lazily, and
+// restarted when it exited or kept failing.
+type process struct {
+	plugin       Plugin
+	hettyVersion string
+	logger       log.Logger
+
+	mu        sync.Mutex
+	conn      *conn
+	lastStart time.Time
+	failures  int
+	closed    bool
+}
+
+// conn is a running plugin process.
+type conn struct {
+	cmd   *exec.Cmd
+	stdin io.WriteCloser
+	// done is closed when the process has exited.
+	done chan struct{}
+
+	writeMu sync.Mutex
+	mu      sync.Mutex
+	nextID  uint64
+	pending map[uint64]chan rpcMessage
+}
+
+func newProcess(p Plugin, hettyVersion string, logger log.Logger) *process {
+	return &process{
+		plugin:       p,
+		hettyVersion: hettyVersion,
+		logger:       logger,
+	}
+}
+
+// call calls a method of the plugin, and decodes the result into `result`.
+// The call is cancelled when the plugin's timeout is exceeded.
+func (p *process) call(ctx context.Context, method string, params, result interface{}) error {
+	c, err := p.running()
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, p.plugin.timeout)
+	defer cancel()
+
+	err = c.call(ctx, method, params, result)
+	p.recordResult(c, err)
+
+	return err
+}
+
+// running returns the running process, and starts it if needed.
+func (p *process) running() (*conn, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.closed {
+		return nil, errUnavailable
+	}
+
+	if p.conn != nil {
+		select {
+		case <-p.conn.done:
+			p.conn = nil
+		default:
+			return p.conn, nil
+		}
+	}
+
+	if !p.lastStart.IsZero() && time.Since(p.lastStart) < restartBackoff {
+		return nil, errUnavailable
+	}
+
+	p.lastStart = time.Now()
+	p.failures = 0
+
+	c, err := p.start()
+	if err != nil {
+		return nil, err
+	}
+
+	p.conn = c
+
+	return c, nil
+}
+
+func (p *process) start() (*conn, error) {
+	name := p.plugin.Manifest.Name
+
+	//nolint:gosec
+	cmd := exec.Command(p.plugin.Manifest.Command[0], p.plugin.Manifest.Command[1:]...)
+	cmd.Dir = p.plugin.Dir
+
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create stdin pipe: %w", err)
+	}
+
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create stdout pipe: %w", err)
+	}
+
+	stderr, err := cmd.StderrPipe()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create stderr pipe: %w", err)
+	}
+
+	if err := cmd.Start(); err != nil {
+		return nil, fmt.Errorf("failed to start plugin: %w", err)
+	}
+
+	p.logger.Infow("Started plugin.",
+		"plugin", name,
+		"pid", cmd.Process.Pid)
+
+	c := &conn{
+		cmd:     cmd,
+		stdin:   stdin,
+		done:    make(chan struct{}),
+		pending: make(map[uint64]chan rpcMessage),
+	}
+
+	var stderrDone sync.WaitGroup
+
+	stderrDone.Add(1)
+
+	go func() {
+		defer stderrDone.Done()
+
+		scanner := bufio.NewScanner(stderr)
+		for scanner.Scan() {
+			p.logger.Infow("Plugin output.",
+				"plugin", name,
+				"message", scanner.Text())
+		}
+	}()
+
+	go func() {
+		c.readLoop(stdout, func(msg rpcMessage) {
+			p.handleNotification(msg)
+		}, func(err error) {
+			p.logger.Errorw("Failed to read message from plugin.",
+				"plugin", name,
+				"error", err)
+		})
+
+		// All reads from the pipes must be done before calling `Wait`.
+		stderrDone.Wait()
+
+		err := cmd.Wait()
+		c.close()
+
+		p.logger.Infow("Plugin exited.",
+			"plugin", name,
+			"error", err)
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), p.plugin.timeout)
+	defer cancel()
+
+	params := InitializeParams{
+		ProtocolVersion: ProtocolVersion,
+		HettyVersion:    p.hettyVersion,
+	}
+
+	if err := c.call(ctx, methodInitialize, params, nil); err != nil {
+		c.kill()
+		return nil, fmt.Errorf("failed to initialize plugin: %w", err)
+	}
+
+	return c, nil
+}
+
+func (p *process) handleNotification(msg rpcMessage) {
+	if msg.Method != methodLog {
+		p.logger.Debugw("Ignored unknown notification from plugin.",
+			"plugin", p.plugin.Manifest.Name,
+			"method", msg.Method)
+
+		return
+	}
+
+	var params LogParams
+	if err := json.Unmarshal(msg.Params, &params); err != nil {
+		p.logger.Errorw("Failed to parse log notification from plugin.",
+			"plugin", p.plugin.Manifest.Name,
+			"error", err)
+
+		return
+	}
+
+	p.logger.Infow("Plugin message.",
+		"plugin", p.plugin.Manifest.Name,
+		"message", params.Message)
+}
+
+// recordResult kills the process after too many consecutive failures. It's
+// restarted on a subsequent call.
+func (p *process) recordResult(c *conn, err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.conn != c {
+		return
+	}
+
+	var rpcErr *rpcError
+
+	if err == nil || errors.As(err, &rpcErr) {
+		p.failures = 0
+		return
+	}
+
+	p.failures++
+
+	if p.failures >= maxConsecutiveFailures {
+		p.logger.Errorw("Restarting plugin after consecutive failures.",
+			"plugin", p.plugin.Manifest.Name,
+			"failures", p.failures)
+
+		c.kill()
+		p.conn = nil
+	}
+}
+
+// close stops the plugin process. It closes the process's stdin first, so it
+// can exit gracefully.
+func (p *process) close() {
+	p.mu.Lock()
+	c := p.conn
+	p.conn = nil
+	p.closed = true
+	p.mu.Unlock()
+
+	if c == nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+
+	_ = c.notify(ctx, methodShutdown, nil)
+	c.stdin.Close()
+
+	select {
+	case <-c.done:
+	case <-time.After(shutdownTimeout):
+		c.kill()
+	}
+}
+
+func (c *conn) call(ctx context.Context, method string, params, result interface{}) error {
+	c.mu.Lock()
+	c.nextID++
+	id := c.nextID
+	resCh := make(chan rpcMessage, 1)
+	c.pending[id] = resCh
+	c.mu.Unlock()
+
+	defer func() {
+		c.mu.Lock()
+		delete(c.pending, id)
+		c.mu.Unlock()
+	}()
+
+	if err := c.write(ctx, &id, method, params); err != nil {
+		return err
+	}
+
+	select {
+	case msg := <-resCh:
+		if msg.Error != nil {
+			return msg.Error
+		}
+
+		if result == nil || len(msg.Result) == 0 {
+			return nil
+		}
+
+		if err := json.Unmarshal(msg.Result, result); err != nil {
+			return fmt.Errorf("failed to parse result: %w", err)
+		}
+
+		return nil
+	case <-c.done:
+		return errors.New("plugin exited")
+	case <-ctx.Done():
+		return fmt.Errorf("call %q: %w", method, ctx.Err())
+	}
+}
+
+func (c *conn) notify(ctx context.Context, method string, params interface{}) error {
+	return c.write(ctx, nil, method, params)
+}
+
+// write writes a message to the process's stdin. A plugin that doesn't read its
+// stdin blocks writes once the pipe buffer is full, so the process is killed
+// when the write doesn't complete before the deadline of `ctx`.
+func (c *conn) write(ctx context.Context, id *uint64, method string, params interface{}) error {
+	msg := rpcMessage{
+		JSONRPC: "2.0",
+		ID:      id,
+		Method:  method,
+	}
+
+	if params != nil {
+		buf, err := json.Marshal(params)
+		if err != nil {
+			return fmt.Errorf("failed to encode params: %w", err)
+		}
+
+		msg.Params = buf
+	}
+
+	buf, err := json.Marshal(msg)
+	if err != nil {
+		return fmt.Errorf("failed to encode message: %w", err)
+	}
+
+	errCh := make(chan error, 1)
+
+	go func() {
+		c.writeMu.Lock()
+		defer c.writeMu.Unlock()
+
+		_, err := c.stdin.Write(append(buf, '\n'))
+		errCh <- err
+	}()
+
+	select {
+	case err := <-errCh:
+		if err != nil {
+			return fmt.Errorf("failed to write message: %w", err)
+		}
+
+		return nil
+	case <-c.done:
+		return errors.New("plugin exited")
+	case <-ctx.Done():
+		// When the write timed out, it (or a write it waits for) is stalled, and
+		// a partially written message can't be recovered from. Killing the
+		// process unblocks the write, because the pipe is closed.
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			c.kill()
+		}
+
+		return fmt.Errorf("write %q: %w", method, ctx.Err())
+	}
+}
+
+// readLoop reads messages until stdout is closed, and dispatches responses to
+// pending calls.
+func (c *conn) readLoop(r io.Reader, onNotification func(rpcMessage), onError func(error)) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxMessageSize)
+
+	for scanner.Scan() {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+
+		var msg rpcMessage
+		if err := json.Unmarshal(scanner.Bytes(), &msg); err != nil {
+			onError(fmt.Errorf("invalid message: %w", err))
+			continue
+		}
+
+		if msg.ID == nil {
+			onNotification(msg)
+			continue
+		}
+
+		c.mu.Lock()
+		resCh, ok := c.pending[*msg.ID]
+		c.mu.Unlock()
+
+		if !ok {
+			continue
+		}
+
+		// Duplicate responses are ignored.
+		select {
+		case resCh <- msg:
+		default:
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		onError(err)
+		c.kill()
+	}
+}
+
+func (c *conn) close() {
+	close(c.done)
+}
+
+func (c *conn) kill() {
+	_ = c.cmd.Process.Kill()
+}
@@ -0,0 +1,139 @@
+package plugin
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// ProtocolVersion is sent to plugins on initialization. It's incremented on
+// breaking changes to the protocol.
+const ProtocolVersion = 1
+
+const (
+	methodInitialize = "initialize"
+	methodShutdown   = "shutdown"
+	methodLog        = "log"
+)
+
+// Severity is the severity of a finding.
+type Severity string
+
+const (
+	SeverityInfo     Severity = "info"
+	SeverityLow      Severity = "low"
+	SeverityMedium   Severity = "medium"
+	SeverityHigh     Severity = "high"
+	SeverityCritical Severity = "critical"
+)
+
+// Finding is an issue that a plugin reported for a request or response.
+type Finding struct {
+	// Plugin is the name of the plugin that reported the finding.
+	Plugin      string   `json:"-"`
+	Title       string   `json:"title"`
+	Severity    Severity `json:"severity"`
+	Description string   `json:"description,omitempty"`
+}
+
+// InitializeParams are the params of the `initialize` call, which is the first
+// call after a plugin is started.
+type InitializeParams struct {
+	ProtocolVersion int    `json:"protocolVersion"`
+	HettyVersion    string `json:"hettyVersion"`
+}
+
+// Request is the param of the `onRequest` call.
+type Request struct {
+	// ID is the ID of the request, which is also used for its log entry.
+	ID     string      `json:"id"`
+	Method string      `json:"method"`
+	URL    string      `json:"url"`
+	Proto  string      `json:"proto"`
+	Header http.Header `json:"headers"`
+	// Body is base64 encoded.
+	Body []byte `json:"body,omitempty"`
+	// BodyOmitted is true when the body exceeded the plugin's max body size.
+	BodyOmitted bool `json:"bodyOmitted,omitempty"`
+}
+
+// Response is the param of the `onResponse` call.
+type Response struct {
+	// RequestID is the ID of the request that the response is for.
+	RequestID  string      `json:"requestId"`
+	Method     string      `json:"method"`
+	URL        string      `json:"url"`
+	Proto      string      `json:"proto"`
+	StatusCode int         `json:"statusCode"`
+	Header     http.Header `json:"headers"`
+	// Body is base64 encoded.
+	Body []byte `json:"body,omitempty"`
+	// BodyOmitted is true when the body exceeded the plugin's max body size.
+	BodyOmitted bool `json:"bodyOmitted,omitempty"`
+}
+
+// RequestResult is the result of the `onRequest` call. Fields that are
+// omitted (or null) are left unmodified. A null result doesn't modify the
+// request.
+type RequestResult struct {
+	// Drop is true if the request shouldn't be sent to the server.
+	Drop   bool    `json:"drop,omitempty"`
+	Method *string `json:"method,omitempty"`
+	URL    *string `json:"url,omitempty"`
+	// Header replaces all request headers.
+	Header http.Header `json:"headers,omitempty"`
+	// Body is base64 encoded. It's ignored if the body was omitted.
+	Body        *[]byte   `json:"body,omitempty"`
+	Annotations []string  `json:"annotations,omitempty"`
+	Findings    []Finding `json:"findings,omitempty"`
+}
+
+// ResponseResult is the result of the `onResponse` call. Fields that are
+// omitted (or null) are left unmodified. A null result doesn't modify the
+// response.
+type ResponseResult struct {
+	// Drop is true if the response shouldn't be sent to the client.
+	Drop       bool `json:"drop,omitempty"`
+	StatusCode *int `json:"statusCode,omitempty"`
+	// Header replaces all response headers.
+	Header http.Header `json:"headers,omitempty"`
+	// Body is base64 encoded. It's ignored if the body was omitted.
+	Body        *[]byte   `json:"body,omitempty"`
+	Annotations []string  `json:"annotations,omitempty"`
+	Findings    []Finding `json:"findings,omitempty"`
+}
+
+// LogParams are the params of a `log` notification, which plugins can send
+// to write to Hetty's log.
+type LogParams struct {
+	Message string `json:"message"`
+}
+
+// rpcMessage is a JSON-RPC 2.0 message. Messages are sent as single lines of
+// JSON over the plugin's stdin and stdout.
+type rpcMessage struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      *uint64         `json:"id,omitempty"`
+	Method  string          `json:"method,omitempty"`
+	Params  json.RawMessage `json:"params,omitempty"`
+	Result  json.RawMessage `json:"result,omitempty"`
+	Error   *rpcError       `json:"error,omitempty"`
+}
+
+type rpcError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *rpcError) Error() string {
+	return fmt.Sprintf("plugin returned error (code: %v): %v", e.Code, e.Message)
+}
+
+func normalizeSeverity(s Severity) Severity {
+	switch s {
+	case SeverityInfo, SeverityLow, SeverityMedium, SeverityHigh, SeverityCritical:
+		return s
+	default:
+		return SeverityInfo
+	}
+}
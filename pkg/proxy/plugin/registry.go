@@ -0,0 +1,265 @@
+package plugin
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"time"
+)
+
+const (
+	manifestFile = "plugin.json"
+	stateFile    = "plugins.json"
+
+	defaultTimeout     = 5 * time.Second
+	defaultMaxBodySize = 1 << 20 // 1 MiB
+)
+
+// Hook is an event that a plugin can handle.
+type Hook string
+
+const (
+	HookRequest  Hook = "onRequest"
+	HookResponse Hook = "onResponse"
+)
+
+var nameRegexp = regexp.MustCompile(`^[\w-]+$`)
+
+// Manifest describes a plugin. It's read from `plugin.json` in the plugin's
+// directory, e.g.:
+//
+//	{
+//	  "name": "sqli-detector",
+//	  "description": "Flags responses with SQL error messages.",
+//	  "command": ["python3", "main.py"],
+//	  "hooks": ["onResponse"],
+//	  "timeout": "2s"
+//	}
+type Manifest struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	// Command is the executable and its arguments. It's run with the plugin's
+	// directory as working directory.
+	Command []string `json:"command"`
+	Hooks   []Hook   `json:"hooks"`
+	// Timeout is the maximum duration of a single call, e.g. "500ms". When a
+	// call times out, the request or response is passed on unmodified.
+	// Defaults to 5 seconds.
+	Timeout string `json:"timeout,omitempty"`
+	// MaxBodySize is the maximum body size (in bytes) sent to the plugin.
+	// Larger bodies are omitted. Defaults to 1 MiB.
+	MaxBodySize int64 `json:"maxBodySize,omitempty"`
+}
+
+// Plugin is a plugin that was found in the plugins directory.
+type Plugin struct {
+	Manifest Manifest
+	// Dir is the plugin's directory.
+	Dir     string
+	Enabled bool
+
+	timeout time.Duration
+}
+
+type state struct {
+	Enabled []string `json:"enabled"`
+}
+
+// Validate returns an error if the manifest is invalid.
+func (m Manifest) Validate() error {
+	if !nameRegexp.MatchString(m.Name) {
+		return fmt.Errorf("%w: name must be alphanumeric, `-` or `_`, got %q", ErrInvalidManifest, m.Name)
+	}
+
+	if len(m.Command) == 0 || m.Command[0] == "" {
+		return fmt.Errorf("%w (name: %v): command must be set", ErrInvalidManifest, m.Name)
+	}
+
+	if len(m.Hooks) == 0 {
+		return fmt.Errorf("%w (name: %v): at least one hook must be set", ErrInvalidManifest, m.Name)
+	}
+
+	for _, hook := range m.Hooks {
+		if hook != HookRequest && hook != HookResponse {
+			return fmt.Errorf("%w (name: %v): invalid hook %q", ErrInvalidManifest, m.Name, hook)
+		}
+	}
+
+	if m.Timeout != "" {
+		if d, err := time.ParseDuration(m.Timeout); err != nil || d <= 0 {
+			return fmt.Errorf("%w (name: %v): invalid timeout %q", ErrInvalidManifest, m.Name, m.Timeout)
+		}
+	}
+
+	if m.MaxBodySize < 0 {
+		return fmt.Errorf("%w (name: %v): max body size must not be negative", ErrInvalidManifest, m.Name)
+	}
+
+	return nil
+}
+
+// HasHook returns true if the plugin handles the hook.
+func (m Manifest) HasHook(hook Hook) bool {
+	for _, h := range m.Hooks {
+		if h == hook {
+			return true
+		}
+	}
+
+	return false
+}
+
+// List returns the plugins in `dir`, sorted by name. Each plugin has its own
+// subdirectory, containing a `plugin.json` manifest. A directory that doesn't
+// exist contains no plugins.
+func List(dir string) ([]Plugin, error) {
+	entries, err := os.ReadDir(dir)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("plugin: failed to read plugins directory: %w", err)
+	}
+
+	st, err := readState(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	enabled := make(map[string]bool, len(st.Enabled))
+	for _, name := range st.Enabled {
+		enabled[name] = true
+	}
+
+	var plugins []Plugin
+
+	names := make(map[string]string)
+
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+
+		pluginDir := filepath.Join(dir, entry.Name())
+
+		manifest, err := readManifest(pluginDir)
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		if other, ok := names[manifest.Name]; ok {
+			return nil, fmt.Errorf("%w: name %q is used by both %v and %v",
+				ErrInvalidManifest, manifest.Name, other, pluginDir)
+		}
+
+		names[manifest.Name] = pluginDir
+
+		p := Plugin{
+			Manifest: manifest,
+			Dir:      pluginDir,
+			Enabled:  enabled[manifest.Name],
+			timeout:  defaultTimeout,
+		}
+
+		if manifest.Timeout != "" {
+			p.timeout, _ = time.ParseDuration(manifest.Timeout)
+		}
+
+		if p.Manifest.MaxBodySize == 0 {
+			p.Manifest.MaxBodySize = defaultMaxBodySize
+		}
+
+		plugins = append(plugins, p)
+	}
+
+	sort.Slice(plugins, func(i, j int) bool {
+		return plugins[i].Manifest.Name < plugins[j].Manifest.Name
+	})
+
+	return plugins, nil
+}
+
+// SetEnabled enables or disables a plugin by name. The change applies the next
+// time Hetty is started.
+func SetEnabled(dir, name string, enabled bool) error {
+	plugins, err := List(dir)
+	if err != nil {
+		return err
+	}
+
+	var names []string
+
+	found := false
+
+	for _, p := range plugins {
+		if p.Manifest.Name == name {
+			found = true
+			p.Enabled = enabled
+		}
+
+		if p.Enabled {
+			names = append(names, p.Manifest.Name)
+		}
+	}
+
+	if !found {
+		return fmt.Errorf("%w: %v", ErrPluginNotFound, name)
+	}
+
+	buf, err := json.MarshalIndent(state{Enabled: names}, "", "  ")
+	if err != nil {
+		return fmt.Errorf("plugin: failed to encode state: %w", err)
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, stateFile), append(buf, '\n'), 0o600); err != nil {
+		return fmt.Errorf("plugin: failed to write state: %w", err)
+	}
+
+	return nil
+}
+
+func readManifest(pluginDir string) (Manifest, error) {
+	buf, err := os.ReadFile(filepath.Join(pluginDir, manifestFile))
+	if err != nil {
+		return Manifest{}, fmt.Errorf("plugin: failed to read manifest: %w", err)
+	}
+
+	var manifest Manifest
+	if err := json.Unmarshal(buf, &manifest); err != nil {
+		return Manifest{}, fmt.Errorf("%w (dir: %v): %v", ErrInvalidManifest, pluginDir, err)
+	}
+
+	if err := manifest.Validate(); err != nil {
+		return Manifest{}, err
+	}
+
+	return manifest, nil
+}
+
+func readState(dir string) (state, error) {
+	buf, err := os.ReadFile(filepath.Join(dir, stateFile))
+	if errors.Is(err, fs.ErrNotExist) {
+		return state{}, nil
+	}
+
+	if err != nil {
+		return state{}, fmt.Errorf("plugin: failed to read state: %w", err)
+	}
+
+	var st state
+	if err := json.Unmarshal(buf, &st); err != nil {
+		return state{}, fmt.Errorf("plugin: failed to parse state: %w", err)
+	}
+
+	return st, nil
+}
@@ -7,41 +7,78 @@ import (
 	"crypto/x509"
 	"errors"
 	"fmt"
+	"io"
 	"math/rand"
 	"net"
 	"net/http"
 	"net/http/httputil"
-	"strings"
+	"sync"
 	"time"
 
 	"github.com/oklog/ulid"
+	"golang.org/x/net/http2"
 
 	"github.com/dstotijn/hetty/pkg/log"
+	"github.com/dstotijn/hetty/pkg/proxy/clientcert"
+	"github.com/dstotijn/hetty/pkg/proxy/timing"
+	"github.com/dstotijn/hetty/pkg/proxy/upstream"
 )
 
+// ulidEntropy is used for the IDs of requests, WebSocket messages and tunnels,
+// which are created concurrently, so it's guarded by a mutex.
+//
 //nolint:gosec
-var ulidEntropy = rand.New(rand.NewSource(time.Now().UnixNano()))
+var ulidEntropy = &lockedEntropy{r: rand.New(rand.NewSource(time.Now().UnixNano()))}
+
+// lockedEntropy is an entropy source for ULIDs that's safe for concurrent use.
+type lockedEntropy struct {
+	r  io.Reader
+	mu sync.Mutex
+}
 
 type contextKey int
 
-const reqIDKey contextKey = 0
+const (
+	reqIDKey contextKey = iota
+	encodedBodyKey
+	errorKey
+	localResponseKey
+)
 
 // Proxy implements http.Handler and offers MITM behaviour for modifying
 // HTTP requests and responses.
 type Proxy struct {
-	certConfig *CertConfig
-	handler    http.Handler
-	logger     log.Logger
+	certConfig  *CertConfig
+	handler     http.Handler
+	h2Server    *http2.Server
+	logger      log.Logger
+	upstream    *upstream.Dialer
+	clientCerts *clientcert.Store
+
+	settings   Settings
+	settingsMu sync.RWMutex
+
+	modifiers *modifierChains
+
+	passthroughFuncs []PassthroughTunnelFunc
+	errorResFuncs    []ErrorResponseFunc
 
-	// TODO: Add mutex for modifier funcs.
-	reqModifiers []RequestModifyMiddleware
-	resModifiers []ResponseModifyMiddleware
+	wsConns   map[ulid.ULID]*webSocketConn
+	wsConnsMu sync.RWMutex
 }
 
 type Config struct {
 	CACert *x509.Certificate
 	CAKey  crypto.PrivateKey
 	Logger log.Logger
+
+	// Upstream is used for dialing outgoing connections. When nil, connections
+	// are made directly (or via proxies from the environment).
+	Upstream *upstream.Dialer
+
+	// ClientCerts contains client certificates that are presented to servers
+	// that request one. When nil, no client certificates are used.
+	ClientCerts *clientcert.Store
 }
 
 // NewProxy returns a new Proxy.
@@ -52,19 +89,31 @@ func NewProxy(cfg Config) (*Proxy, error) {
 	}
 
 	p := &Proxy{
-		certConfig:   certConfig,
-		reqModifiers: make([]RequestModifyMiddleware, 0),
-		resModifiers: make([]ResponseModifyMiddleware, 0),
-		logger:       cfg.Logger,
+		certConfig:  certConfig,
+		h2Server:    &http2.Server{},
+		modifiers:   newModifierChains(),
+		wsConns:     make(map[ulid.ULID]*webSocketConn),
+		logger:      cfg.Logger,
+		upstream:    cfg.Upstream,
+		clientCerts: cfg.ClientCerts,
 	}
 
 	if p.logger == nil {
 		p.logger = log.NewNopLogger()
 	}
 
+	if p.upstream == nil {
+		p.upstream = upstream.NewDialer()
+	}
+
+	if p.clientCerts == nil {
+		p.clientCerts = &clientcert.Store{}
+	}
+
 	transport := &http.Transport{
-		// Values taken from `http.DefaultTransport`.
-		Proxy: http.ProxyFromEnvironment,
+		// Values taken from `http.DefaultTransport`. The transport connects to
+		// the upstream proxy itself, so it must not dial via the upstream proxy.
+		Proxy: p.upstream.Proxy,
 		DialContext: (&net.Dialer{
 			Timeout:   30 * time.Second,
 			KeepAlive: 30 * time.Second,
@@ -80,7 +129,9 @@ func NewProxy(cfg Config) (*Proxy, error) {
 	}
 
 	p.handler = &httputil.ReverseProxy{
-		Transport:      transport,
+		Transport: &localTransport{
+			base: timing.NewTransport(clientcert.NewTransport(transport, p.clientCerts)),
+		},
 		Director:       p.modifyRequest,
 		ModifyResponse: p.modifyResponse,
 		ErrorHandler:   p.errorHandler,
@@ -91,7 +142,13 @@ func NewProxy(cfg Config) (*Proxy, error) {
 
 func (p *Proxy) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	if r.Method == http.MethodConnect {
+		if p.isPassthrough(r.Host) {
+			p.handlePassthrough(w, r)
+			return
+		}
+
 		p.handleConnect(w)
+
 		return
 	}
 
@@ -99,15 +156,12 @@ func (p *Proxy) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	ctx := context.WithValue(r.Context(), reqIDKey, reqID)
 	*r = *r.WithContext(ctx)
 
-	p.handler.ServeHTTP(w, r)
-}
-
-func (p *Proxy) UseRequestModifier(fn ...RequestModifyMiddleware) {
-	p.reqModifiers = append(p.reqModifiers, fn...)
-}
+	if isWebSocketUpgrade(r) {
+		p.handleWebSocket(w, r)
+		return
+	}
 
-func (p *Proxy) UseResponseModifier(fn ...ResponseModifyMiddleware) {
-	p.resModifiers = append(p.resModifiers, fn...)
+	p.handler.ServeHTTP(w, r)
 }
 
 func (p *Proxy) modifyRequest(r *http.Request) {
@@ -121,29 +175,17 @@ func (p *Proxy) modifyRequest(r *http.Request) {
 	// set this header.
 	r.Header["X-Forwarded-For"] = nil
 
-	// Strip unsupported encodings.
-	if acceptEncs := r.Header.Get("Accept-Encoding"); acceptEncs != "" {
-		directives := strings.Split(acceptEncs, ",")
-		updated := make([]string, 0, len(directives))
-
-		for _, directive := range directives {
-			stripped := strings.TrimSpace(directive)
-			if strings.HasPrefix(stripped, "*") || strings.HasPrefix(stripped, "gzip") {
-				updated = append(updated, stripped)
-			}
-		}
-
-		if len(updated) == 0 {
-			r.Header.Del("Accept-Encoding")
-		} else {
-			r.Header.Set("Accept-Encoding", strings.Join(updated, ", "))
-		}
+	// Strip encodings that can't be decoded. When decompression is disabled,
+	// bodies are never decoded, so the header is left as-is.
+	if !p.Settings().DisableDecompression {
+		stripUnsupportedEncodings(r.Header)
 	}
 
 	fn := nopReqModifier
+	modifiers := p.modifiers.enabled(ModifierKindRequest)
 
-	for i := len(p.reqModifiers) - 1; i >= 0; i-- {
-		fn = p.reqModifiers[i](fn)
+	for i := len(modifiers) - 1; i >= 0; i-- {
+		fn = modifiers[i].req(fn)
 	}
 
 	fn(r)
@@ -152,13 +194,16 @@ func (p *Proxy) modifyRequest(r *http.Request) {
 func (p *Proxy) modifyResponse(res *http.Response) error {
 	fn := nopResModifier
 
-	// TODO: Make decompressing gzip formatted response bodies a configurable project setting.
-	if err := gunzipResponseBody(res); err != nil {
-		return fmt.Errorf("proxy: failed to gunzip response body: %w", err)
+	if !p.Settings().DisableDecompression {
+		if err := DecodeResponseBody(res); err != nil {
+			return fmt.Errorf("proxy: failed to decode response body: %w", err)
+		}
 	}
 
-	for i := len(p.resModifiers) - 1; i >= 0; i-- {
-		fn = p.resModifiers[i](fn)
+	modifiers := p.modifiers.enabled(ModifierKindResponse)
+
+	for i := len(modifiers) - 1; i >= 0; i-- {
+		fn = modifiers[i].res(fn)
 	}
 
 	return fn(res)
@@ -175,7 +220,8 @@ func RequestIDFromContext(ctx context.Context) (ulid.ULID, bool) {
 
 // handleConnect hijacks the incoming HTTP request and sets up an HTTP tunnel.
 // During the TLS handshake with the client, we use the proxy's CA config to
-// create a certificate on-the-fly.
+// create a certificate on-the-fly. When the client negotiates HTTP/2 via ALPN,
+// the tunnel is served by an HTTP/2 server.
 func (p *Proxy) handleConnect(w http.ResponseWriter) {
 	hj, ok := w.(http.Hijacker)
 	if !ok {
@@ -199,7 +245,7 @@ func (p *Proxy) handleConnect(w http.ResponseWriter) {
 	defer clientConn.Close()
 
 	// Secure connection to client.
-	tlsConn, err := p.clientTLSConn(clientConn)
+	tlsConn, err := p.clientTLSConn(clientConn, "")
 	if err != nil {
 		p.logger.Errorw("Securing client connection failed.",
 			"error", err,
@@ -208,6 +254,12 @@ func (p *Proxy) handleConnect(w http.ResponseWriter) {
 		return
 	}
 
+	if tlsConn.ConnectionState().NegotiatedProtocol == http2.NextProtoTLS {
+		// Blocks until the client closes the connection.
+		p.h2Server.ServeConn(tlsConn, &http2.ServeConnOpts{Handler: p})
+		return
+	}
+
 	clientConnNotify := ConnNotify{tlsConn, make(chan struct{})}
 	l := &OnceAcceptListener{clientConnNotify.Conn}
 
@@ -220,9 +272,27 @@ func (p *Proxy) handleConnect(w http.ResponseWriter) {
 	<-clientConnNotify.closed
 }
 
-func (p *Proxy) clientTLSConn(conn net.Conn) (*tls.Conn, error) {
+// clientTLSConn performs a TLS handshake with the client. When the client
+// doesn't send a server name (SNI), a certificate for `defaultHost` is used,
+// if set.
+func (p *Proxy) clientTLSConn(conn net.Conn, defaultHost string) (*tls.Conn, error) {
 	tlsConfig := p.certConfig.TLSConfig()
 
+	if defaultHost != "" {
+		getCert := tlsConfig.GetCertificate
+		tlsConfig.GetCertificate = func(clientHello *tls.ClientHelloInfo) (*tls.Certificate, error) {
+			if clientHello.ServerName == "" {
+				return p.certConfig.certForClient(clientHello, defaultHost)
+			}
+
+			return getCert(clientHello)
+		}
+	}
+
+	if p.Settings().DowngradeHTTP2 {
+		tlsConfig.NextProtos = []string{"http/1.1"}
+	}
+
 	tlsConn := tls.Server(conn, tlsConfig)
 	if err := tlsConn.Handshake(); err != nil {
 		tlsConn.Close()
@@ -232,18 +302,13 @@ func (p *Proxy) clientTLSConn(conn net.Conn) (*tls.Conn, error) {
 	return tlsConn, nil
 }
 
-func (p *Proxy) errorHandler(w http.ResponseWriter, r *http.Request, err error) {
-	switch {
-	case !errors.Is(err, context.Canceled):
-		p.logger.Errorw("Failed to proxy request.",
-			"error", err)
-	case errors.Is(err, context.Canceled):
-		p.logger.Debugw("Proxy request was cancelled.")
-	}
-
-	w.WriteHeader(http.StatusBadGateway)
-}
-
 func writeError(w http.ResponseWriter, code int) {
 	http.Error(w, http.StatusText(code), code)
 }
+
+func (e *lockedEntropy) Read(p []byte) (int, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	return e.r.Read(p)
+}
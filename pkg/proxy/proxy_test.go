@@ -0,0 +1,124 @@
+package proxy_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/dstotijn/hetty/pkg/proxy"
+	"github.com/dstotijn/hetty/pkg/proxy/upstream"
+)
+
+func newTestProxy(t *testing.T, cfg proxy.Config) *proxy.Proxy {
+	t.Helper()
+
+	caCert, caKey, err := proxy.NewCA("Hetty Test CA", "Hetty", time.Hour)
+	if err != nil {
+		t.Fatalf("failed to create CA: %v", err)
+	}
+
+	cfg.CACert = caCert
+	cfg.CAKey = caKey
+
+	p, err := proxy.NewProxy(cfg)
+	if err != nil {
+		t.Fatalf("failed to create proxy: %v", err)
+	}
+
+	return p
+}
+
+func TestProxyUpstream(t *testing.T) {
+	t.Parallel()
+
+	target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = io.WriteString(w, "hello")
+	}))
+	defer target.Close()
+
+	var (
+		upstreamReqs []string
+		mu           sync.Mutex
+	)
+
+	upstreamTransport := &http.Transport{}
+	defer upstreamTransport.CloseIdleConnections()
+
+	upstreamProxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		upstreamReqs = append(upstreamReqs, r.Method+" "+r.RequestURI)
+		mu.Unlock()
+
+		if r.Method == http.MethodConnect {
+			http.Error(w, "unexpected CONNECT", http.StatusMethodNotAllowed)
+			return
+		}
+
+		outReq, err := http.NewRequestWithContext(r.Context(), r.Method, r.RequestURI, r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		res, err := upstreamTransport.RoundTrip(outReq)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadGateway)
+			return
+		}
+		defer res.Body.Close()
+
+		w.WriteHeader(res.StatusCode)
+		_, _ = io.Copy(w, res.Body)
+	}))
+	defer upstreamProxy.Close()
+
+	upstreamURL, err := url.Parse(upstreamProxy.URL)
+	if err != nil {
+		t.Fatalf("failed to parse upstream proxy URL: %v", err)
+	}
+
+	dialer := upstream.NewDialer()
+	dialer.UpdateSettings(upstream.Settings{
+		Type: upstream.TypeHTTP,
+		Addr: upstreamURL.Host,
+	})
+
+	p := newTestProxy(t, proxy.Config{Upstream: dialer})
+
+	proxyServer := httptest.NewServer(p)
+	defer proxyServer.Close()
+
+	proxyURL, err := url.Parse(proxyServer.URL)
+	if err != nil {
+		t.Fatalf("failed to parse proxy URL: %v", err)
+	}
+
+	client := &http.Client{Transport: &http.Transport{Proxy: http.ProxyURL(proxyURL)}}
+
+	res, err := client.Get(target.URL + "/foo")
+	if err != nil {
+		t.Fatalf("unexpected error sending request: %v", err)
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		t.Fatalf("unexpected error reading response body: %v", err)
+	}
+
+	if res.StatusCode != http.StatusOK || string(body) != "hello" {
+		t.Errorf("unexpected response: %v %q", res.Status, body)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	exp := "GET " + target.URL + "/foo"
+	if len(upstreamReqs) != 1 || upstreamReqs[0] != exp {
+		t.Errorf("expected upstream proxy to receive [%q], got: %q", exp, upstreamReqs)
+	}
+}
@@ -0,0 +1,312 @@
+// Package script runs user provided Starlark scripts as part of the proxy's
+// request and response modifier chain. A script defines an `onRequest(req)`
+// and/or `onResponse(res)` function, e.g.:
+//
+//	def onRequest(req):
+//	    req.headers["User-Agent"] = "hetty"
+//	    if req.url.endswith("/logout"):
+//	        req.drop()
+//
+//	def onResponse(res):
+//	    if res.statusCode >= 500:
+//	        res.annotate("server error")
+//	    res.body = res.body.replace('"isAdmin":false', '"isAdmin":true')
+//
+// Requests and responses expose `headers` (a mapping with `get`, `set`, `add`,
+// `remove`, `keys` and `values` methods), `body`, `proto`, `annotate(text)`
+// and `drop()`. Requests also have a settable `method` and `url`; responses a
+// settable `statusCode`, and read-only `method` and `url` of their request.
+// The `json` module is predeclared.
+//
+// The `body` of streamed messages (e.g. server-sent events), and of bodies
+// larger than 10 MiB, is `None`, because these aren't buffered. It can still be
+// replaced.
+package script
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/oklog/ulid"
+	"go.starlark.net/lib/json"
+	"go.starlark.net/starlark"
+	"go.starlark.net/syntax"
+
+	"github.com/dstotijn/hetty/pkg/log"
+	"github.com/dstotijn/hetty/pkg/proxy"
+)
+
+// maxExecutionSteps limits the work a single hook call (or script load) can
+// do, so a faulty script (e.g. an infinite loop) can't stall the proxy.
+const maxExecutionSteps = 10_000_000
+
+// maxBodySize is the maximum number of bytes of a body that is buffered, so it
+// can be read by a script.
+const maxBodySize = 10 << 20 // 10 MiB
+
+const (
+	onRequestFn  = "onRequest"
+	onResponseFn = "onResponse"
+)
+
+var ErrInvalidScript = errors.New("script: invalid script")
+
+// ErrDropped wraps proxy.ErrDropped, so the proxy handles a request or response
+// that was dropped by a script as a cancelled request.
+var ErrDropped = fmt.Errorf("script: dropped by script: %w", proxy.ErrDropped)
+
+type contextKey int
+
+const (
+	reqAnnotationsKey contextKey = iota
+	resAnnotationsKey
+)
+
+// Script is a Starlark script with hooks for requests and/or responses.
+type Script struct {
+	ID      ulid.ULID
+	Name    string
+	Enabled bool
+	Source  string
+}
+
+// Service runs scripts for proxied requests and responses. Scripts can be
+// replaced while the proxy is running; requests that are in flight finish
+// with the scripts they started with. It's safe for concurrent use.
+type Service struct {
+	scripts  []Script
+	compiled []compiledScript
+	logger   log.Logger
+	mu       sync.RWMutex
+}
+
+type Config struct {
+	Logger log.Logger
+}
+
+type compiledScript struct {
+	name       string
+	onRequest  starlark.Callable
+	onResponse starlark.Callable
+}
+
+// NewService returns a new Service.
+func NewService(cfg Config) *Service {
+	svc := &Service{
+		logger: cfg.Logger,
+	}
+
+	if svc.logger == nil {
+		svc.logger = log.NewNopLogger()
+	}
+
+	return svc
+}
+
+// Validate returns an error if the script can't be loaded, e.g. because of a
+// syntax error or a missing hook.
+func (s Script) Validate() error {
+	_, err := compile(s, log.NewNopLogger())
+	return err
+}
+
+// Scripts returns the scripts.
+func (svc *Service) Scripts() []Script {
+	svc.mu.RLock()
+	defer svc.mu.RUnlock()
+
+	return svc.scripts
+}
+
+// SetScripts loads and replaces the scripts. Hooks of enabled scripts are run
+// in order. When any script can't be loaded, the current scripts are kept.
+func (svc *Service) SetScripts(scripts []Script) error {
+	compiled := make([]compiledScript, 0, len(scripts))
+
+	for _, s := range scripts {
+		c, err := compile(s, svc.logger)
+		if err != nil {
+			return err
+		}
+
+		if s.Enabled {
+			compiled = append(compiled, c)
+		}
+	}
+
+	svc.mu.Lock()
+	defer svc.mu.Unlock()
+
+	svc.scripts = scripts
+	svc.compiled = compiled
+
+	return nil
+}
+
+func compile(s Script, logger log.Logger) (compiledScript, error) {
+	thread := newThread(s.Name, logger)
+	predeclared := starlark.StringDict{
+		"json": json.Module,
+	}
+
+	globals, err := starlark.ExecFileOptions(&syntax.FileOptions{}, thread, s.Name, s.Source, predeclared)
+	if err != nil {
+		return compiledScript{}, fmt.Errorf("%w (name: %v): %v", ErrInvalidScript, s.Name, err)
+	}
+
+	// Globals are frozen, so hooks can be called concurrently.
+	globals.Freeze()
+
+	c := compiledScript{name: s.Name}
+
+	for name, hook := range map[string]*starlark.Callable{
+		onRequestFn:  &c.onRequest,
+		onResponseFn: &c.onResponse,
+	} {
+		v, ok := globals[name]
+		if !ok {
+			continue
+		}
+
+		fn, ok := v.(starlark.Callable)
+		if !ok {
+			return compiledScript{}, fmt.Errorf("%w (name: %v): `%v` must be a function, got %v",
+				ErrInvalidScript, s.Name, name, v.Type())
+		}
+
+		*hook = fn
+	}
+
+	if c.onRequest == nil && c.onResponse == nil {
+		return compiledScript{}, fmt.Errorf("%w (name: %v): script must define `%v` and/or `%v`",
+			ErrInvalidScript, s.Name, onRequestFn, onResponseFn)
+	}
+
+	return c, nil
+}
+
+func newThread(name string, logger log.Logger) *starlark.Thread {
+	thread := &starlark.Thread{
+		Name: name,
+		Print: func(_ *starlark.Thread, msg string) {
+			logger.Infow("Script output.",
+				"script", name,
+				"message", msg)
+		},
+	}
+	thread.SetMaxExecutionSteps(maxExecutionSteps)
+
+	return thread
+}
+
+func (svc *Service) compiledScripts() []compiledScript {
+	svc.mu.RLock()
+	defer svc.mu.RUnlock()
+
+	return svc.compiled
+}
+
+// RequestModifier is a proxy.RequestModifyMiddleware that calls the
+// `onRequest` hooks. When a hook drops the request, it's not sent to the
+// server, and the proxy responds with an error.
+func (svc *Service) RequestModifier(next proxy.RequestModifyFunc) proxy.RequestModifyFunc {
+	return func(req *http.Request) {
+		scripts := svc.compiledScripts()
+		if len(scripts) == 0 {
+			next(req)
+			return
+		}
+
+		v := newRequestValue(req)
+
+		for _, s := range scripts {
+			if s.onRequest == nil {
+				continue
+			}
+
+			_, err := starlark.Call(newThread(s.name, svc.logger), s.onRequest, starlark.Tuple{v}, nil)
+			if err != nil {
+				svc.logger.Errorw("Failed to run script.",
+					"script", s.name,
+					"hook", onRequestFn,
+					"error", err)
+			}
+
+			if v.dropped {
+				break
+			}
+		}
+
+		if len(v.annotations) > 0 {
+			*req = *req.WithContext(context.WithValue(req.Context(), reqAnnotationsKey, v.annotations))
+		}
+
+		if v.dropped {
+			svc.logger.Debugw("Request was dropped by script.")
+			proxy.DropRequest(req, ErrDropped)
+
+			return
+		}
+
+		next(req)
+	}
+}
+
+// ResponseModifier is a proxy.ResponseModifyMiddleware that calls the
+// `onResponse` hooks. When a hook drops the response, the proxy responds
+// with an error instead.
+func (svc *Service) ResponseModifier(next proxy.ResponseModifyFunc) proxy.ResponseModifyFunc {
+	return func(res *http.Response) error {
+		scripts := svc.compiledScripts()
+		if len(scripts) == 0 {
+			return next(res)
+		}
+
+		v := newResponseValue(res)
+
+		for _, s := range scripts {
+			if s.onResponse == nil {
+				continue
+			}
+
+			_, err := starlark.Call(newThread(s.name, svc.logger), s.onResponse, starlark.Tuple{v}, nil)
+			if err != nil {
+				svc.logger.Errorw("Failed to run script.",
+					"script", s.name,
+					"hook", onResponseFn,
+					"error", err)
+			}
+
+			if v.dropped {
+				break
+			}
+		}
+
+		if len(v.annotations) > 0 && res.Request != nil {
+			res.Request = res.Request.WithContext(context.WithValue(res.Request.Context(), resAnnotationsKey, v.annotations))
+		}
+
+		if v.dropped {
+			return ErrDropped
+		}
+
+		return next(res)
+	}
+}
+
+// RequestAnnotationsFromContext returns the annotations that scripts added to
+// a request.
+func RequestAnnotationsFromContext(ctx context.Context) []string {
+	annotations, _ := ctx.Value(reqAnnotationsKey).([]string)
+	return annotations
+}
+
+// ResponseAnnotationsFromContext returns the annotations that scripts added to
+// a response. Use the context of `res.Request`.
+func ResponseAnnotationsFromContext(ctx context.Context) []string {
+	annotations, _ := ctx.Value(resAnnotationsKey).([]string)
+	return annotations
+}
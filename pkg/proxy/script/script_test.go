@@ -0,0 +1,406 @@
+package script_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/dstotijn/hetty/pkg/proxy/script"
+)
+
+func TestRequestModifier(t *testing.T) {
+	t.Parallel()
+
+	svc := script.NewService(script.Config{})
+
+	err := svc.SetScripts([]script.Script{
+		{
+			Name:    "rewrite",
+			Enabled: true,
+			Source: `
+def onRequest(req):
+    req.headers["User-Agent"] = "hetty"
+    req.headers.add("X-Foo", "bar")
+    req.headers.remove("X-Remove")
+    req.url = req.url.replace("/v1/", "/v2/")
+    data = json.decode(req.body)
+    data["role"] = "admin"
+    req.body = json.encode(data)
+    req.annotate("rewritten by " + req.method)
+`,
+		},
+		{
+			Name:    "disabled",
+			Enabled: false,
+			Source: `
+def onRequest(req):
+    req.drop()
+`,
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	req := httptest.NewRequest(http.MethodPost, "https://example.com/v1/users", strings.NewReader(`{"role":"user"}`))
+	req.Header.Set("User-Agent", "curl/7.79.1")
+	req.Header.Set("X-Remove", "foobar")
+	req.Header.Set("Content-Length", "15")
+
+	var modifiedReq *http.Request
+
+	svc.RequestModifier(func(req *http.Request) {
+		modifiedReq = req
+	})(req)
+
+	if modifiedReq == nil {
+		t.Fatal("expected next modifier to be called")
+	}
+
+	if got := modifiedReq.URL.String(); got != "https://example.com/v2/users" {
+		t.Errorf("expected rewritten URL, got: %v", got)
+	}
+
+	body, err := io.ReadAll(modifiedReq.Body)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if expBody := `{"role":"admin"}`; string(body) != expBody {
+		t.Errorf("expected body %q, got: %q", expBody, body)
+	}
+
+	expHeader := http.Header{
+		"User-Agent":     []string{"hetty"},
+		"X-Foo":          []string{"bar"},
+		"Content-Length": []string{strconv.Itoa(len(body))},
+	}
+	if diff := cmp.Diff(expHeader, modifiedReq.Header); diff != "" {
+		t.Errorf("header not equal (-exp, +got):\n%v", diff)
+	}
+
+	if modifiedReq.ContentLength != int64(len(body)) {
+		t.Errorf("expected content length %v, got: %v", len(body), modifiedReq.ContentLength)
+	}
+
+	expAnnotations := []string{"rewritten by POST"}
+	if diff := cmp.Diff(expAnnotations, script.RequestAnnotationsFromContext(modifiedReq.Context())); diff != "" {
+		t.Errorf("annotations not equal (-exp, +got):\n%v", diff)
+	}
+}
+
+func TestRequestModifierDrop(t *testing.T) {
+	t.Parallel()
+
+	svc := script.NewService(script.Config{})
+
+	err := svc.SetScripts([]script.Script{
+		{
+			Name:    "drop",
+			Enabled: true,
+			Source: `
+def onRequest(req):
+    if req.url.endswith("/logout"):
+        req.annotate("dropped")
+        req.drop()
+`,
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "https://example.com/logout", nil)
+	called := false
+
+	svc.RequestModifier(func(*http.Request) {
+		called = true
+	})(req)
+
+	if called {
+		t.Error("expected next modifier not to be called")
+	}
+
+	if !errors.Is(context.Cause(req.Context()), script.ErrDropped) {
+		t.Errorf("expected context cause `script.ErrDropped`, got: %v", context.Cause(req.Context()))
+	}
+
+	if !errors.Is(req.Context().Err(), context.Canceled) {
+		t.Errorf("expected context to be cancelled, got: %v", req.Context().Err())
+	}
+
+	if diff := cmp.Diff([]string{"dropped"}, script.RequestAnnotationsFromContext(req.Context())); diff != "" {
+		t.Errorf("annotations not equal (-exp, +got):\n%v", diff)
+	}
+}
+
+func TestResponseModifier(t *testing.T) {
+	t.Parallel()
+
+	svc := script.NewService(script.Config{})
+
+	err := svc.SetScripts([]script.Script{
+		{
+			Name:    "response",
+			Enabled: true,
+			Source: `
+def onResponse(res):
+    if res.statusCode >= 500:
+        res.annotate("server error")
+        res.statusCode = 200
+    res.body = res.body.replace('"isAdmin":false', '"isAdmin":true')
+    if res.url.endswith("/drop"):
+        res.drop()
+`,
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		name           string
+		url            string
+		statusCode     int
+		expStatus      string
+		expErr         error
+		expAnnotations []string
+	}{
+		{
+			name:       "modified response",
+			url:        "https://example.com/",
+			statusCode: http.StatusOK,
+			expStatus:  "200 OK",
+		},
+		{
+			name:           "server error",
+			url:            "https://example.com/",
+			statusCode:     http.StatusBadGateway,
+			expStatus:      "200 OK",
+			expAnnotations: []string{"server error"},
+		},
+		{
+			name:       "dropped response",
+			url:        "https://example.com/drop",
+			statusCode: http.StatusOK,
+			expStatus:  "200 OK",
+			expErr:     script.ErrDropped,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			res := &http.Response{
+				StatusCode: tt.statusCode,
+				Status:     strconv.Itoa(tt.statusCode) + " " + http.StatusText(tt.statusCode),
+				Proto:      "HTTP/1.1",
+				Header:     http.Header{},
+				Body:       io.NopCloser(strings.NewReader(`{"isAdmin":false}`)),
+				Request:    httptest.NewRequest(http.MethodGet, tt.url, nil),
+			}
+
+			called := false
+
+			err := svc.ResponseModifier(func(*http.Response) error {
+				called = true
+				return nil
+			})(res)
+			if !errors.Is(err, tt.expErr) {
+				t.Fatalf("expected error %v, got: %v", tt.expErr, err)
+			}
+
+			if called == (tt.expErr != nil) {
+				t.Errorf("expected next modifier to be called: %v", tt.expErr == nil)
+			}
+
+			if res.Status != tt.expStatus {
+				t.Errorf("expected status %q, got: %q", tt.expStatus, res.Status)
+			}
+
+			body, err := io.ReadAll(res.Body)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if expBody := `{"isAdmin":true}`; string(body) != expBody {
+				t.Errorf("expected body %q, got: %q", expBody, body)
+			}
+
+			annotations := script.ResponseAnnotationsFromContext(res.Request.Context())
+			if diff := cmp.Diff(tt.expAnnotations, annotations); diff != "" {
+				t.Errorf("annotations not equal (-exp, +got):\n%v", diff)
+			}
+		})
+	}
+}
+
+func TestResponseModifierBodyLimits(t *testing.T) {
+	t.Parallel()
+
+	svc := script.NewService(script.Config{})
+
+	err := svc.SetScripts([]script.Script{
+		{
+			Name:    "body",
+			Enabled: true,
+			Source: `
+def onResponse(res):
+    if res.body == None:
+        res.annotate("no body")
+    else:
+        res.annotate("body: " + res.body[:3])
+`,
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	errRead := errors.New("read error")
+	largeBody := strings.Repeat("a", 10<<20+1)
+
+	tests := []struct {
+		name           string
+		contentType    string
+		body           func() io.ReadCloser
+		endless        bool
+		expBody        string
+		expErr         error
+		expAnnotations []string
+	}{
+		{
+			name:           "within limit",
+			body:           func() io.ReadCloser { return io.NopCloser(strings.NewReader("foobar")) },
+			expBody:        "foobar",
+			expAnnotations: []string{"body: foo"},
+		},
+		{
+			name:           "exceeds limit",
+			body:           func() io.ReadCloser { return io.NopCloser(strings.NewReader(largeBody)) },
+			expBody:        largeBody,
+			expAnnotations: []string{"no body"},
+		},
+		{
+			name:        "streaming content type",
+			contentType: "text/event-stream",
+			body: func() io.ReadCloser {
+				// The body never ends, so it must not be buffered.
+				pr, pw := io.Pipe()
+				go func() { _, _ = io.WriteString(pw, "data: foo\n\n") }()
+				return pr
+			},
+			endless:        true,
+			expBody:        "data: foo\n\n",
+			expAnnotations: []string{"no body"},
+		},
+		{
+			name: "read error",
+			body: func() io.ReadCloser {
+				return io.NopCloser(io.MultiReader(strings.NewReader("foo"), iotest.ErrReader(errRead)))
+			},
+			expBody: "foo",
+			expErr:  errRead,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			res := &http.Response{
+				StatusCode: http.StatusOK,
+				Proto:      "HTTP/1.1",
+				Header:     http.Header{"Content-Type": []string{tt.contentType}},
+				Body:       tt.body(),
+				Request:    httptest.NewRequest(http.MethodGet, "https://example.com/", nil),
+			}
+
+			err := svc.ResponseModifier(func(*http.Response) error { return nil })(res)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			annotations := script.ResponseAnnotationsFromContext(res.Request.Context())
+			if diff := cmp.Diff(tt.expAnnotations, annotations); diff != "" {
+				t.Errorf("annotations not equal (-exp, +got):\n%v", diff)
+			}
+
+			if tt.endless {
+				defer res.Body.Close()
+
+				body := make([]byte, len(tt.expBody))
+				if _, err := io.ReadFull(res.Body, body); err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+
+				if string(body) != tt.expBody {
+					t.Errorf("expected body %q, got: %q", tt.expBody, body)
+				}
+
+				return
+			}
+
+			body, err := io.ReadAll(res.Body)
+			if !errors.Is(err, tt.expErr) {
+				t.Fatalf("expected error %v, got: %v", tt.expErr, err)
+			}
+
+			if string(body) != tt.expBody {
+				t.Errorf("expected body of %v bytes, got %v bytes", len(tt.expBody), len(body))
+			}
+		})
+	}
+}
+
+func TestScriptValidate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		source string
+	}{
+		{
+			name:   "syntax error",
+			source: "def onRequest(req)\n    pass\n",
+		},
+		{
+			name:   "no hooks",
+			source: "x = 1\n",
+		},
+		{
+			name:   "hook is not a function",
+			source: "onRequest = 1\n",
+		},
+		{
+			name:   "infinite loop",
+			source: "def f():\n    for i in range(1 << 62):\n        pass\nf()\n",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := script.Script{Name: tt.name, Source: tt.source}.Validate()
+			if !errors.Is(err, script.ErrInvalidScript) {
+				t.Errorf("expected `script.ErrInvalidScript`, got: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,495 @@
+package script
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+
+	"go.starlark.net/starlark"
+
+	"github.com/dstotijn/hetty/pkg/proxy"
+)
+
+// message contains what the Starlark values of requests and responses have
+// in common: headers, a (lazily read) body, annotations and dropping.
+type message struct {
+	header        http.Header
+	body          *io.ReadCloser
+	contentLength *int64
+	buf           []byte
+	bodyRead      bool
+	// bodyOmitted is set when the body is streamed (e.g. server-sent events),
+	// or larger than maxBodySize, so it isn't buffered.
+	bodyOmitted bool
+	// bodyLocked is set for protocol switches (e.g. WebSockets), where the
+	// body is the upgraded connection.
+	bodyLocked bool
+
+	annotations []string
+	dropped     bool
+}
+
+type requestValue struct {
+	message
+	req *http.Request
+}
+
+type responseValue struct {
+	message
+	res *http.Response
+}
+
+type headersValue struct {
+	header http.Header
+}
+
+type keyIterator struct {
+	keys []string
+	i    int
+}
+
+var (
+	_ starlark.HasSetField = (*requestValue)(nil)
+	_ starlark.HasSetField = (*responseValue)(nil)
+	_ starlark.HasSetKey   = (*headersValue)(nil)
+	_ starlark.HasAttrs    = (*headersValue)(nil)
+	_ starlark.Iterable    = (*headersValue)(nil)
+)
+
+func newRequestValue(req *http.Request) *requestValue {
+	return &requestValue{
+		message: message{
+			header:        req.Header,
+			body:          &req.Body,
+			contentLength: &req.ContentLength,
+		},
+		req: req,
+	}
+}
+
+func newResponseValue(res *http.Response) *responseValue {
+	if res.Header == nil {
+		res.Header = make(http.Header)
+	}
+
+	return &responseValue{
+		message: message{
+			header:        res.Header,
+			body:          &res.Body,
+			contentLength: &res.ContentLength,
+			bodyLocked:    res.StatusCode == http.StatusSwitchingProtocols,
+		},
+		res: res,
+	}
+}
+
+// readBody reads the body once, and replaces it with a buffered copy. Bodies
+// that are streamed, or larger than maxBodySize, aren't buffered, and the
+// boolean is false. If the body can't be read, the bytes read so far are put
+// back in front of the unread body.
+func (m *message) readBody() ([]byte, bool, error) {
+	if m.bodyOmitted {
+		return nil, false, nil
+	}
+
+	if m.bodyRead || m.bodyLocked || *m.body == nil || *m.body == http.NoBody {
+		return m.buf, true, nil
+	}
+
+	if proxy.IsStreamingBody(m.header) {
+		m.bodyOmitted = true
+		return nil, false, nil
+	}
+
+	buf, err := io.ReadAll(io.LimitReader(*m.body, maxBodySize+1))
+
+	if err != nil || int64(len(buf)) > maxBodySize {
+		*m.body = struct {
+			io.Reader
+			io.Closer
+		}{io.MultiReader(bytes.NewReader(buf), *m.body), *m.body}
+
+		if err != nil {
+			return nil, false, fmt.Errorf("failed to read body: %w", err)
+		}
+
+		m.bodyOmitted = true
+
+		return nil, false, nil
+	}
+
+	(*m.body).Close()
+
+	m.buf = buf
+	m.bodyRead = true
+	*m.body = io.NopCloser(bytes.NewReader(buf))
+
+	return buf, true, nil
+}
+
+func (m *message) setBody(v starlark.Value) error {
+	if m.bodyLocked {
+		return errors.New("body can't be set for a protocol switch")
+	}
+
+	var buf []byte
+
+	switch body := v.(type) {
+	case starlark.String:
+		buf = []byte(body)
+	case starlark.Bytes:
+		buf = []byte(body)
+	default:
+		return fmt.Errorf("body must be a string or bytes, got %v", v.Type())
+	}
+
+	// Any unread body is discarded.
+	if !m.bodyRead && *m.body != nil {
+		(*m.body).Close()
+	}
+
+	m.buf = buf
+	m.bodyRead = true
+	m.bodyOmitted = false
+	*m.body = io.NopCloser(bytes.NewReader(buf))
+	*m.contentLength = int64(len(buf))
+
+	if m.header.Get("Content-Length") != "" {
+		m.header.Set("Content-Length", strconv.Itoa(len(buf)))
+	}
+
+	return nil
+}
+
+// attr returns the common attributes, or nil if there's no such attribute.
+func (m *message) attr(name string) (starlark.Value, error) {
+	switch name {
+	case "headers":
+		return &headersValue{header: m.header}, nil
+	case "body":
+		buf, ok, err := m.readBody()
+		if err != nil {
+			return nil, err
+		}
+
+		if !ok {
+			return starlark.None, nil
+		}
+
+		return starlark.String(buf), nil
+	case "annotate":
+		return starlark.NewBuiltin(name, func(
+			_ *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple,
+		) (starlark.Value, error) {
+			var text string
+			if err := starlark.UnpackPositionalArgs(b.Name(), args, kwargs, 1, &text); err != nil {
+				return nil, err
+			}
+
+			m.annotations = append(m.annotations, text)
+
+			return starlark.None, nil
+		}), nil
+	case "drop":
+		return starlark.NewBuiltin(name, func(
+			_ *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple,
+		) (starlark.Value, error) {
+			if err := starlark.UnpackPositionalArgs(b.Name(), args, kwargs, 0); err != nil {
+				return nil, err
+			}
+
+			m.dropped = true
+
+			return starlark.None, nil
+		}), nil
+	default:
+		return nil, nil
+	}
+}
+
+var messageAttrNames = []string{"annotate", "body", "drop", "headers"}
+
+func (v *requestValue) String() string {
+	return fmt.Sprintf("<request %v %v>", v.req.Method, v.req.URL)
+}
+
+func (v *requestValue) Type() string          { return "request" }
+func (v *requestValue) Freeze()               {}
+func (v *requestValue) Truth() starlark.Bool  { return starlark.True }
+func (v *requestValue) Hash() (uint32, error) { return 0, fmt.Errorf("unhashable type: %v", v.Type()) }
+
+func (v *requestValue) Attr(name string) (starlark.Value, error) {
+	switch name {
+	case "method":
+		return starlark.String(v.req.Method), nil
+	case "url":
+		return starlark.String(v.req.URL.String()), nil
+	case "proto":
+		return starlark.String(v.req.Proto), nil
+	default:
+		return v.attr(name)
+	}
+}
+
+func (v *requestValue) AttrNames() []string {
+	names := append([]string{"method", "proto", "url"}, messageAttrNames...)
+	sort.Strings(names)
+
+	return names
+}
+
+func (v *requestValue) SetField(name string, val starlark.Value) error {
+	switch name {
+	case "method":
+		method, ok := starlark.AsString(val)
+		if !ok || method == "" {
+			return fmt.Errorf("method must be a non-empty string, got %v", val)
+		}
+
+		v.req.Method = method
+	case "url":
+		rawURL, ok := starlark.AsString(val)
+		if !ok {
+			return fmt.Errorf("url must be a string, got %v", val.Type())
+		}
+
+		u, err := url.Parse(rawURL)
+		if err != nil {
+			return fmt.Errorf("invalid url: %w", err)
+		}
+
+		if !u.IsAbs() || u.Host == "" {
+			return fmt.Errorf("url must be absolute, got %q", rawURL)
+		}
+
+		if u.Host != v.req.URL.Host {
+			v.req.Host = u.Host
+		}
+
+		v.req.URL = u
+	case "body":
+		return v.setBody(val)
+	default:
+		return starlark.NoSuchAttrError(fmt.Sprintf("request has no settable field .%v", name))
+	}
+
+	return nil
+}
+
+func (v *responseValue) String() string {
+	return fmt.Sprintf("<response %v>", v.res.Status)
+}
+
+func (v *responseValue) Type() string          { return "response" }
+func (v *responseValue) Freeze()               {}
+func (v *responseValue) Truth() starlark.Bool  { return starlark.True }
+func (v *responseValue) Hash() (uint32, error) { return 0, fmt.Errorf("unhashable type: %v", v.Type()) }
+
+func (v *responseValue) Attr(name string) (starlark.Value, error) {
+	switch name {
+	case "statusCode":
+		return starlark.MakeInt(v.res.StatusCode), nil
+	case "proto":
+		return starlark.String(v.res.Proto), nil
+	case "method", "url":
+		if v.res.Request == nil {
+			return starlark.None, nil
+		}
+
+		if name == "method" {
+			return starlark.String(v.res.Request.Method), nil
+		}
+
+		return starlark.String(v.res.Request.URL.String()), nil
+	default:
+		return v.attr(name)
+	}
+}
+
+func (v *responseValue) AttrNames() []string {
+	names := append([]string{"method", "proto", "statusCode", "url"}, messageAttrNames...)
+	sort.Strings(names)
+
+	return names
+}
+
+func (v *responseValue) SetField(name string, val starlark.Value) error {
+	switch name {
+	case "statusCode":
+		var statusCode int
+		if err := starlark.AsInt(val, &statusCode); err != nil || statusCode < 100 || statusCode > 599 {
+			return fmt.Errorf("statusCode must be an int between 100 and 599, got %v", val)
+		}
+
+		v.res.StatusCode = statusCode
+		v.res.Status = fmt.Sprintf("%d %s", statusCode, http.StatusText(statusCode))
+	case "body":
+		return v.setBody(val)
+	default:
+		return starlark.NoSuchAttrError(fmt.Sprintf("response has no settable field .%v", name))
+	}
+
+	return nil
+}
+
+func (h *headersValue) String() string {
+	return fmt.Sprintf("<headers %v>", h.keys())
+}
+
+func (h *headersValue) Type() string          { return "headers" }
+func (h *headersValue) Freeze()               {}
+func (h *headersValue) Truth() starlark.Bool  { return starlark.Bool(len(h.header) > 0) }
+func (h *headersValue) Hash() (uint32, error) { return 0, fmt.Errorf("unhashable type: %v", h.Type()) }
+
+// Get implements starlark.Mapping. It returns the first value of a header.
+func (h *headersValue) Get(k starlark.Value) (starlark.Value, bool, error) {
+	key, ok := starlark.AsString(k)
+	if !ok {
+		return nil, false, fmt.Errorf("header key must be a string, got %v", k.Type())
+	}
+
+	values := h.header.Values(key)
+	if len(values) == 0 {
+		return nil, false, nil
+	}
+
+	return starlark.String(values[0]), true, nil
+}
+
+// SetKey implements starlark.HasSetKey. It replaces all values of a header.
+func (h *headersValue) SetKey(k, v starlark.Value) error {
+	key, ok := starlark.AsString(k)
+	if !ok {
+		return fmt.Errorf("header key must be a string, got %v", k.Type())
+	}
+
+	value, ok := starlark.AsString(v)
+	if !ok {
+		return fmt.Errorf("header value must be a string, got %v", v.Type())
+	}
+
+	h.header.Set(key, value)
+
+	return nil
+}
+
+func (h *headersValue) Iterate() starlark.Iterator {
+	return &keyIterator{keys: h.keys()}
+}
+
+func (h *headersValue) keys() []string {
+	keys := make([]string, 0, len(h.header))
+	for key := range h.header {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
+
+func (h *headersValue) Attr(name string) (starlark.Value, error) {
+	var fn func(b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error)
+
+	switch name {
+	case "get":
+		fn = func(b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+			var (
+				key string
+				def starlark.Value = starlark.None
+			)
+
+			if err := starlark.UnpackArgs(b.Name(), args, kwargs, "key", &key, "default?", &def); err != nil {
+				return nil, err
+			}
+
+			if values := h.header.Values(key); len(values) > 0 {
+				return starlark.String(values[0]), nil
+			}
+
+			return def, nil
+		}
+	case "set", "add":
+		fn = func(b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+			var key, value string
+			if err := starlark.UnpackArgs(b.Name(), args, kwargs, "key", &key, "value", &value); err != nil {
+				return nil, err
+			}
+
+			if b.Name() == "set" {
+				h.header.Set(key, value)
+			} else {
+				h.header.Add(key, value)
+			}
+
+			return starlark.None, nil
+		}
+	case "remove":
+		fn = func(b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+			var key string
+			if err := starlark.UnpackArgs(b.Name(), args, kwargs, "key", &key); err != nil {
+				return nil, err
+			}
+
+			h.header.Del(key)
+
+			return starlark.None, nil
+		}
+	case "keys":
+		fn = func(b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+			if err := starlark.UnpackArgs(b.Name(), args, kwargs); err != nil {
+				return nil, err
+			}
+
+			return stringList(h.keys()), nil
+		}
+	case "values":
+		fn = func(b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+			var key string
+			if err := starlark.UnpackArgs(b.Name(), args, kwargs, "key", &key); err != nil {
+				return nil, err
+			}
+
+			return stringList(h.header.Values(key)), nil
+		}
+	default:
+		return nil, nil
+	}
+
+	return starlark.NewBuiltin(name, func(
+		_ *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple,
+	) (starlark.Value, error) {
+		return fn(b, args, kwargs)
+	}), nil
+}
+
+func (h *headersValue) AttrNames() []string {
+	return []string{"add", "get", "keys", "remove", "set", "values"}
+}
+
+func stringList(values []string) *starlark.List {
+	elems := make([]starlark.Value, len(values))
+	for i, v := range values {
+		elems[i] = starlark.String(v)
+	}
+
+	return starlark.NewList(elems)
+}
+
+func (it *keyIterator) Next(p *starlark.Value) bool {
+	if it.i >= len(it.keys) {
+		return false
+	}
+
+	*p = starlark.String(it.keys[it.i])
+	it.i++
+
+	return true
+}
+
+func (it *keyIterator) Done() {}
@@ -0,0 +1,34 @@
+package proxy
+
+// Settings represents the proxy settings that can be changed at runtime, e.g.
+// when a project is opened.
+type Settings struct {
+	// DowngradeHTTP2 disables negotiating HTTP/2 with clients over TLS, so all
+	// intercepted HTTPS traffic is served over HTTP/1.1.
+	DowngradeHTTP2 bool
+
+	// TLSPassthrough rules match tunnels that are relayed as-is, without TLS
+	// termination, e.g. for hosts with certificate pinning.
+	TLSPassthrough []PassthroughRule
+
+	// DisableDecompression keeps response bodies encoded (e.g. gzip) as they
+	// were received. By default, bodies are decoded before response modifiers
+	// are called, so these can be read and modified.
+	DisableDecompression bool
+}
+
+// UpdateSettings updates the proxy settings. It's safe for concurrent use.
+func (p *Proxy) UpdateSettings(settings Settings) {
+	p.settingsMu.Lock()
+	defer p.settingsMu.Unlock()
+
+	p.settings = settings
+}
+
+// Settings returns the current proxy settings. It's safe for concurrent use.
+func (p *Proxy) Settings() Settings {
+	p.settingsMu.RLock()
+	defer p.settingsMu.RUnlock()
+
+	return p.settings
+}
@@ -0,0 +1,184 @@
+package proxy
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+)
+
+// SOCKS5 protocol values. See: https://datatracker.ietf.org/doc/html/rfc1928
+const (
+	socks5Version = 0x05
+
+	socks5AuthNone         = 0x00
+	socks5AuthNoAcceptable = 0xff
+
+	socks5CmdConnect = 0x01
+
+	socks5AddrIPv4   = 0x01
+	socks5AddrDomain = 0x03
+	socks5AddrIPv6   = 0x04
+
+	socks5ReplySucceeded        = 0x00
+	socks5ReplyCmdNotSupported  = 0x07
+	socks5ReplyAddrNotSupported = 0x08
+)
+
+var (
+	ErrSOCKS5InvalidVersion   = errors.New("proxy: invalid SOCKS version")
+	ErrSOCKS5NoAcceptableAuth = errors.New("proxy: no acceptable SOCKS5 authentication method")
+	ErrSOCKS5CmdNotSupported  = errors.New("proxy: SOCKS5 command not supported")
+	ErrSOCKS5AddrNotSupported = errors.New("proxy: SOCKS5 address type not supported")
+)
+
+// ServeSOCKS5 accepts incoming SOCKS5 connections on the listener `l`. Only
+// the CONNECT command is supported. Tunneled streams are sniffed for TLS or
+// plaintext HTTP, and are handled the same way as requests to the HTTP proxy.
+// It blocks until `l` is closed, or accepting a connection fails.
+func (p *Proxy) ServeSOCKS5(l net.Listener) error {
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			return err
+		}
+
+		go p.handleSOCKS5Conn(conn)
+	}
+}
+
+func (p *Proxy) handleSOCKS5Conn(conn net.Conn) {
+	target, err := socks5Handshake(conn)
+	if err != nil {
+		p.logger.Debugw("SOCKS5 handshake failed.",
+			"error", err,
+			"remoteAddr", conn.RemoteAddr().String())
+		conn.Close()
+
+		return
+	}
+
+	if p.isPassthrough(target) {
+		serverConn, err := p.upstream.DialContext(context.Background(), "tcp", target)
+		if err != nil {
+			p.logger.Errorw("Dialing passthrough tunnel host failed.",
+				"error", err,
+				"host", target)
+			conn.Close()
+
+			return
+		}
+
+		p.relayPassthrough(conn, serverConn, target)
+
+		return
+	}
+
+	p.serveTunnel(conn, target)
+}
+
+// socks5Handshake performs the method negotiation and reads the CONNECT request
+// from a SOCKS5 client. On success, it returns the requested target address.
+func socks5Handshake(conn net.Conn) (string, error) {
+	// Method selection: VER | NMETHODS | METHODS
+	header := make([]byte, 2)
+	if _, err := io.ReadFull(conn, header); err != nil {
+		return "", fmt.Errorf("proxy: failed to read SOCKS5 greeting: %w", err)
+	}
+
+	if header[0] != socks5Version {
+		return "", ErrSOCKS5InvalidVersion
+	}
+
+	methods := make([]byte, header[1])
+	if _, err := io.ReadFull(conn, methods); err != nil {
+		return "", fmt.Errorf("proxy: failed to read SOCKS5 auth methods: %w", err)
+	}
+
+	noAuth := false
+
+	for _, method := range methods {
+		if method == socks5AuthNone {
+			noAuth = true
+			break
+		}
+	}
+
+	if !noAuth {
+		_, _ = conn.Write([]byte{socks5Version, socks5AuthNoAcceptable})
+		return "", ErrSOCKS5NoAcceptableAuth
+	}
+
+	if _, err := conn.Write([]byte{socks5Version, socks5AuthNone}); err != nil {
+		return "", fmt.Errorf("proxy: failed to write SOCKS5 method selection: %w", err)
+	}
+
+	// Request: VER | CMD | RSV | ATYP | DST.ADDR | DST.PORT
+	req := make([]byte, 4)
+	if _, err := io.ReadFull(conn, req); err != nil {
+		return "", fmt.Errorf("proxy: failed to read SOCKS5 request: %w", err)
+	}
+
+	if req[0] != socks5Version {
+		return "", ErrSOCKS5InvalidVersion
+	}
+
+	var host string
+
+	switch req[3] {
+	case socks5AddrIPv4, socks5AddrIPv6:
+		size := net.IPv4len
+		if req[3] == socks5AddrIPv6 {
+			size = net.IPv6len
+		}
+
+		ip := make(net.IP, size)
+		if _, err := io.ReadFull(conn, ip); err != nil {
+			return "", fmt.Errorf("proxy: failed to read SOCKS5 address: %w", err)
+		}
+
+		host = ip.String()
+	case socks5AddrDomain:
+		size := make([]byte, 1)
+		if _, err := io.ReadFull(conn, size); err != nil {
+			return "", fmt.Errorf("proxy: failed to read SOCKS5 address: %w", err)
+		}
+
+		domain := make([]byte, size[0])
+		if _, err := io.ReadFull(conn, domain); err != nil {
+			return "", fmt.Errorf("proxy: failed to read SOCKS5 address: %w", err)
+		}
+
+		host = string(domain)
+	default:
+		_ = writeSOCKS5Reply(conn, socks5ReplyAddrNotSupported)
+		return "", ErrSOCKS5AddrNotSupported
+	}
+
+	port := make([]byte, 2)
+	if _, err := io.ReadFull(conn, port); err != nil {
+		return "", fmt.Errorf("proxy: failed to read SOCKS5 port: %w", err)
+	}
+
+	if req[1] != socks5CmdConnect {
+		_ = writeSOCKS5Reply(conn, socks5ReplyCmdNotSupported)
+		return "", ErrSOCKS5CmdNotSupported
+	}
+
+	// Like with HTTP CONNECT, the tunnel is established before connecting to
+	// the target, because requests are read and proxied by Hetty.
+	if err := writeSOCKS5Reply(conn, socks5ReplySucceeded); err != nil {
+		return "", fmt.Errorf("proxy: failed to write SOCKS5 reply: %w", err)
+	}
+
+	return net.JoinHostPort(host, strconv.Itoa(int(binary.BigEndian.Uint16(port)))), nil
+}
+
+// writeSOCKS5Reply writes a reply with an unspecified bound address.
+func writeSOCKS5Reply(w io.Writer, reply byte) error {
+	_, err := w.Write([]byte{socks5Version, reply, 0x00, socks5AddrIPv4, 0, 0, 0, 0, 0, 0})
+	return err
+}
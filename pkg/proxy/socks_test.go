@@ -0,0 +1,192 @@
+package proxy
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	netproxy "golang.org/x/net/proxy"
+)
+
+// scriptedConn is a net.Conn that reads from `r`, and records writes.
+type scriptedConn struct {
+	net.Conn
+	r io.Reader
+	w bytes.Buffer
+}
+
+func (c *scriptedConn) Read(b []byte) (int, error)  { return c.r.Read(b) }
+func (c *scriptedConn) Write(b []byte) (int, error) { return c.w.Write(b) }
+
+func TestSOCKS5Handshake(t *testing.T) {
+	t.Parallel()
+
+	greeting := []byte{0x05, 0x02, 0x02, 0x00}
+	selected := []byte{0x05, 0x00}
+
+	join := func(parts ...[]byte) []byte {
+		return bytes.Join(parts, nil)
+	}
+
+	tests := []struct {
+		name      string
+		input     []byte
+		expTarget string
+		expOutput []byte
+		expErr    error
+	}{
+		{
+			name: "IPv4 address",
+			input: join(greeting,
+				[]byte{0x05, 0x01, 0x00, 0x01, 127, 0, 0, 1, 0x1f, 0x90}),
+			expTarget: "127.0.0.1:8080",
+			expOutput: join(selected, []byte{0x05, 0x00, 0x00, 0x01, 0, 0, 0, 0, 0, 0}),
+		},
+		{
+			name: "IPv6 address",
+			input: join(greeting,
+				[]byte{0x05, 0x01, 0x00, 0x04},
+				net.ParseIP("::1"),
+				[]byte{0x01, 0xbb}),
+			expTarget: "[::1]:443",
+			expOutput: join(selected, []byte{0x05, 0x00, 0x00, 0x01, 0, 0, 0, 0, 0, 0}),
+		},
+		{
+			name: "domain name",
+			input: join(greeting,
+				[]byte{0x05, 0x01, 0x00, 0x03, 11},
+				[]byte("example.com"),
+				[]byte{0x01, 0xbb}),
+			expTarget: "example.com:443",
+			expOutput: join(selected, []byte{0x05, 0x00, 0x00, 0x01, 0, 0, 0, 0, 0, 0}),
+		},
+		{
+			name:   "invalid version",
+			input:  []byte{0x04, 0x01, 0x00},
+			expErr: ErrSOCKS5InvalidVersion,
+		},
+		{
+			name:      "no acceptable auth method",
+			input:     []byte{0x05, 0x01, 0x02},
+			expOutput: []byte{0x05, 0xff},
+			expErr:    ErrSOCKS5NoAcceptableAuth,
+		},
+		{
+			name: "unsupported command",
+			input: join(greeting,
+				[]byte{0x05, 0x02, 0x00, 0x01, 127, 0, 0, 1, 0x1f, 0x90}),
+			expOutput: join(selected, []byte{0x05, 0x07, 0x00, 0x01, 0, 0, 0, 0, 0, 0}),
+			expErr:    ErrSOCKS5CmdNotSupported,
+		},
+		{
+			name: "unsupported address type",
+			input: join(greeting,
+				[]byte{0x05, 0x01, 0x00, 0x05}),
+			expOutput: join(selected, []byte{0x05, 0x08, 0x00, 0x01, 0, 0, 0, 0, 0, 0}),
+			expErr:    ErrSOCKS5AddrNotSupported,
+		},
+		{
+			name: "truncated request",
+			input: join(greeting,
+				[]byte{0x05, 0x01, 0x00, 0x03, 11},
+				[]byte("example")),
+			expOutput: selected,
+			expErr:    io.ErrUnexpectedEOF,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			conn := &scriptedConn{r: bytes.NewReader(tt.input)}
+
+			target, err := socks5Handshake(conn)
+			if !errors.Is(err, tt.expErr) {
+				t.Fatalf("expected error %v, got: %v", tt.expErr, err)
+			}
+
+			if target != tt.expTarget {
+				t.Errorf("expected target %q, got: %q", tt.expTarget, target)
+			}
+
+			if !bytes.Equal(conn.w.Bytes(), tt.expOutput) {
+				t.Errorf("expected output %#v, got: %#v", tt.expOutput, conn.w.Bytes())
+			}
+		})
+	}
+}
+
+func TestServeSOCKS5(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.WriteString(w, r.Header.Get("X-Modified"))
+	}))
+	defer server.Close()
+
+	p := newProxyForTest(t, Config{})
+
+	err := p.UseRequestModifier("test", func(next RequestModifyFunc) RequestModifyFunc {
+		return func(req *http.Request) {
+			req.Header.Set("X-Modified", "yes")
+			next(req)
+		}
+	})
+	if err != nil {
+		t.Fatalf("unexpected error adding request modifier: %v", err)
+	}
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+	defer l.Close()
+
+	go func() {
+		_ = p.ServeSOCKS5(l)
+	}()
+
+	dialer, err := netproxy.SOCKS5("tcp", l.Addr().String(), nil, netproxy.Direct)
+	if err != nil {
+		t.Fatalf("failed to create SOCKS5 dialer: %v", err)
+	}
+
+	conn, err := dialer.Dial("tcp", server.Listener.Addr().String())
+	if err != nil {
+		t.Fatalf("unexpected error dialing via SOCKS5: %v", err)
+	}
+	defer conn.Close()
+
+	req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+	if err != nil {
+		t.Fatalf("failed to create request: %v", err)
+	}
+
+	if err := req.Write(conn); err != nil {
+		t.Fatalf("unexpected error writing request: %v", err)
+	}
+
+	res, err := http.ReadResponse(bufio.NewReader(conn), req)
+	if err != nil {
+		t.Fatalf("unexpected error reading response: %v", err)
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		t.Fatalf("unexpected error reading response body: %v", err)
+	}
+
+	// The tunneled request is handled like requests to the HTTP proxy, so it's
+	// passed through the request modifiers.
+	if string(body) != "yes" {
+		t.Errorf("expected modified request, got response body: %q", body)
+	}
+}
@@ -0,0 +1,57 @@
+package throttle
+
+import "time"
+
+// Preset is a named set of common network conditions.
+type Preset struct {
+	Name       string
+	Conditions Conditions
+}
+
+// Presets are the built-in presets. The 3G presets are based on those of
+// Chrome DevTools.
+var Presets = []Preset{
+	{
+		Name: "slow-3g",
+		Conditions: Conditions{
+			Latency:                2 * time.Second,
+			UploadBytesPerSecond:   50_000,
+			DownloadBytesPerSecond: 50_000,
+		},
+	},
+	{
+		Name: "fast-3g",
+		Conditions: Conditions{
+			Latency:                563 * time.Millisecond,
+			UploadBytesPerSecond:   84_375,
+			DownloadBytesPerSecond: 180_000,
+		},
+	},
+	{
+		Name: "lossy",
+		Conditions: Conditions{
+			Latency:     100 * time.Millisecond,
+			Jitter:      100 * time.Millisecond,
+			FailureRate: 0.1,
+			FailureMode: FailureModeReset,
+		},
+	},
+	{
+		Name: "offline",
+		Conditions: Conditions{
+			FailureRate: 1,
+			FailureMode: FailureModeReset,
+		},
+	},
+}
+
+// PresetByName returns the conditions of a built-in preset.
+func PresetByName(name string) (Conditions, bool) {
+	for _, preset := range Presets {
+		if preset.Name == name {
+			return preset.Conditions, true
+		}
+	}
+
+	return Conditions{}, false
+}
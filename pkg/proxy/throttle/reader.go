@@ -0,0 +1,63 @@
+package throttle
+
+import (
+	"context"
+	"io"
+	"strings"
+	"time"
+)
+
+// throttledReader limits the rate at which a body can be read.
+type throttledReader struct {
+	ctx         context.Context
+	rc          io.ReadCloser
+	bytesPerSec int64
+	// chunkSize is the maximum number of bytes per read, so data is delivered
+	// in a steady flow rather than in bursts.
+	chunkSize int
+	start     time.Time
+	read      int64
+}
+
+func newThrottledReader(ctx context.Context, rc io.ReadCloser, bytesPerSec int64) *throttledReader {
+	chunkSize := int(bytesPerSec / 10)
+	if chunkSize < 1 {
+		chunkSize = 1
+	}
+
+	return &throttledReader{
+		ctx:         ctx,
+		rc:          rc,
+		bytesPerSec: bytesPerSec,
+		chunkSize:   chunkSize,
+	}
+}
+
+func (r *throttledReader) Read(p []byte) (int, error) {
+	if r.start.IsZero() {
+		r.start = time.Now()
+	}
+
+	if len(p) > r.chunkSize {
+		p = p[:r.chunkSize]
+	}
+
+	n, err := r.rc.Read(p)
+	r.read += int64(n)
+
+	// Wait until the bytes read so far are within the rate.
+	due := r.start.Add(time.Duration(float64(r.read) / float64(r.bytesPerSec) * float64(time.Second)))
+	if sleepErr := sleep(r.ctx, time.Until(due)); sleepErr != nil && err == nil {
+		err = sleepErr
+	}
+
+	return n, err
+}
+
+func (r *throttledReader) Close() error {
+	return r.rc.Close()
+}
+
+func newStringBody(s string) io.ReadCloser {
+	return io.NopCloser(strings.NewReader(s))
+}
@@ -0,0 +1,399 @@
+// Package throttle simulates network conditions for proxied requests: added
+// latency with jitter, upload and download bandwidth caps, and randomly
+// injected failures.
+package throttle
+
+import (
+	"bytes"
+	"context"
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"regexp"
+	"sync"
+	"time"
+
+	"github.com/oklog/ulid"
+
+	"github.com/dstotijn/hetty/pkg/filter"
+	"github.com/dstotijn/hetty/pkg/log"
+	"github.com/dstotijn/hetty/pkg/proxy"
+	"github.com/dstotijn/hetty/pkg/proxy/intercept"
+)
+
+var ErrInvalidRule = errors.New("throttle: invalid rule")
+
+// ErrConnectionReset is the cause of requests that failed because of an
+// injected connection reset.
+var ErrConnectionReset = fmt.Errorf("throttle: injected connection reset: %w", proxy.ErrAbortConnection)
+
+type contextKey int
+
+const conditionsKey contextKey = 0
+
+// FailureMode is how injected failures fail.
+type FailureMode string
+
+const (
+	// FailureModeReset closes the client connection without a response.
+	FailureModeReset FailureMode = "reset"
+	// FailureModeServerError responds with `503 Service Unavailable`, without
+	// sending the request to the server.
+	FailureModeServerError FailureMode = "server_error"
+)
+
+// Conditions are the simulated network conditions.
+type Conditions struct {
+	// Latency is added before a request is sent to the server.
+	Latency time.Duration
+	// Jitter is the maximum random deviation from Latency.
+	Jitter time.Duration
+	// UploadBytesPerSecond caps the upload speed of request bodies. Zero means
+	// unlimited.
+	UploadBytesPerSecond int64
+	// DownloadBytesPerSecond caps the download speed of response bodies. Zero
+	// means unlimited.
+	DownloadBytesPerSecond int64
+	// FailureRate is the probability (0 to 1) of a request failing.
+	FailureRate float64
+	FailureMode FailureMode
+}
+
+// Rule applies conditions to requests that match its Host and Condition. When
+// neither is set, it applies to all requests.
+type Rule struct {
+	ID      ulid.ULID
+	Name    string
+	Enabled bool
+	// Host is matched against the host (without port) of requests.
+	Host *regexp.Regexp
+	// Condition is an optional filter expression.
+	Condition  filter.Expression
+	Conditions Conditions
+}
+
+// Service applies the conditions of the first matching rule to proxied
+// requests and responses. It's safe for concurrent use.
+type Service struct {
+	rules  []Rule
+	logger log.Logger
+	mu     sync.RWMutex
+
+	// randFloat and randInt63n are replaceable, for tests.
+	randFloat  func() float64
+	randInt63n func(n int64) int64
+	randMu     sync.Mutex
+}
+
+type Config struct {
+	Logger log.Logger
+}
+
+// NewService returns a new Service.
+func NewService(cfg Config) *Service {
+	svc := &Service{
+		logger: cfg.Logger,
+	}
+
+	if svc.logger == nil {
+		svc.logger = log.NewNopLogger()
+	}
+
+	//nolint:gosec
+	rnd := rand.New(rand.NewSource(time.Now().UnixNano()))
+	svc.randFloat = rnd.Float64
+	svc.randInt63n = rnd.Int63n
+
+	return svc
+}
+
+// Validate returns an error if the conditions are invalid.
+func (c Conditions) Validate() error {
+	if c.Latency < 0 || c.Jitter < 0 {
+		return fmt.Errorf("%w: latency and jitter must not be negative", ErrInvalidRule)
+	}
+
+	if c.UploadBytesPerSecond < 0 || c.DownloadBytesPerSecond < 0 {
+		return fmt.Errorf("%w: bandwidth must not be negative", ErrInvalidRule)
+	}
+
+	if c.FailureRate < 0 || c.FailureRate > 1 {
+		return fmt.Errorf("%w: failure rate must be between 0 and 1", ErrInvalidRule)
+	}
+
+	switch c.FailureMode {
+	case FailureModeReset, FailureModeServerError:
+	case "":
+		if c.FailureRate > 0 {
+			return fmt.Errorf("%w: failure mode must be set", ErrInvalidRule)
+		}
+	default:
+		return fmt.Errorf("%w: invalid failure mode %q", ErrInvalidRule, c.FailureMode)
+	}
+
+	return nil
+}
+
+// Validate returns an error if the rule is invalid.
+func (r Rule) Validate() error {
+	if err := r.Conditions.Validate(); err != nil {
+		return fmt.Errorf("%w (name: %v)", err, r.Name)
+	}
+
+	return nil
+}
+
+// Rules returns the rules.
+func (svc *Service) Rules() []Rule {
+	svc.mu.RLock()
+	defer svc.mu.RUnlock()
+
+	return svc.rules
+}
+
+// SetRules replaces the rules. Changes apply to requests that are proxied
+// afterwards.
+func (svc *Service) SetRules(rules []Rule) error {
+	for _, rule := range rules {
+		if err := rule.Validate(); err != nil {
+			return err
+		}
+	}
+
+	svc.mu.Lock()
+	defer svc.mu.Unlock()
+
+	svc.rules = rules
+
+	return nil
+}
+
+// match returns the conditions of the first enabled rule that matches the
+// request.
+func (svc *Service) match(req *http.Request) (Conditions, bool) {
+	svc.mu.RLock()
+	rules := svc.rules
+	svc.mu.RUnlock()
+
+	for _, rule := range rules {
+		if !rule.Enabled {
+			continue
+		}
+
+		if rule.Host != nil && !rule.Host.MatchString(req.URL.Hostname()) {
+			continue
+		}
+
+		if rule.Condition != nil {
+			match, err := intercept.MatchRequestFilter(req, rule.Condition)
+			if err != nil {
+				svc.logger.Errorw("Failed to match throttle rule condition.",
+					"rule", rule.Name,
+					"error", err)
+
+				continue
+			}
+
+			if !match {
+				continue
+			}
+		}
+
+		return rule.Conditions, true
+	}
+
+	return Conditions{}, false
+}
+
+// RequestModifier is a proxy.RequestModifyMiddleware that delays requests,
+// caps their upload speed and injects failures, based on the matching rule.
+func (svc *Service) RequestModifier(next proxy.RequestModifyFunc) proxy.RequestModifyFunc {
+	return func(req *http.Request) {
+		cond, ok := svc.match(req)
+		if !ok {
+			next(req)
+			return
+		}
+
+		*req = *req.WithContext(context.WithValue(req.Context(), conditionsKey, cond))
+
+		if cond.FailureRate > 0 && svc.float() < cond.FailureRate {
+			svc.logger.Debugw("Injected failure.",
+				"url", req.URL.String(),
+				"mode", cond.FailureMode)
+
+			svc.fail(req, cond.FailureMode)
+
+			return
+		}
+
+		if err := sleep(req.Context(), svc.latency(cond)); err != nil {
+			return
+		}
+
+		if cond.UploadBytesPerSecond > 0 && req.Body != nil && req.Body != http.NoBody {
+			req.Body = newThrottledReader(req.Context(), req.Body, cond.UploadBytesPerSecond)
+		}
+
+		next(req)
+	}
+}
+
+// ResponseModifier is a proxy.ResponseModifyMiddleware that caps the download
+// speed of response bodies, based on the rule that matched the request. The
+// body is wrapped after the next modifiers are called, so the cap applies to
+// the body as it's sent to the client.
+func (svc *Service) ResponseModifier(next proxy.ResponseModifyFunc) proxy.ResponseModifyFunc {
+	return func(res *http.Response) error {
+		if err := next(res); err != nil {
+			return err
+		}
+
+		if res.Request == nil {
+			return nil
+		}
+
+		cond, ok := ConditionsFromContext(res.Request.Context())
+		if ok && cond.DownloadBytesPerSecond > 0 && res.Body != nil && res.Body != http.NoBody &&
+			res.StatusCode != http.StatusSwitchingProtocols {
+			res.Body = newThrottledReader(res.Request.Context(), res.Body, cond.DownloadBytesPerSecond)
+		}
+
+		return nil
+	}
+}
+
+func (svc *Service) fail(req *http.Request, mode FailureMode) {
+	if mode == FailureModeServerError {
+		*req = *req.WithContext(proxy.WithLocalResponse(req.Context(), serverErrorResponse))
+		return
+	}
+
+	// With ErrConnectionReset as cause, the client connection is closed.
+	proxy.DropRequest(req, ErrConnectionReset)
+}
+
+func serverErrorResponse(req *http.Request) (*http.Response, error) {
+	body := "Hetty: injected failure (throttle).\n"
+
+	return &http.Response{
+		Status:     fmt.Sprintf("%d %s", http.StatusServiceUnavailable, http.StatusText(http.StatusServiceUnavailable)),
+		StatusCode: http.StatusServiceUnavailable,
+		Proto:      "HTTP/1.1",
+		ProtoMajor: 1,
+		ProtoMinor: 1,
+		Header: http.Header{
+			"Content-Type":   []string{"text/plain; charset=utf-8"},
+			"Content-Length": []string{fmt.Sprint(len(body))},
+		},
+		Body:          newStringBody(body),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}, nil
+}
+
+// latency returns the latency plus a random jitter in [-jitter, jitter].
+func (svc *Service) latency(cond Conditions) time.Duration {
+	d := cond.Latency
+
+	if cond.Jitter > 0 {
+		svc.randMu.Lock()
+		d += time.Duration(svc.randInt63n(int64(2*cond.Jitter)+1)) - cond.Jitter
+		svc.randMu.Unlock()
+	}
+
+	if d < 0 {
+		return 0
+	}
+
+	return d
+}
+
+func (svc *Service) float() float64 {
+	svc.randMu.Lock()
+	defer svc.randMu.Unlock()
+
+	return svc.randFloat()
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return nil
+	}
+
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// ConditionsFromContext returns the conditions that were applied to a request.
+func ConditionsFromContext(ctx context.Context) (Conditions, bool) {
+	cond, ok := ctx.Value(conditionsKey).(Conditions)
+	return cond, ok
+}
+
+type ruleDTO struct {
+	ID         ulid.ULID
+	Name       string
+	Enabled    bool
+	Host       string
+	Condition  filter.Expression
+	Conditions Conditions
+}
+
+func (r Rule) MarshalBinary() ([]byte, error) {
+	dto := ruleDTO{
+		ID:         r.ID,
+		Name:       r.Name,
+		Enabled:    r.Enabled,
+		Condition:  r.Condition,
+		Conditions: r.Conditions,
+	}
+
+	if r.Host != nil {
+		dto.Host = r.Host.String()
+	}
+
+	buf := bytes.Buffer{}
+
+	err := gob.NewEncoder(&buf).Encode(dto)
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func (r *Rule) UnmarshalBinary(data []byte) error {
+	dto := ruleDTO{}
+
+	err := gob.NewDecoder(bytes.NewReader(data)).Decode(&dto)
+	if err != nil {
+		return err
+	}
+
+	*r = Rule{
+		ID:         dto.ID,
+		Name:       dto.Name,
+		Enabled:    dto.Enabled,
+		Condition:  dto.Condition,
+		Conditions: dto.Conditions,
+	}
+
+	if dto.Host != "" {
+		r.Host, err = regexp.Compile(dto.Host)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,311 @@
+package throttle_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dstotijn/hetty/pkg/filter"
+	"github.com/dstotijn/hetty/pkg/proxy"
+	"github.com/dstotijn/hetty/pkg/proxy/throttle"
+)
+
+func TestRequestModifierMatch(t *testing.T) {
+	t.Parallel()
+
+	svc := throttle.NewService(throttle.Config{})
+
+	expr, err := filter.ParseQuery(`method = "POST"`)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	err = svc.SetRules([]throttle.Rule{
+		{
+			Name:       "disabled",
+			Enabled:    false,
+			Conditions: throttle.Conditions{UploadBytesPerSecond: 1},
+		},
+		{
+			Name:       "host",
+			Enabled:    true,
+			Host:       regexp.MustCompile(`^api\.example\.com$`),
+			Conditions: throttle.Conditions{UploadBytesPerSecond: 2},
+		},
+		{
+			Name:       "condition",
+			Enabled:    true,
+			Condition:  expr,
+			Conditions: throttle.Conditions{UploadBytesPerSecond: 3},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		req    *http.Request
+		expOK  bool
+		expBPS int64
+	}{
+		{
+			name:   "host rule",
+			req:    httptest.NewRequest(http.MethodPost, "https://api.example.com:8443/", nil),
+			expOK:  true,
+			expBPS: 2,
+		},
+		{
+			name:   "condition rule",
+			req:    httptest.NewRequest(http.MethodPost, "https://example.com/", nil),
+			expOK:  true,
+			expBPS: 3,
+		},
+		{
+			name:  "no match",
+			req:   httptest.NewRequest(http.MethodGet, "https://example.com/", nil),
+			expOK: false,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var got *http.Request
+
+			svc.RequestModifier(func(req *http.Request) {
+				got = req
+			})(tt.req)
+
+			if got == nil {
+				t.Fatal("expected next modifier to be called")
+			}
+
+			cond, ok := throttle.ConditionsFromContext(got.Context())
+			if ok != tt.expOK {
+				t.Fatalf("expected ok to be %v, got: %v", tt.expOK, ok)
+			}
+
+			if cond.UploadBytesPerSecond != tt.expBPS {
+				t.Errorf("expected upload bytes per second %v, got: %v", tt.expBPS, cond.UploadBytesPerSecond)
+			}
+		})
+	}
+}
+
+func TestRequestModifierFailure(t *testing.T) {
+	t.Parallel()
+
+	t.Run("reset", func(t *testing.T) {
+		t.Parallel()
+
+		svc := throttle.NewService(throttle.Config{})
+
+		err := svc.SetRules([]throttle.Rule{{
+			Enabled:    true,
+			Conditions: throttle.Conditions{FailureRate: 1, FailureMode: throttle.FailureModeReset},
+		}})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		req := httptest.NewRequest(http.MethodGet, "https://example.com/", nil)
+		called := false
+
+		svc.RequestModifier(func(*http.Request) {
+			called = true
+		})(req)
+
+		if called {
+			t.Error("expected next modifier not to be called")
+		}
+
+		cause := context.Cause(req.Context())
+		if !errors.Is(cause, throttle.ErrConnectionReset) || !errors.Is(cause, proxy.ErrAbortConnection) {
+			t.Errorf("expected context cause `throttle.ErrConnectionReset`, got: %v", cause)
+		}
+	})
+
+	t.Run("server error", func(t *testing.T) {
+		t.Parallel()
+
+		svc := throttle.NewService(throttle.Config{})
+
+		err := svc.SetRules([]throttle.Rule{{
+			Enabled:    true,
+			Conditions: throttle.Conditions{FailureRate: 1, FailureMode: throttle.FailureModeServerError},
+		}})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		req := httptest.NewRequest(http.MethodGet, "https://example.com/", nil)
+		called := false
+
+		svc.RequestModifier(func(*http.Request) {
+			called = true
+		})(req)
+
+		if called {
+			t.Error("expected next modifier not to be called")
+		}
+
+		if req.Context().Err() != nil {
+			t.Errorf("expected context not to be cancelled, got: %v", req.Context().Err())
+		}
+	})
+}
+
+func TestRequestModifierLatency(t *testing.T) {
+	t.Parallel()
+
+	svc := throttle.NewService(throttle.Config{})
+
+	err := svc.SetRules([]throttle.Rule{{
+		Enabled:    true,
+		Conditions: throttle.Conditions{Latency: 100 * time.Millisecond},
+	}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	start := time.Now()
+
+	svc.RequestModifier(func(*http.Request) {})(httptest.NewRequest(http.MethodGet, "https://example.com/", nil))
+
+	if elapsed := time.Since(start); elapsed < 100*time.Millisecond {
+		t.Errorf("expected request to be delayed by at least 100ms, got: %v", elapsed)
+	}
+
+	// Cancelled requests aren't delayed, nor passed on.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	called := false
+	start = time.Now()
+
+	svc.RequestModifier(func(*http.Request) {
+		called = true
+	})(httptest.NewRequest(http.MethodGet, "https://example.com/", nil).WithContext(ctx))
+
+	if called {
+		t.Error("expected next modifier not to be called")
+	}
+
+	if elapsed := time.Since(start); elapsed >= 100*time.Millisecond {
+		t.Errorf("expected cancelled request not to be delayed, got: %v", elapsed)
+	}
+}
+
+func TestResponseModifierBandwidth(t *testing.T) {
+	t.Parallel()
+
+	svc := throttle.NewService(throttle.Config{})
+
+	err := svc.SetRules([]throttle.Rule{{
+		Enabled:    true,
+		Conditions: throttle.Conditions{DownloadBytesPerSecond: 1000},
+	}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var req *http.Request
+
+	svc.RequestModifier(func(r *http.Request) {
+		req = r
+	})(httptest.NewRequest(http.MethodGet, "https://example.com/", nil))
+
+	body := strings.Repeat("a", 300)
+	res := &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(strings.NewReader(body)),
+		Request:    req,
+	}
+
+	err = svc.ResponseModifier(func(*http.Response) error { return nil })(res)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	start := time.Now()
+
+	got, err := io.ReadAll(res.Body)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if string(got) != body {
+		t.Errorf("expected body to be unmodified, got: %q", got)
+	}
+
+	// 300 bytes at 1000 bytes per second takes about 300ms.
+	if elapsed := time.Since(start); elapsed < 250*time.Millisecond {
+		t.Errorf("expected reading body to take at least 250ms, got: %v", elapsed)
+	}
+}
+
+func TestRuleValidate(t *testing.T) {
+	t.Parallel()
+
+	conditions := []throttle.Conditions{
+		{Latency: -1},
+		{DownloadBytesPerSecond: -1},
+		{FailureRate: 1.5},
+		{FailureRate: 0.5},
+		{FailureRate: 0.5, FailureMode: "foobar"},
+	}
+
+	for _, cond := range conditions {
+		if err := (throttle.Rule{Conditions: cond}).Validate(); !errors.Is(err, throttle.ErrInvalidRule) {
+			t.Errorf("expected `throttle.ErrInvalidRule` for %+v, got: %v", cond, err)
+		}
+	}
+
+	for _, preset := range throttle.Presets {
+		if err := preset.Conditions.Validate(); err != nil {
+			t.Errorf("unexpected error for preset %q: %v", preset.Name, err)
+		}
+	}
+}
+
+func TestRuleMarshalBinary(t *testing.T) {
+	t.Parallel()
+
+	expr, err := filter.ParseQuery(`method = "POST"`)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	rule := throttle.Rule{
+		Name:       "foobar",
+		Enabled:    true,
+		Host:       regexp.MustCompile(`example\.com$`),
+		Condition:  expr,
+		Conditions: throttle.Conditions{Latency: time.Second, FailureRate: 0.1, FailureMode: throttle.FailureModeReset},
+	}
+
+	data, err := rule.MarshalBinary()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var got throttle.Rule
+	if err := got.UnmarshalBinary(data); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got.Name != rule.Name || got.Conditions != rule.Conditions || got.Host.String() != rule.Host.String() ||
+		got.Condition.String() != rule.Condition.String() {
+		t.Errorf("expected %+v, got: %+v", rule, got)
+	}
+}
@@ -0,0 +1,187 @@
+// Package timing records how long the phases of an HTTP round trip take, using
+// `net/http/httptrace`.
+package timing
+
+import (
+	"context"
+	"crypto/tls"
+	"io"
+	"net/http"
+	"net/http/httptrace"
+	"sync"
+	"time"
+)
+
+type contextKey int
+
+const recorderKey contextKey = 0
+
+// Timing contains the durations of the phases of an HTTP round trip. Phases
+// that didn't occur (e.g. DNS lookup and connect for a reused connection) have
+// a zero duration.
+type Timing struct {
+	DNS             time.Duration
+	Connect         time.Duration
+	TLSHandshake    time.Duration
+	TimeToFirstByte time.Duration
+	// Total is the duration from sending the request until the response body
+	// was read.
+	Total time.Duration
+}
+
+// Transport implements http.RoundTripper, and records timing for each round
+// trip of the underlying transport. Use `FromContext` with the context of
+// `res.Request` to get the recorded timing.
+type Transport struct {
+	base http.RoundTripper
+}
+
+type recorder struct {
+	start        time.Time
+	dnsStart     time.Time
+	connectStart time.Time
+	tlsStart     time.Time
+	end          time.Time
+	timing       Timing
+	mu           sync.Mutex
+}
+
+type body struct {
+	io.ReadCloser
+	rec *recorder
+}
+
+// NewTransport returns a new Transport. When `base` is nil,
+// `http.DefaultTransport` is used.
+func NewTransport(base http.RoundTripper) *Transport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	return &Transport{base: base}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	rec := &recorder{start: time.Now()}
+
+	ctx := context.WithValue(req.Context(), recorderKey, rec)
+	ctx = httptrace.WithClientTrace(ctx, rec.clientTrace())
+
+	res, err := t.base.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	// Bodies of protocol switch responses (e.g. WebSocket) must remain
+	// writable, so these aren't wrapped.
+	if res.StatusCode != http.StatusSwitchingProtocols && res.Body != nil {
+		res.Body = &body{ReadCloser: res.Body, rec: rec}
+	}
+
+	return res, nil
+}
+
+// CloseIdleConnections closes idle connections of the underlying transport.
+func (t *Transport) CloseIdleConnections() {
+	type closeIdler interface {
+		CloseIdleConnections()
+	}
+
+	if tr, ok := t.base.(closeIdler); ok {
+		tr.CloseIdleConnections()
+	}
+}
+
+// FromContext returns the timing recorded for a round trip. If the response
+// body hasn't been read completely, `Total` is the duration until now.
+func FromContext(ctx context.Context) (Timing, bool) {
+	rec, ok := ctx.Value(recorderKey).(*recorder)
+	if !ok {
+		return Timing{}, false
+	}
+
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+
+	timing := rec.timing
+
+	if rec.end.IsZero() {
+		timing.Total = time.Since(rec.start)
+	} else {
+		timing.Total = rec.end.Sub(rec.start)
+	}
+
+	return timing, true
+}
+
+func (rec *recorder) clientTrace() *httptrace.ClientTrace {
+	return &httptrace.ClientTrace{
+		DNSStart: func(httptrace.DNSStartInfo) {
+			rec.mu.Lock()
+			defer rec.mu.Unlock()
+			rec.dnsStart = time.Now()
+		},
+		DNSDone: func(httptrace.DNSDoneInfo) {
+			rec.mu.Lock()
+			defer rec.mu.Unlock()
+			rec.timing.DNS = time.Since(rec.dnsStart)
+		},
+		ConnectStart: func(_, _ string) {
+			rec.mu.Lock()
+			defer rec.mu.Unlock()
+
+			// With multiple connection attempts (e.g. IPv4 and IPv6), the
+			// duration is measured from the first attempt.
+			if rec.connectStart.IsZero() {
+				rec.connectStart = time.Now()
+			}
+		},
+		ConnectDone: func(_, _ string, err error) {
+			rec.mu.Lock()
+			defer rec.mu.Unlock()
+
+			if err == nil && rec.timing.Connect == 0 {
+				rec.timing.Connect = time.Since(rec.connectStart)
+			}
+		},
+		TLSHandshakeStart: func() {
+			rec.mu.Lock()
+			defer rec.mu.Unlock()
+			rec.tlsStart = time.Now()
+		},
+		TLSHandshakeDone: func(tls.ConnectionState, error) {
+			rec.mu.Lock()
+			defer rec.mu.Unlock()
+			rec.timing.TLSHandshake = time.Since(rec.tlsStart)
+		},
+		GotFirstResponseByte: func() {
+			rec.mu.Lock()
+			defer rec.mu.Unlock()
+			rec.timing.TimeToFirstByte = time.Since(rec.start)
+		},
+	}
+}
+
+func (rec *recorder) done() {
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+
+	if rec.end.IsZero() {
+		rec.end = time.Now()
+	}
+}
+
+func (b *body) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	if err != nil {
+		b.rec.done()
+	}
+
+	return n, err
+}
+
+func (b *body) Close() error {
+	b.rec.done()
+	return b.ReadCloser.Close()
+}
@@ -0,0 +1,161 @@
+package timing_test
+
+import (
+	"context"
+	"crypto/tls"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/dstotijn/hetty/pkg/proxy/timing"
+	"github.com/dstotijn/hetty/pkg/reqlog"
+)
+
+const serverDelay = 20 * time.Millisecond
+
+func TestTransport(t *testing.T) {
+	t.Parallel()
+
+	var (
+		serverTLS tls.ConnectionState
+		mu        sync.Mutex
+	)
+
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		serverTLS = *r.TLS
+		mu.Unlock()
+
+		time.Sleep(serverDelay)
+		_, _ = io.WriteString(w, "foobar")
+	}))
+	defer srv.Close()
+
+	base, ok := srv.Client().Transport.(*http.Transport)
+	if !ok {
+		t.Fatalf("unexpected transport type: %T", srv.Client().Transport)
+	}
+
+	// Requests are sent to `localhost`, so its address is looked up. The
+	// certificate of the test server is valid for `example.com`.
+	base = base.Clone()
+	base.TLSClientConfig.ServerName = "example.com"
+
+	transport := timing.NewTransport(base)
+	defer transport.CloseIdleConnections()
+
+	_, port, err := net.SplitHostPort(srv.Listener.Addr().String())
+	if err != nil {
+		t.Fatalf("failed to parse server address: %v", err)
+	}
+
+	client := &http.Client{Transport: transport}
+	url := "https://localhost:" + port + "/"
+
+	get := func(t *testing.T) *http.Response {
+		t.Helper()
+
+		res, err := client.Get(url)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if _, err := io.ReadAll(res.Body); err != nil {
+			t.Fatalf("unexpected error reading body: %v", err)
+		}
+
+		res.Body.Close()
+
+		return res
+	}
+
+	t.Run("new connection", func(t *testing.T) {
+		res := get(t)
+
+		got, ok := timing.FromContext(res.Request.Context())
+		if !ok {
+			t.Fatal("expected timing in context")
+		}
+
+		if got.DNS <= 0 || got.Connect <= 0 || got.TLSHandshake <= 0 {
+			t.Errorf("expected DNS, connect and TLS handshake phases, got: %+v", got)
+		}
+
+		if got.TimeToFirstByte < serverDelay {
+			t.Errorf("expected time to first byte of at least %v, got: %v", serverDelay, got.TimeToFirstByte)
+		}
+
+		if got.Total < got.TimeToFirstByte {
+			t.Errorf("expected total (%v) to include time to first byte (%v)", got.Total, got.TimeToFirstByte)
+		}
+
+		// Once the body is read, the total duration doesn't change.
+		time.Sleep(10 * time.Millisecond)
+
+		if again, _ := timing.FromContext(res.Request.Context()); again.Total != got.Total {
+			t.Errorf("expected total to be fixed at %v, got: %v", got.Total, again.Total)
+		}
+
+		if res.TLS == nil {
+			t.Fatal("expected TLS connection state")
+		}
+
+		mu.Lock()
+		expVersion, expCipherSuite := serverTLS.Version, serverTLS.CipherSuite
+		mu.Unlock()
+
+		if res.TLS.Version != expVersion || res.TLS.CipherSuite != expCipherSuite {
+			t.Errorf("expected TLS version %v and cipher suite %v, got: %v and %v",
+				tls.VersionName(expVersion), tls.CipherSuiteName(expCipherSuite),
+				tls.VersionName(res.TLS.Version), tls.CipherSuiteName(res.TLS.CipherSuite))
+		}
+
+		// The body was already read.
+		res.Body = http.NoBody
+
+		resLog, err := reqlog.ParseHTTPResponse(res)
+		if err != nil {
+			t.Fatalf("unexpected error parsing response: %v", err)
+		}
+
+		if resLog.Timing == nil || *resLog.Timing != got {
+			t.Errorf("expected response log timing %+v, got: %+v", got, resLog.Timing)
+		}
+
+		if resLog.TLS == nil ||
+			resLog.TLS.Version != tls.VersionName(expVersion) ||
+			resLog.TLS.CipherSuite != tls.CipherSuiteName(expCipherSuite) ||
+			resLog.TLS.ServerName != "example.com" {
+			t.Errorf("unexpected response log TLS info: %+v", resLog.TLS)
+		}
+	})
+
+	t.Run("reused connection", func(t *testing.T) {
+		res := get(t)
+
+		got, ok := timing.FromContext(res.Request.Context())
+		if !ok {
+			t.Fatal("expected timing in context")
+		}
+
+		if got.DNS != 0 || got.Connect != 0 || got.TLSHandshake != 0 {
+			t.Errorf("expected no DNS, connect and TLS handshake phases, got: %+v", got)
+		}
+
+		if got.TimeToFirstByte < serverDelay || got.Total < got.TimeToFirstByte {
+			t.Errorf("unexpected timing: %+v", got)
+		}
+	})
+}
+
+func TestFromContextWithoutTiming(t *testing.T) {
+	t.Parallel()
+
+	if _, ok := timing.FromContext(context.Background()); ok {
+		t.Error("expected no timing in context")
+	}
+}
@@ -0,0 +1,58 @@
+package proxy
+
+import "net"
+
+// ServeTransparent accepts raw TLS and plaintext HTTP connections on the
+// listener `l`, e.g. traffic that is redirected to Hetty with iptables, from
+// clients that aren't configured to use a proxy. Connections are routed by the
+// server name (SNI) from the TLS ClientHello, or the `Host` header of requests.
+// When a client sends neither (e.g. a TLS client without SNI, or an HTTP/1.0
+// request without `Host` header), the original destination of the connection
+// is used. This is looked up with `SO_ORIGINAL_DST` (Linux only) for
+// connections that were redirected with NAT (e.g. an iptables `REDIRECT`
+// target), or is the local address of connections that were redirected
+// without NAT (e.g. with TPROXY). It blocks until `l` is closed, or accepting a
+// connection fails.
+//
+// Note: Outgoing connections made by the proxy itself must not be redirected
+// back to the listener, to prevent loops.
+func (p *Proxy) ServeTransparent(l net.Listener) error {
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			return err
+		}
+
+		go p.serveTunnel(conn, originalDestination(conn, l.Addr()))
+	}
+}
+
+// originalDestination returns the address that a redirected connection was
+// originally destined for, or an empty string if the connection wasn't
+// redirected, or its original destination is unknown.
+func originalDestination(conn net.Conn, listenAddr net.Addr) string {
+	if addr, err := originalDst(conn); err == nil && !isListenAddr(addr, listenAddr) {
+		return addr.String()
+	}
+
+	if addr, ok := conn.LocalAddr().(*net.TCPAddr); ok && !isListenAddr(addr, listenAddr) {
+		return addr.String()
+	}
+
+	return ""
+}
+
+// isListenAddr returns true if `addr` is (one of) the address(es) of a listener
+// on `listenAddr`, i.e. a connection to it wasn't redirected.
+func isListenAddr(addr *net.TCPAddr, listenAddr net.Addr) bool {
+	l, ok := listenAddr.(*net.TCPAddr)
+	if !ok {
+		return true
+	}
+
+	if addr.Port != l.Port {
+		return false
+	}
+
+	return l.IP == nil || l.IP.IsUnspecified() || l.IP.Equal(addr.IP)
+}
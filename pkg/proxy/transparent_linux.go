@@ -0,0 +1,82 @@
+//go:build linux
+
+package proxy
+
+import (
+	"errors"
+	"net"
+	"syscall"
+	"unsafe"
+)
+
+// soOriginalDst is the socket option (`SO_ORIGINAL_DST` and
+// `IP6T_SO_ORIGINAL_DST`) for the original destination of a connection that
+// was redirected with netfilter, e.g. with an iptables `REDIRECT` target.
+const soOriginalDst = 80
+
+// originalDst returns the original destination of a connection that was
+// redirected with netfilter.
+func originalDst(conn net.Conn) (*net.TCPAddr, error) {
+	tcpConn, ok := conn.(*net.TCPConn)
+	if !ok {
+		return nil, errors.New("proxy: not a TCP connection")
+	}
+
+	rawConn, err := tcpConn.SyscallConn()
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		addr   *net.TCPAddr
+		optErr error
+	)
+
+	local, _ := conn.LocalAddr().(*net.TCPAddr)
+	isIPv6 := local != nil && local.IP.To4() == nil
+
+	// The syscall package has no getsockopt for socket addresses, so structs of
+	// (at least) the size of `sockaddr_in` and `sockaddr_in6` are used instead.
+	err = rawConn.Control(func(fd uintptr) {
+		if isIPv6 {
+			var info *syscall.IPv6MTUInfo
+
+			info, optErr = syscall.GetsockoptIPv6MTUInfo(int(fd), syscall.SOL_IPV6, soOriginalDst)
+			if optErr != nil {
+				return
+			}
+
+			// The port is in network byte order.
+			port := (*[2]byte)(unsafe.Pointer(&info.Addr.Port))
+			addr = &net.TCPAddr{
+				IP:   net.IP(append([]byte(nil), info.Addr.Addr[:]...)),
+				Port: int(port[0])<<8 | int(port[1]),
+			}
+
+			return
+		}
+
+		var mreq *syscall.IPv6Mreq
+
+		mreq, optErr = syscall.GetsockoptIPv6Mreq(int(fd), syscall.SOL_IP, soOriginalDst)
+		if optErr != nil {
+			return
+		}
+
+		// `sockaddr_in`: family (2 bytes), port (2 bytes) and IPv4 address.
+		sa := mreq.Multiaddr
+		addr = &net.TCPAddr{
+			IP:   net.IPv4(sa[4], sa[5], sa[6], sa[7]),
+			Port: int(sa[2])<<8 | int(sa[3]),
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if optErr != nil {
+		return nil, optErr
+	}
+
+	return addr, nil
+}
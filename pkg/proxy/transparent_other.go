@@ -0,0 +1,14 @@
+//go:build !linux
+
+package proxy
+
+import (
+	"errors"
+	"net"
+)
+
+// originalDst returns the original destination of a redirected connection,
+// which is only supported on Linux.
+func originalDst(net.Conn) (*net.TCPAddr, error) {
+	return nil, errors.ErrUnsupported
+}
@@ -0,0 +1,354 @@
+package proxy
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"golang.org/x/net/http2"
+)
+
+// newRoutingProxyForTest returns a proxy that answers requests locally, so
+// only the routing of requests is tested, without connecting to servers. The
+// returned function returns the URL of the last request.
+func newRoutingProxyForTest(t *testing.T) (*Proxy, func() string) {
+	t.Helper()
+
+	p := newProxyForTest(t, Config{})
+
+	var (
+		reqURLs []string
+		mu      sync.Mutex
+	)
+
+	err := p.UseRequestModifier("test", func(next RequestModifyFunc) RequestModifyFunc {
+		return func(req *http.Request) {
+			mu.Lock()
+			reqURLs = append(reqURLs, req.URL.String())
+			mu.Unlock()
+
+			*req = *req.WithContext(WithLocalResponse(req.Context(), func(req *http.Request) (*http.Response, error) {
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Proto:      "HTTP/1.1",
+					ProtoMajor: 1,
+					ProtoMinor: 1,
+					Header:     http.Header{},
+					Body:       io.NopCloser(strings.NewReader(req.URL.String())),
+				}, nil
+			}))
+
+			next(req)
+		}
+	})
+	if err != nil {
+		t.Fatalf("unexpected error adding request modifier: %v", err)
+	}
+
+	lastReqURL := func() string {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if len(reqURLs) == 0 {
+			return ""
+		}
+
+		return reqURLs[len(reqURLs)-1]
+	}
+
+	return p, lastReqURL
+}
+
+func roundTripForTest(t *testing.T, conn net.Conn, req *http.Request) *http.Response {
+	t.Helper()
+
+	if err := req.Write(conn); err != nil {
+		t.Fatalf("unexpected error writing request: %v", err)
+	}
+
+	res, err := http.ReadResponse(bufio.NewReader(conn), req)
+	if err != nil {
+		t.Fatalf("unexpected error reading response: %v", err)
+	}
+
+	t.Cleanup(func() { res.Body.Close() })
+
+	return res
+}
+
+func TestServeTransparent(t *testing.T) {
+	t.Parallel()
+
+	p, lastReqURL := newRoutingProxyForTest(t)
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+	defer l.Close()
+
+	go func() {
+		_ = p.ServeTransparent(l)
+	}()
+
+	rootCAs := x509.NewCertPool()
+	rootCAs.AddCert(p.certConfig.ca)
+
+	t.Run("HTTP", func(t *testing.T) {
+		conn, err := net.Dial("tcp", l.Addr().String())
+		if err != nil {
+			t.Fatalf("unexpected error dialing: %v", err)
+		}
+		defer conn.Close()
+
+		req, err := http.NewRequest(http.MethodGet, "http://example.com/foo", nil)
+		if err != nil {
+			t.Fatalf("failed to create request: %v", err)
+		}
+
+		res := roundTripForTest(t, conn, req)
+
+		if res.StatusCode != http.StatusOK {
+			t.Errorf("expected status code %v, got: %v", http.StatusOK, res.StatusCode)
+		}
+
+		if got := lastReqURL(); got != "http://example.com/foo" {
+			t.Errorf("expected request URL `http://example.com/foo`, got: %q", got)
+		}
+	})
+
+	t.Run("HTTP without host", func(t *testing.T) {
+		conn, err := net.Dial("tcp", l.Addr().String())
+		if err != nil {
+			t.Fatalf("unexpected error dialing: %v", err)
+		}
+		defer conn.Close()
+
+		_, err = io.WriteString(conn, "GET /foo HTTP/1.0\r\n\r\n")
+		if err != nil {
+			t.Fatalf("unexpected error writing request: %v", err)
+		}
+
+		res, err := http.ReadResponse(bufio.NewReader(conn), nil)
+		if err != nil {
+			t.Fatalf("unexpected error reading response: %v", err)
+		}
+		defer res.Body.Close()
+
+		if res.StatusCode != http.StatusBadRequest {
+			t.Errorf("expected status code %v, got: %v", http.StatusBadRequest, res.StatusCode)
+		}
+	})
+
+	testTLS := func(t *testing.T) {
+		conn, err := tls.Dial("tcp", l.Addr().String(), &tls.Config{
+			ServerName: "api.example.com",
+			RootCAs:    rootCAs,
+			NextProtos: []string{"http/1.1"},
+		})
+		if err != nil {
+			t.Fatalf("unexpected error dialing: %v", err)
+		}
+		defer conn.Close()
+
+		req, err := http.NewRequest(http.MethodGet, "https://api.example.com/bar", nil)
+		if err != nil {
+			t.Fatalf("failed to create request: %v", err)
+		}
+
+		res := roundTripForTest(t, conn, req)
+
+		if res.StatusCode != http.StatusOK {
+			t.Errorf("expected status code %v, got: %v", http.StatusOK, res.StatusCode)
+		}
+
+		if got := lastReqURL(); got != "https://api.example.com/bar" {
+			t.Errorf("expected request URL `https://api.example.com/bar`, got: %q", got)
+		}
+	}
+
+	t.Run("TLS", testTLS)
+
+	t.Run("HTTP/2", func(t *testing.T) {
+		transport := &http2.Transport{
+			TLSClientConfig: &tls.Config{RootCAs: rootCAs},
+			DialTLSContext: func(ctx context.Context, network, _ string, cfg *tls.Config) (net.Conn, error) {
+				dialer := &tls.Dialer{Config: cfg}
+				return dialer.DialContext(ctx, network, l.Addr().String())
+			},
+		}
+		defer transport.CloseIdleConnections()
+
+		res, err := (&http.Client{Transport: transport}).Get("https://www.example.com/baz")
+		if err != nil {
+			t.Fatalf("unexpected error sending request: %v", err)
+		}
+		defer res.Body.Close()
+
+		if res.ProtoMajor != 2 {
+			t.Errorf("expected HTTP/2 response, got: %v", res.Proto)
+		}
+
+		if got := lastReqURL(); got != "https://www.example.com/baz" {
+			t.Errorf("expected request URL `https://www.example.com/baz`, got: %q", got)
+		}
+	})
+
+	// With passthrough rules, the ClientHello is sniffed before it's handled,
+	// and must be replayed for connections that are intercepted.
+	t.Run("TLS with passthrough rules", func(t *testing.T) {
+		p.UpdateSettings(Settings{
+			TLSPassthrough: []PassthroughRule{{HostGlob: "*.apple.com"}},
+		})
+
+		testTLS(t)
+	})
+}
+
+// Without SNI and `Host` header, requests are routed to the original
+// destination of a redirected connection.
+func TestServeTunnelOriginalDestination(t *testing.T) {
+	t.Parallel()
+
+	p, lastReqURL := newRoutingProxyForTest(t)
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+	defer l.Close()
+
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+
+			go p.serveTunnel(conn, "192.0.2.1:8443")
+		}
+	}()
+
+	t.Run("HTTP without host", func(t *testing.T) {
+		conn, err := net.Dial("tcp", l.Addr().String())
+		if err != nil {
+			t.Fatalf("unexpected error dialing: %v", err)
+		}
+		defer conn.Close()
+
+		_, err = io.WriteString(conn, "GET /foo HTTP/1.0\r\n\r\n")
+		if err != nil {
+			t.Fatalf("unexpected error writing request: %v", err)
+		}
+
+		res, err := http.ReadResponse(bufio.NewReader(conn), nil)
+		if err != nil {
+			t.Fatalf("unexpected error reading response: %v", err)
+		}
+		defer res.Body.Close()
+
+		if res.StatusCode != http.StatusOK {
+			t.Errorf("expected status code %v, got: %v", http.StatusOK, res.StatusCode)
+		}
+
+		if got := lastReqURL(); got != "http://192.0.2.1:8443/foo" {
+			t.Errorf("expected request URL `http://192.0.2.1:8443/foo`, got: %q", got)
+		}
+	})
+
+	t.Run("TLS without SNI", func(t *testing.T) {
+		rootCAs := x509.NewCertPool()
+		rootCAs.AddCert(p.certConfig.ca)
+
+		// Without server name, the certificate is verified for the IP address
+		// of the original destination.
+		conn, err := tls.Dial("tcp", l.Addr().String(), &tls.Config{
+			NextProtos:         []string{"http/1.1"},
+			InsecureSkipVerify: true, //nolint:gosec
+			VerifyConnection: func(cs tls.ConnectionState) error {
+				_, err := cs.PeerCertificates[0].Verify(x509.VerifyOptions{
+					DNSName: "192.0.2.1",
+					Roots:   rootCAs,
+				})
+				return err
+			},
+		})
+		if err != nil {
+			t.Fatalf("unexpected error dialing: %v", err)
+		}
+		defer conn.Close()
+
+		_, err = io.WriteString(conn, "GET /bar HTTP/1.0\r\n\r\n")
+		if err != nil {
+			t.Fatalf("unexpected error writing request: %v", err)
+		}
+
+		res, err := http.ReadResponse(bufio.NewReader(conn), nil)
+		if err != nil {
+			t.Fatalf("unexpected error reading response: %v", err)
+		}
+		defer res.Body.Close()
+
+		if got := lastReqURL(); got != "https://192.0.2.1:8443/bar" {
+			t.Errorf("expected request URL `https://192.0.2.1:8443/bar`, got: %q", got)
+		}
+	})
+}
+
+func TestOriginalDestination(t *testing.T) {
+	t.Parallel()
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+	defer l.Close()
+
+	conns := make(chan net.Conn, 1)
+
+	go func() {
+		conn, err := l.Accept()
+		if err != nil {
+			close(conns)
+			return
+		}
+
+		conns <- conn
+	}()
+
+	clientConn, err := net.Dial("tcp", l.Addr().String())
+	if err != nil {
+		t.Fatalf("unexpected error dialing: %v", err)
+	}
+	defer clientConn.Close()
+
+	conn, ok := <-conns
+	if !ok {
+		t.Fatal("failed to accept connection")
+	}
+	defer conn.Close()
+
+	// Connections directly to the listener weren't redirected.
+	if got := originalDestination(conn, l.Addr()); got != "" {
+		t.Errorf("expected no original destination, got: %q", got)
+	}
+
+	unspecified := &net.TCPAddr{IP: net.IPv4zero, Port: l.Addr().(*net.TCPAddr).Port}
+	if got := originalDestination(conn, unspecified); got != "" {
+		t.Errorf("expected no original destination for unspecified listen address, got: %q", got)
+	}
+
+	// Connections that were redirected without NAT (e.g. TPROXY) are destined
+	// for another address than the listener's.
+	other := &net.TCPAddr{IP: net.IPv4zero, Port: 1}
+	if got, exp := originalDestination(conn, other), conn.LocalAddr().String(); got != exp {
+		t.Errorf("expected original destination %q, got: %q", exp, got)
+	}
+}
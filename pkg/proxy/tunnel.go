@@ -0,0 +1,204 @@
+package proxy
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/tls"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"time"
+
+	"golang.org/x/net/http2"
+)
+
+// tlsRecordTypeHandshake is the first byte of a TLS ClientHello.
+const tlsRecordTypeHandshake = 0x16
+
+// sniffTimeout is how long a client gets to send its TLS ClientHello or
+// request header, when these are sniffed for passthrough rules.
+const sniffTimeout = 10 * time.Second
+
+// serveTunnel sniffs whether the client speaks TLS or plaintext HTTP on conn,
+// and serves its requests via the proxy. The optional `target` (host:port) is
+// used when the client doesn't send a server name (SNI) or `Host` header.
+// Connections to hosts that match a passthrough rule are relayed as-is.
+func (p *Proxy) serveTunnel(conn net.Conn, target string) {
+	br := bufio.NewReader(conn)
+
+	first, err := br.Peek(1)
+	if err != nil {
+		conn.Close()
+		return
+	}
+
+	isTLS := first[0] == tlsRecordTypeHandshake
+	clientConn := &peekedConn{Conn: conn, r: br}
+
+	if len(p.Settings().TLSPassthrough) > 0 {
+		// Bytes read while sniffing are replayed, both for passthrough tunnels
+		// and intercepted connections.
+		var sniffed bytes.Buffer
+
+		_ = conn.SetReadDeadline(time.Now().Add(sniffTimeout))
+		host := sniffHost(io.TeeReader(br, &sniffed), isTLS)
+		_ = conn.SetReadDeadline(time.Time{})
+
+		clientConn.r = io.MultiReader(&sniffed, br)
+
+		if host != "" {
+			hostport := tunnelHostport(host, target, isTLS)
+
+			if p.isPassthrough(hostport) {
+				p.handleTunnelPassthrough(clientConn, hostport)
+				return
+			}
+		}
+	}
+
+	if !isTLS {
+		serveHTTPConn(clientConn, p.tunnelHandler("http", target))
+		return
+	}
+
+	host, _, _ := net.SplitHostPort(target)
+
+	tlsConn, err := p.clientTLSConn(clientConn, host)
+	if err != nil {
+		p.logger.Errorw("Securing client connection failed.",
+			"error", err,
+			"remoteAddr", conn.RemoteAddr().String())
+
+		return
+	}
+
+	if tlsConn.ConnectionState().NegotiatedProtocol == http2.NextProtoTLS {
+		// Blocks until the client closes the connection.
+		p.h2Server.ServeConn(tlsConn, &http2.ServeConnOpts{Handler: p.tunnelHandler("https", target)})
+		tlsConn.Close()
+
+		return
+	}
+
+	serveHTTPConn(tlsConn, p.tunnelHandler("https", target))
+}
+
+func (p *Proxy) handleTunnelPassthrough(clientConn net.Conn, hostport string) {
+	serverConn, err := p.upstream.DialContext(context.Background(), "tcp", hostport)
+	if err != nil {
+		p.logger.Errorw("Dialing passthrough tunnel host failed.",
+			"error", err,
+			"host", hostport)
+		clientConn.Close()
+
+		return
+	}
+
+	p.relayPassthrough(clientConn, serverConn, hostport)
+}
+
+// sniffHost returns the server name (SNI) of a TLS ClientHello, or the `Host`
+// header of a plaintext HTTP request, read from `r`. It returns an empty string
+// if neither could be read.
+func sniffHost(r io.Reader, isTLS bool) string {
+	if !isTLS {
+		req, err := http.ReadRequest(bufio.NewReader(r))
+		if err != nil {
+			return ""
+		}
+
+		return req.Host
+	}
+
+	var serverName string
+
+	// The handshake is aborted as soon as the ClientHello is parsed.
+	_ = tls.Server(readOnlyConn{r: r}, &tls.Config{
+		GetConfigForClient: func(hello *tls.ClientHelloInfo) (*tls.Config, error) {
+			serverName = hello.ServerName
+			return nil, errSniffed
+		},
+	}).Handshake()
+
+	return serverName
+}
+
+var errSniffed = errors.New("proxy: sniffed client hello")
+
+// tunnelHostport returns the address of the server of a tunnel, for a sniffed
+// host. When the host has no port, the port of the tunnel target is used, or
+// else the default port of the protocol.
+func tunnelHostport(host, target string, isTLS bool) string {
+	if _, _, err := net.SplitHostPort(host); err == nil {
+		return host
+	}
+
+	if _, port, err := net.SplitHostPort(target); err == nil {
+		return net.JoinHostPort(host, port)
+	}
+
+	if isTLS {
+		return net.JoinHostPort(host, "443")
+	}
+
+	return net.JoinHostPort(host, "80")
+}
+
+// tunnelHandler returns a handler for requests that are read from a tunnel to
+// `target`. Because these requests typically have a Request-URI in origin form
+// (e.g. `/foo`), the request URL is completed using the `Host` header, or the
+// tunnel target (if known) when the header is missing.
+func (p *Proxy) tunnelHandler(scheme, target string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Scheme == "" {
+			r.URL.Scheme = scheme
+			r.URL.Host = r.Host
+
+			if r.URL.Host == "" {
+				r.URL.Host = target
+				r.Host = target
+			}
+		}
+
+		if r.URL.Host == "" {
+			writeError(w, http.StatusBadRequest)
+			return
+		}
+
+		p.ServeHTTP(w, r)
+	})
+}
+
+// serveHTTPConn serves HTTP/1.x requests read from conn. It doesn't block; the
+// connection is closed by the HTTP server when it's done.
+func serveHTTPConn(conn net.Conn, handler http.Handler) {
+	_ = http.Serve(&OnceAcceptListener{conn}, handler)
+}
+
+// peekedConn is a net.Conn that first reads data that was buffered while
+// sniffing the protocol of the connection.
+type peekedConn struct {
+	net.Conn
+	r io.Reader
+}
+
+func (c *peekedConn) Read(p []byte) (int, error) {
+	return c.r.Read(p)
+}
+
+// readOnlyConn is a net.Conn that can only be read from. It's used for parsing
+// a TLS ClientHello without responding to it.
+type readOnlyConn struct {
+	r io.Reader
+}
+
+func (c readOnlyConn) Read(p []byte) (int, error)         { return c.r.Read(p) }
+func (c readOnlyConn) Write(p []byte) (int, error)        { return 0, io.ErrClosedPipe }
+func (c readOnlyConn) Close() error                       { return nil }
+func (c readOnlyConn) LocalAddr() net.Addr                { return nil }
+func (c readOnlyConn) RemoteAddr() net.Addr               { return nil }
+func (c readOnlyConn) SetDeadline(_ time.Time) error      { return nil }
+func (c readOnlyConn) SetReadDeadline(_ time.Time) error  { return nil }
+func (c readOnlyConn) SetWriteDeadline(_ time.Time) error { return nil }
@@ -0,0 +1,288 @@
+// Package upstream provides dialing and proxy selection for outgoing
+// connections, optionally chained through an upstream HTTP(S) or SOCKS5 proxy.
+package upstream
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/net/proxy"
+)
+
+// Type represents the protocol used for connecting to an upstream proxy.
+type Type string
+
+const (
+	TypeHTTP   Type = "http"
+	TypeHTTPS  Type = "https"
+	TypeSOCKS5 Type = "socks5"
+)
+
+var (
+	ErrInvalidType = errors.New("upstream: invalid proxy type")
+	ErrInvalidAddr = errors.New("upstream: invalid proxy address")
+)
+
+// Settings represents the configuration of an upstream proxy. When `Addr` is
+// empty, connections are made directly (or via proxies from the environment,
+// e.g. `HTTPS_PROXY`).
+type Settings struct {
+	Type     Type
+	Addr     string
+	Username string
+	Password string
+
+	// Bypass is a list of host patterns (e.g. `localhost`, `*.example.com`),
+	// that are matched against the hostname of outgoing requests. Matching
+	// requests are not sent via the upstream proxy.
+	Bypass []string
+}
+
+// Dialer dials outgoing connections, and selects the proxy to use for HTTP
+// requests, based on its (live updatable) settings. It's safe for concurrent
+// use.
+type Dialer struct {
+	netDialer *net.Dialer
+	settings  Settings
+	mu        sync.RWMutex
+}
+
+// NewDialer returns a new Dialer, that by default connects directly.
+func NewDialer() *Dialer {
+	return &Dialer{
+		// Values taken from `http.DefaultTransport`.
+		netDialer: &net.Dialer{
+			Timeout:   30 * time.Second,
+			KeepAlive: 30 * time.Second,
+		},
+	}
+}
+
+// Validate returns an error if the settings can't be used for dialing.
+func (s Settings) Validate() error {
+	if s.Addr == "" {
+		return nil
+	}
+
+	switch s.Type {
+	case TypeHTTP, TypeHTTPS, TypeSOCKS5:
+	default:
+		return ErrInvalidType
+	}
+
+	if _, _, err := net.SplitHostPort(s.Addr); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidAddr, err)
+	}
+
+	for _, pattern := range s.Bypass {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("upstream: invalid bypass pattern %q: %w", pattern, err)
+		}
+	}
+
+	return nil
+}
+
+// Enabled returns true if an upstream proxy is configured.
+func (s Settings) Enabled() bool {
+	return s.Addr != ""
+}
+
+// URL returns the URL of the upstream proxy, including credentials.
+func (s Settings) URL() *url.URL {
+	u := &url.URL{
+		Scheme: string(s.Type),
+		Host:   s.Addr,
+	}
+
+	if s.Username != "" || s.Password != "" {
+		u.User = url.UserPassword(s.Username, s.Password)
+	}
+
+	return u
+}
+
+// Bypassed returns true if `host` (with or without port) matches any of the
+// bypass patterns.
+func (s Settings) Bypassed(host string) bool {
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+
+	host = strings.ToLower(host)
+
+	for _, pattern := range s.Bypass {
+		if match, _ := path.Match(strings.ToLower(pattern), host); match {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (d *Dialer) UpdateSettings(settings Settings) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.settings = settings
+}
+
+func (d *Dialer) Settings() Settings {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	return d.settings
+}
+
+// Proxy returns the URL of the proxy to use for a given request. It can be
+// used as `http.Transport.Proxy`. When no upstream proxy is configured, it
+// falls back to `http.ProxyFromEnvironment`.
+func (d *Dialer) Proxy(req *http.Request) (*url.URL, error) {
+	settings := d.Settings()
+
+	if !settings.Enabled() {
+		return http.ProxyFromEnvironment(req)
+	}
+
+	if settings.Bypassed(req.URL.Host) {
+		return nil, nil
+	}
+
+	return settings.URL(), nil
+}
+
+// DialContext connects to `addr`, tunneled via the upstream proxy if one is
+// configured and `addr` isn't bypassed. It can be used for connections that
+// aren't made by an `http.Transport`, e.g. raw TCP tunnels.
+func (d *Dialer) DialContext(ctx context.Context, network, addr string) (net.Conn, error) {
+	settings := d.Settings()
+
+	if !settings.Enabled() || settings.Bypassed(addr) {
+		return d.netDialer.DialContext(ctx, network, addr)
+	}
+
+	switch settings.Type {
+	case TypeSOCKS5:
+		return d.dialSOCKS5(ctx, settings, network, addr)
+	case TypeHTTP, TypeHTTPS:
+		return d.dialHTTPConnect(ctx, settings, addr)
+	default:
+		return nil, ErrInvalidType
+	}
+}
+
+func (d *Dialer) dialSOCKS5(ctx context.Context, settings Settings, network, addr string) (net.Conn, error) {
+	var auth *proxy.Auth
+	if settings.Username != "" || settings.Password != "" {
+		auth = &proxy.Auth{
+			User:     settings.Username,
+			Password: settings.Password,
+		}
+	}
+
+	dialer, err := proxy.SOCKS5("tcp", settings.Addr, auth, d.netDialer)
+	if err != nil {
+		return nil, fmt.Errorf("upstream: failed to create SOCKS5 dialer: %w", err)
+	}
+
+	//nolint:forcetypeassert
+	conn, err := dialer.(proxy.ContextDialer).DialContext(ctx, network, addr)
+	if err != nil {
+		return nil, fmt.Errorf("upstream: failed to dial via SOCKS5 proxy: %w", err)
+	}
+
+	return conn, nil
+}
+
+// dialHTTPConnect sets up a tunnel to `addr` using the HTTP CONNECT method.
+func (d *Dialer) dialHTTPConnect(ctx context.Context, settings Settings, addr string) (net.Conn, error) {
+	conn, err := d.netDialer.DialContext(ctx, "tcp", settings.Addr)
+	if err != nil {
+		return nil, fmt.Errorf("upstream: failed to dial HTTP proxy: %w", err)
+	}
+
+	if settings.Type == TypeHTTPS {
+		host, _, _ := net.SplitHostPort(settings.Addr)
+		tlsConn := tls.Client(conn, &tls.Config{
+			ServerName: host,
+			MinVersion: tls.VersionTLS12,
+		})
+
+		if err := tlsConn.HandshakeContext(ctx); err != nil {
+			conn.Close()
+			return nil, fmt.Errorf("upstream: TLS handshake with HTTPS proxy failed: %w", err)
+		}
+
+		conn = tlsConn
+	}
+
+	connectReq := &http.Request{
+		Method: http.MethodConnect,
+		URL:    &url.URL{Opaque: addr},
+		Host:   addr,
+		Header: make(http.Header),
+	}
+
+	if settings.Username != "" || settings.Password != "" {
+		creds := base64.StdEncoding.EncodeToString([]byte(settings.Username + ":" + settings.Password))
+		connectReq.Header.Set("Proxy-Authorization", "Basic "+creds)
+	}
+
+	// Abort the handshake with the proxy when the context is done.
+	stop := context.AfterFunc(ctx, func() {
+		_ = conn.SetDeadline(time.Now())
+	})
+	defer stop()
+
+	if err := connectReq.Write(conn); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("upstream: failed to write CONNECT request: %w", err)
+	}
+
+	br := bufio.NewReader(conn)
+
+	res, err := http.ReadResponse(br, connectReq)
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("upstream: failed to read CONNECT response: %w", err)
+	}
+	res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		conn.Close()
+		return nil, fmt.Errorf("upstream: proxy refused CONNECT: %v", res.Status)
+	}
+
+	if !stop() {
+		conn.Close()
+		return nil, ctx.Err()
+	}
+
+	if br.Buffered() > 0 {
+		return &bufferedConn{Conn: conn, r: br}, nil
+	}
+
+	return conn, nil
+}
+
+// bufferedConn is a net.Conn that first reads data that was buffered while
+// reading the CONNECT response.
+type bufferedConn struct {
+	net.Conn
+	r *bufio.Reader
+}
+
+func (c *bufferedConn) Read(p []byte) (int, error) {
+	return c.r.Read(p)
+}
@@ -0,0 +1,537 @@
+package proxy
+
+import (
+	"bufio"
+	"context"
+	"crypto/rand"
+	"crypto/tls"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/oklog/ulid"
+)
+
+// WebSocketOpcode represents the opcode of a WebSocket frame.
+// See: https://datatracker.ietf.org/doc/html/rfc6455#section-5.2
+type WebSocketOpcode byte
+
+const (
+	WebSocketOpContinuation WebSocketOpcode = 0x0
+	WebSocketOpText         WebSocketOpcode = 0x1
+	WebSocketOpBinary       WebSocketOpcode = 0x2
+	WebSocketOpClose        WebSocketOpcode = 0x8
+	WebSocketOpPing         WebSocketOpcode = 0x9
+	WebSocketOpPong         WebSocketOpcode = 0xA
+)
+
+// maxWebSocketPayloadSize is the upper boundary for the payload size of a
+// single WebSocket message (after defragmentation).
+const maxWebSocketPayloadSize = 32 << 20
+
+var (
+	ErrWebSocketConnNotFound     = errors.New("proxy: websocket connection not found")
+	ErrWebSocketPayloadTooLarge  = errors.New("proxy: websocket payload too large")
+	ErrWebSocketInvalidOpcode    = errors.New("proxy: invalid websocket opcode")
+	ErrWebSocketUnexpectedOpcode = errors.New("proxy: unexpected websocket continuation frame")
+)
+
+// WebSocketMessage represents a WebSocket data or close message, relayed
+// between client and server. Fragmented messages are reassembled before they
+// are passed to modifiers, and are relayed as a single frame.
+type WebSocketMessage struct {
+	ID         ulid.ULID
+	RequestID  ulid.ULID
+	Opcode     WebSocketOpcode
+	Payload    []byte
+	FromClient bool
+}
+
+type webSocketFrame struct {
+	fin     bool
+	opcode  WebSocketOpcode
+	payload []byte
+}
+
+// webSocketConn represents an established WebSocket connection, relayed by
+// the proxy.
+type webSocketConn struct {
+	reqID      ulid.ULID
+	ctx        context.Context
+	clientConn net.Conn
+	serverConn net.Conn
+	clientMu   sync.Mutex
+	serverMu   sync.Mutex
+}
+
+func (op WebSocketOpcode) String() string {
+	switch op {
+	case WebSocketOpContinuation:
+		return "continuation"
+	case WebSocketOpText:
+		return "text"
+	case WebSocketOpBinary:
+		return "binary"
+	case WebSocketOpClose:
+		return "close"
+	case WebSocketOpPing:
+		return "ping"
+	case WebSocketOpPong:
+		return "pong"
+	default:
+		return fmt.Sprintf("unknown (%#x)", byte(op))
+	}
+}
+
+// IsControl returns true if the opcode is used for control frames.
+func (op WebSocketOpcode) IsControl() bool {
+	return op >= WebSocketOpClose
+}
+
+// SendWebSocketMessage sends a message on an established WebSocket connection,
+// identified by the ID of the request that initiated the handshake. The message
+// is passed through WebSocket modifiers first, as if it was sent by the client
+// (`msg.FromClient`) or the server.
+func (p *Proxy) SendWebSocketMessage(reqID ulid.ULID, msg WebSocketMessage) (WebSocketMessage, error) {
+	p.wsConnsMu.RLock()
+	conn, ok := p.wsConns[reqID]
+	p.wsConnsMu.RUnlock()
+
+	if !ok {
+		return WebSocketMessage{}, ErrWebSocketConnNotFound
+	}
+
+	if msg.Opcode != WebSocketOpText && msg.Opcode != WebSocketOpBinary && msg.Opcode != WebSocketOpClose {
+		return WebSocketMessage{}, ErrWebSocketInvalidOpcode
+	}
+
+	msg.ID = ulid.MustNew(ulid.Timestamp(time.Now()), ulidEntropy)
+	msg.RequestID = reqID
+
+	if err := p.modifyWebSocketMessage(conn.ctx, &msg); err != nil {
+		return WebSocketMessage{}, fmt.Errorf("proxy: websocket message was dropped: %w", err)
+	}
+
+	if err := conn.writeMessage(msg); err != nil {
+		return WebSocketMessage{}, fmt.Errorf("proxy: failed to write websocket message: %w", err)
+	}
+
+	return msg, nil
+}
+
+func (p *Proxy) modifyWebSocketMessage(ctx context.Context, msg *WebSocketMessage) error {
+	fn := nopWSModifier
+	modifiers := p.modifiers.enabled(ModifierKindWebSocket)
+
+	for i := len(modifiers) - 1; i >= 0; i-- {
+		fn = modifiers[i].ws(fn)
+	}
+
+	return fn(ctx, msg)
+}
+
+func isWebSocketUpgrade(r *http.Request) bool {
+	if !strings.EqualFold(r.Header.Get("Upgrade"), "websocket") {
+		return false
+	}
+
+	for _, value := range r.Header.Values("Connection") {
+		for _, token := range strings.Split(value, ",") {
+			if strings.EqualFold(strings.TrimSpace(token), "upgrade") {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// handleWebSocket proxies a WebSocket handshake, and when the upstream server
+// agrees on switching protocols, relays messages between client and server
+// until either side closes the connection.
+func (p *Proxy) handleWebSocket(w http.ResponseWriter, r *http.Request) {
+	outreq := r.Clone(r.Context())
+	if r.ContentLength == 0 {
+		outreq.Body = nil
+	}
+
+	// Per-message compression would require inflating frames before they can be
+	// inspected, so the extension is never negotiated.
+	outreq.Header.Del("Sec-WebSocket-Extensions")
+
+	p.modifyRequest(outreq)
+
+	if err := outreq.Context().Err(); err != nil {
+		p.errorHandler(w, outreq, err)
+		return
+	}
+
+	serverConn, err := p.dialWebSocketServer(outreq.Context(), outreq.URL)
+	if err != nil {
+		p.errorHandler(w, outreq, err)
+		return
+	}
+	defer serverConn.Close()
+
+	if err := outreq.Write(serverConn); err != nil {
+		p.errorHandler(w, outreq, fmt.Errorf("failed to write websocket handshake request: %w", err))
+		return
+	}
+
+	serverBuf := bufio.NewReader(serverConn)
+
+	res, err := http.ReadResponse(serverBuf, outreq)
+	if err != nil {
+		p.errorHandler(w, outreq, fmt.Errorf("failed to read websocket handshake response: %w", err))
+		return
+	}
+	defer res.Body.Close()
+
+	if err := p.modifyResponse(res); err != nil {
+		p.errorHandler(w, outreq, err)
+		return
+	}
+
+	if res.StatusCode != http.StatusSwitchingProtocols {
+		for key, values := range res.Header {
+			w.Header()[key] = values
+		}
+
+		w.WriteHeader(res.StatusCode)
+
+		if _, err := io.Copy(w, res.Body); err != nil {
+			p.logger.Debugw("Failed to write websocket handshake response body.",
+				"error", err)
+		}
+
+		return
+	}
+
+	hj, ok := w.(http.Hijacker)
+	if !ok {
+		p.logger.Errorw("ResponseWriter is not a http.Hijacker.",
+			"type", fmt.Sprintf("%T", w))
+		writeError(w, http.StatusServiceUnavailable)
+
+		return
+	}
+
+	clientConn, clientBuf, err := hj.Hijack()
+	if err != nil {
+		p.logger.Errorw("Hijacking client connection failed.",
+			"error", err)
+
+		return
+	}
+	defer clientConn.Close()
+
+	fmt.Fprintf(clientBuf, "HTTP/1.1 %v\r\n", res.Status)
+	_ = res.Header.Write(clientBuf)
+	_, _ = clientBuf.WriteString("\r\n")
+
+	if err := clientBuf.Flush(); err != nil {
+		p.logger.Debugw("Failed to write websocket handshake response.",
+			"error", err)
+
+		return
+	}
+
+	reqID, _ := RequestIDFromContext(outreq.Context())
+
+	ctx, cancel := context.WithCancel(outreq.Context())
+	defer cancel()
+
+	conn := &webSocketConn{
+		reqID:      reqID,
+		ctx:        ctx,
+		clientConn: clientConn,
+		serverConn: serverConn,
+	}
+
+	p.wsConnsMu.Lock()
+	p.wsConns[reqID] = conn
+	p.wsConnsMu.Unlock()
+
+	defer func() {
+		p.wsConnsMu.Lock()
+		delete(p.wsConns, reqID)
+		p.wsConnsMu.Unlock()
+	}()
+
+	errc := make(chan error, 2)
+
+	go func() { errc <- p.relayWebSocketMessages(conn, clientBuf.Reader, true) }()
+	go func() { errc <- p.relayWebSocketMessages(conn, serverBuf, false) }()
+
+	err = <-errc
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, net.ErrClosed) {
+		p.logger.Debugw("WebSocket connection closed.",
+			"error", err)
+	}
+
+	// Closing both connections unblocks the remaining relay.
+	cancel()
+	clientConn.Close()
+	serverConn.Close()
+	<-errc
+}
+
+// dialWebSocketServer connects to the server of a WebSocket upgrade request,
+// tunneled via the upstream proxy if one is configured.
+func (p *Proxy) dialWebSocketServer(ctx context.Context, u *url.URL) (net.Conn, error) {
+	host := u.Host
+
+	switch u.Scheme {
+	case "https", "wss":
+		if u.Port() == "" {
+			host = net.JoinHostPort(u.Hostname(), "443")
+		}
+
+		conn, err := p.upstream.DialContext(ctx, "tcp", host)
+		if err != nil {
+			return nil, err
+		}
+
+		tlsConfig := &tls.Config{
+			ServerName: u.Hostname(),
+			NextProtos: []string{"http/1.1"},
+			MinVersion: tls.VersionTLS12,
+		}
+
+		if _, clientCert, ok := p.clientCerts.Match(host); ok {
+			tlsConfig.Certificates = []tls.Certificate{clientCert}
+		}
+
+		tlsConn := tls.Client(conn, tlsConfig)
+
+		if err := tlsConn.HandshakeContext(ctx); err != nil {
+			conn.Close()
+			return nil, err
+		}
+
+		return tlsConn, nil
+	default:
+		if u.Port() == "" {
+			host = net.JoinHostPort(u.Hostname(), "80")
+		}
+
+		return p.upstream.DialContext(ctx, "tcp", host)
+	}
+}
+
+// relayWebSocketMessages reads frames from r, and relays them to the opposite
+// side of the connection. It returns when reading or writing fails.
+func (p *Proxy) relayWebSocketMessages(conn *webSocketConn, r io.Reader, fromClient bool) error {
+	var msg *WebSocketMessage
+
+	for {
+		frame, err := readWebSocketFrame(r)
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case frame.opcode == WebSocketOpPing || frame.opcode == WebSocketOpPong:
+			// Control frames can be interleaved with fragments of a data message,
+			// so they are relayed as-is.
+			// See: https://datatracker.ietf.org/doc/html/rfc6455#section-5.4
+			if err := conn.writeFrame(frame, fromClient); err != nil {
+				return err
+			}
+
+			continue
+		case frame.opcode == WebSocketOpContinuation:
+			if msg == nil {
+				return ErrWebSocketUnexpectedOpcode
+			}
+
+			if len(msg.Payload)+len(frame.payload) > maxWebSocketPayloadSize {
+				return ErrWebSocketPayloadTooLarge
+			}
+
+			msg.Payload = append(msg.Payload, frame.payload...)
+		case frame.opcode.IsControl():
+			closeMsg := &WebSocketMessage{
+				Opcode:     frame.opcode,
+				Payload:    frame.payload,
+				FromClient: fromClient,
+			}
+
+			if err := p.relayWebSocketMessage(conn, closeMsg); err != nil {
+				return err
+			}
+
+			continue
+		default:
+			msg = &WebSocketMessage{
+				Opcode:     frame.opcode,
+				Payload:    frame.payload,
+				FromClient: fromClient,
+			}
+		}
+
+		if !frame.fin {
+			continue
+		}
+
+		if err := p.relayWebSocketMessage(conn, msg); err != nil {
+			return err
+		}
+
+		msg = nil
+	}
+}
+
+func (p *Proxy) relayWebSocketMessage(conn *webSocketConn, msg *WebSocketMessage) error {
+	msg.ID = ulid.MustNew(ulid.Timestamp(time.Now()), ulidEntropy)
+	msg.RequestID = conn.reqID
+
+	if err := p.modifyWebSocketMessage(conn.ctx, msg); err != nil {
+		p.logger.Debugw("Dropped websocket message.",
+			"id", msg.ID.String(),
+			"error", err)
+
+		return nil
+	}
+
+	return conn.writeMessage(*msg)
+}
+
+// writeMessage writes a message as a single frame to the server (when sent
+// by the client), or to the client.
+func (c *webSocketConn) writeMessage(msg WebSocketMessage) error {
+	return c.writeFrame(webSocketFrame{
+		fin:     true,
+		opcode:  msg.Opcode,
+		payload: msg.Payload,
+	}, msg.FromClient)
+}
+
+func (c *webSocketConn) writeFrame(frame webSocketFrame, toServer bool) error {
+	if toServer {
+		c.serverMu.Lock()
+		defer c.serverMu.Unlock()
+
+		// Frames sent from client to server must be masked.
+		return writeWebSocketFrame(c.serverConn, frame, true)
+	}
+
+	c.clientMu.Lock()
+	defer c.clientMu.Unlock()
+
+	return writeWebSocketFrame(c.clientConn, frame, false)
+}
+
+func readWebSocketFrame(r io.Reader) (webSocketFrame, error) {
+	var hdr [2]byte
+	if _, err := io.ReadFull(r, hdr[:]); err != nil {
+		return webSocketFrame{}, err
+	}
+
+	frame := webSocketFrame{
+		fin:    hdr[0]&0x80 != 0,
+		opcode: WebSocketOpcode(hdr[0] & 0x0f),
+	}
+
+	masked := hdr[1]&0x80 != 0
+	length := uint64(hdr[1] & 0x7f)
+
+	switch length {
+	case 126:
+		var ext [2]byte
+		if _, err := io.ReadFull(r, ext[:]); err != nil {
+			return webSocketFrame{}, err
+		}
+
+		length = uint64(binary.BigEndian.Uint16(ext[:]))
+	case 127:
+		var ext [8]byte
+		if _, err := io.ReadFull(r, ext[:]); err != nil {
+			return webSocketFrame{}, err
+		}
+
+		length = binary.BigEndian.Uint64(ext[:])
+	}
+
+	if length > maxWebSocketPayloadSize {
+		return webSocketFrame{}, ErrWebSocketPayloadTooLarge
+	}
+
+	var maskKey [4]byte
+
+	if masked {
+		if _, err := io.ReadFull(r, maskKey[:]); err != nil {
+			return webSocketFrame{}, err
+		}
+	}
+
+	frame.payload = make([]byte, length)
+	if _, err := io.ReadFull(r, frame.payload); err != nil {
+		return webSocketFrame{}, err
+	}
+
+	if masked {
+		maskWebSocketPayload(maskKey, frame.payload)
+	}
+
+	return frame, nil
+}
+
+func writeWebSocketFrame(w io.Writer, frame webSocketFrame, mask bool) error {
+	buf := make([]byte, 0, 14+len(frame.payload))
+
+	b0 := byte(frame.opcode)
+	if frame.fin {
+		b0 |= 0x80
+	}
+
+	var maskBit byte
+	if mask {
+		maskBit = 0x80
+	}
+
+	buf = append(buf, b0)
+
+	switch n := len(frame.payload); {
+	case n < 126:
+		buf = append(buf, byte(n)|maskBit)
+	case n <= 0xffff:
+		buf = append(buf, 126|maskBit)
+		buf = binary.BigEndian.AppendUint16(buf, uint16(n))
+	default:
+		buf = append(buf, 127|maskBit)
+		buf = binary.BigEndian.AppendUint64(buf, uint64(n))
+	}
+
+	payload := frame.payload
+
+	if mask {
+		var maskKey [4]byte
+		if _, err := rand.Read(maskKey[:]); err != nil {
+			return fmt.Errorf("failed to generate mask key: %w", err)
+		}
+
+		buf = append(buf, maskKey[:]...)
+		payload = append([]byte(nil), frame.payload...)
+		maskWebSocketPayload(maskKey, payload)
+	}
+
+	buf = append(buf, payload...)
+
+	_, err := w.Write(buf)
+
+	return err
+}
+
+func maskWebSocketPayload(key [4]byte, b []byte) {
+	for i := range b {
+		b[i] ^= key[i%4]
+	}
+}
@@ -0,0 +1,286 @@
+package proxy
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/oklog/ulid"
+)
+
+// newProxyForTest returns a proxy with a CA that's valid for an hour.
+func newProxyForTest(t *testing.T, cfg Config) *Proxy {
+	t.Helper()
+
+	caCert, caKey, err := NewCA("Hetty Test CA", "Hetty", time.Hour)
+	if err != nil {
+		t.Fatalf("failed to create CA: %v", err)
+	}
+
+	cfg.CACert = caCert
+	cfg.CAKey = caKey
+
+	p, err := NewProxy(cfg)
+	if err != nil {
+		t.Fatalf("failed to create proxy: %v", err)
+	}
+
+	return p
+}
+
+func TestReadWebSocketFrame(t *testing.T) {
+	t.Parallel()
+
+	// Examples from: https://datatracker.ietf.org/doc/html/rfc6455#section-5.7
+	tests := []struct {
+		name     string
+		input    []byte
+		expFrame webSocketFrame
+		expErr   error
+	}{
+		{
+			name:     "unmasked text frame",
+			input:    []byte{0x81, 0x05, 0x48, 0x65, 0x6c, 0x6c, 0x6f},
+			expFrame: webSocketFrame{fin: true, opcode: WebSocketOpText, payload: []byte("Hello")},
+		},
+		{
+			name:     "masked text frame",
+			input:    []byte{0x81, 0x85, 0x37, 0xfa, 0x21, 0x3d, 0x7f, 0x9f, 0x4d, 0x51, 0x58},
+			expFrame: webSocketFrame{fin: true, opcode: WebSocketOpText, payload: []byte("Hello")},
+		},
+		{
+			name:     "fragmented text frame",
+			input:    []byte{0x01, 0x03, 0x48, 0x65, 0x6c},
+			expFrame: webSocketFrame{fin: false, opcode: WebSocketOpText, payload: []byte("Hel")},
+		},
+		{
+			name:     "unmasked ping frame",
+			input:    []byte{0x89, 0x05, 0x48, 0x65, 0x6c, 0x6c, 0x6f},
+			expFrame: webSocketFrame{fin: true, opcode: WebSocketOpPing, payload: []byte("Hello")},
+		},
+		{
+			name:     "16-bit payload length",
+			input:    append([]byte{0x82, 0x7e, 0x01, 0x00}, make([]byte, 256)...),
+			expFrame: webSocketFrame{fin: true, opcode: WebSocketOpBinary, payload: make([]byte, 256)},
+		},
+		{
+			name:   "payload too large",
+			input:  []byte{0x82, 0x7f, 0x00, 0x00, 0x00, 0x00, 0x02, 0x00, 0x00, 0x01},
+			expErr: ErrWebSocketPayloadTooLarge,
+		},
+		{
+			name:   "truncated payload",
+			input:  []byte{0x81, 0x05, 0x48, 0x65},
+			expErr: io.ErrUnexpectedEOF,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := readWebSocketFrame(bytes.NewReader(tt.input))
+			if !errors.Is(err, tt.expErr) {
+				t.Fatalf("expected error %v, got: %v", tt.expErr, err)
+			}
+
+			if err != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tt.expFrame, got, cmp.AllowUnexported(webSocketFrame{})); diff != "" {
+				t.Errorf("frame not equal (-exp, +got):\n%v", diff)
+			}
+		})
+	}
+}
+
+func TestWriteWebSocketFrame(t *testing.T) {
+	t.Parallel()
+
+	for _, size := range []int{0, 125, 126, 0xffff, 0x10000} {
+		for _, mask := range []bool{false, true} {
+			frame := webSocketFrame{
+				fin:     true,
+				opcode:  WebSocketOpBinary,
+				payload: bytes.Repeat([]byte{'a'}, size),
+			}
+
+			buf := &bytes.Buffer{}
+
+			if err := writeWebSocketFrame(buf, frame, mask); err != nil {
+				t.Fatalf("unexpected error writing frame: %v", err)
+			}
+
+			if masked := buf.Bytes()[1]&0x80 != 0; masked != mask {
+				t.Errorf("size %v: expected mask bit %v, got: %v", size, mask, masked)
+			}
+
+			if mask && size > 0 && bytes.Contains(buf.Bytes(), frame.payload) {
+				t.Errorf("size %v: expected masked payload", size)
+			}
+
+			got, err := readWebSocketFrame(buf)
+			if err != nil {
+				t.Fatalf("size %v: unexpected error reading frame: %v", size, err)
+			}
+
+			if got.fin != frame.fin || got.opcode != frame.opcode || !bytes.Equal(got.payload, frame.payload) {
+				t.Errorf("size %v, mask %v: frame not equal after round trip", size, mask)
+			}
+
+			if buf.Len() != 0 {
+				t.Errorf("size %v: expected frame to be read completely, %v bytes left", size, buf.Len())
+			}
+		}
+	}
+}
+
+func TestRelayWebSocketMessages(t *testing.T) {
+	t.Parallel()
+
+	p := newProxyForTest(t, Config{})
+
+	err := p.UseWebSocketModifier("test", func(next WebSocketModifyFunc) WebSocketModifyFunc {
+		return func(ctx context.Context, msg *WebSocketMessage) error {
+			switch msg.Opcode {
+			case WebSocketOpBinary:
+				return errors.New("dropped")
+			case WebSocketOpText:
+				msg.Payload = bytes.ToUpper(msg.Payload)
+			}
+
+			return next(ctx, msg)
+		}
+	})
+	if err != nil {
+		t.Fatalf("unexpected error adding websocket modifier: %v", err)
+	}
+
+	// Frames sent by the client: a text message fragmented in three frames,
+	// interleaved by a ping, a binary message that's dropped, and a close.
+	input := &bytes.Buffer{}
+
+	for _, frame := range []webSocketFrame{
+		{fin: false, opcode: WebSocketOpText, payload: []byte("hel")},
+		{fin: false, opcode: WebSocketOpContinuation, payload: []byte("lo ")},
+		{fin: true, opcode: WebSocketOpPing, payload: []byte("ping")},
+		{fin: true, opcode: WebSocketOpContinuation, payload: []byte("world")},
+		{fin: true, opcode: WebSocketOpBinary, payload: []byte{0x01}},
+		{fin: true, opcode: WebSocketOpClose, payload: []byte{0x03, 0xe8}},
+	} {
+		if err := writeWebSocketFrame(input, frame, true); err != nil {
+			t.Fatalf("unexpected error writing frame: %v", err)
+		}
+	}
+
+	serverConn, serverPeer := net.Pipe()
+	clientConn, clientPeer := net.Pipe()
+
+	defer clientConn.Close()
+	defer clientPeer.Close()
+
+	conn := &webSocketConn{
+		ctx:        context.Background(),
+		clientConn: clientConn,
+		serverConn: serverConn,
+	}
+
+	framesc := make(chan []webSocketFrame)
+
+	go func() {
+		var frames []webSocketFrame
+
+		for {
+			frame, err := readWebSocketFrame(serverPeer)
+			if err != nil {
+				framesc <- frames
+				return
+			}
+
+			frames = append(frames, frame)
+		}
+	}()
+
+	err = p.relayWebSocketMessages(conn, input, true)
+	if !errors.Is(err, io.EOF) {
+		t.Errorf("expected error %v, got: %v", io.EOF, err)
+	}
+
+	serverConn.Close()
+
+	exp := []webSocketFrame{
+		{fin: true, opcode: WebSocketOpPing, payload: []byte("ping")},
+		{fin: true, opcode: WebSocketOpText, payload: []byte("HELLO WORLD")},
+		{fin: true, opcode: WebSocketOpClose, payload: []byte{0x03, 0xe8}},
+	}
+
+	if diff := cmp.Diff(exp, <-framesc, cmp.AllowUnexported(webSocketFrame{})); diff != "" {
+		t.Errorf("frames relayed to server not equal (-exp, +got):\n%v", diff)
+	}
+}
+
+func TestRelayWebSocketMessagesUnexpectedContinuation(t *testing.T) {
+	t.Parallel()
+
+	p := newProxyForTest(t, Config{})
+
+	input := &bytes.Buffer{}
+
+	err := writeWebSocketFrame(input, webSocketFrame{fin: true, opcode: WebSocketOpContinuation}, false)
+	if err != nil {
+		t.Fatalf("unexpected error writing frame: %v", err)
+	}
+
+	err = p.relayWebSocketMessages(&webSocketConn{ctx: context.Background()}, input, false)
+	if !errors.Is(err, ErrWebSocketUnexpectedOpcode) {
+		t.Errorf("expected error %v, got: %v", ErrWebSocketUnexpectedOpcode, err)
+	}
+}
+
+func TestWebSocketOpcode(t *testing.T) {
+	t.Parallel()
+
+	for op, exp := range map[WebSocketOpcode]string{
+		WebSocketOpText:  "text",
+		WebSocketOpClose: "close",
+		0x3:              "unknown (0x3)",
+	} {
+		if got := op.String(); got != exp {
+			t.Errorf("expected %q, got: %q", exp, got)
+		}
+	}
+
+	if WebSocketOpBinary.IsControl() || !WebSocketOpPing.IsControl() {
+		t.Error("expected only ping to be a control opcode")
+	}
+}
+
+// IDs of WebSocket messages are created by the relay goroutines of each
+// connection concurrently. Run with `-race` to detect unsafe use of entropy.
+func TestULIDEntropyConcurrent(t *testing.T) {
+	t.Parallel()
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for j := 0; j < 100; j++ {
+				_ = ulid.MustNew(ulid.Timestamp(time.Now()), ulidEntropy)
+			}
+		}()
+	}
+
+	wg.Wait()
+}
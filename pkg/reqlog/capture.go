@@ -0,0 +1,190 @@
+package reqlog
+
+import (
+	"bytes"
+	"io"
+	"sync"
+	"time"
+)
+
+// DefaultMaxBodySize is the maximum number of bytes of a request or response
+// body that is logged, when no limit is configured.
+const DefaultMaxBodySize = 10 << 20 // 10 MiB
+
+// responseLogInterval is the delay after which a response log is first stored
+// while its body is still being streamed, e.g. for server-sent events, so it
+// can be inspected before the body is done. The delay doubles after each store,
+// up to maxResponseLogInterval.
+const (
+	responseLogInterval    = time.Second
+	maxResponseLogInterval = 30 * time.Second
+)
+
+// readBody reads up to `limit` bytes of `body`, and returns these alongside a
+// reader that yields the entire (unconsumed) body.
+func readBody(body io.ReadCloser, limit int64) ([]byte, bool, io.ReadCloser, error) {
+	buf, err := io.ReadAll(io.LimitReader(body, limit+1))
+	if err != nil {
+		return nil, false, nil, err
+	}
+
+	if int64(len(buf)) <= limit {
+		return buf, false, io.NopCloser(bytes.NewReader(buf)), nil
+	}
+
+	rc := struct {
+		io.Reader
+		io.Closer
+	}{
+		Reader: io.MultiReader(bytes.NewReader(buf), body),
+		Closer: body,
+	}
+
+	return buf[:limit], true, rc, nil
+}
+
+// truncateBody returns at most `limit` bytes of `body`.
+func truncateBody(body []byte, limit int64) []byte {
+	if int64(len(body)) > limit {
+		return body[:limit]
+	}
+
+	return body
+}
+
+// captureBody wraps a body that is streamed to a client, and records up to
+// `limit` bytes of it. While the body is being read, `store` is called with
+// the captured bytes so far at a growing interval (because storing a response
+// log updates its indexes), when bytes were captured since the last store. It's
+// also called when the limit is reached, and when the body is read completely
+// or closed, unless the limit was reached, because the captured body doesn't
+// change after that.
+type captureBody struct {
+	io.ReadCloser
+
+	limit     int64
+	buf       bytes.Buffer
+	truncated bool
+	done      bool
+	// storedLen is the length of the captured body when it was last stored,
+	// or -1 if it wasn't stored yet.
+	storedLen int
+	// finalStored is true when the captured body was stored after the limit
+	// was reached.
+	finalStored bool
+	interval    time.Duration
+	timer       *time.Timer
+	mu          sync.Mutex
+
+	store   func(body []byte, truncated bool)
+	storeMu sync.Mutex
+}
+
+func newCaptureBody(body io.ReadCloser, limit int64, store func(body []byte, truncated bool)) *captureBody {
+	c := &captureBody{
+		ReadCloser: body,
+		limit:      limit,
+		storedLen:  -1,
+		interval:   responseLogInterval,
+		store:      store,
+	}
+
+	c.timer = time.AfterFunc(c.interval, c.flush)
+
+	return c
+}
+
+func (c *captureBody) Read(p []byte) (int, error) {
+	n, err := c.ReadCloser.Read(p)
+
+	c.mu.Lock()
+
+	limitReached := false
+
+	if remaining := c.limit - int64(c.buf.Len()); remaining < int64(n) {
+		c.buf.Write(p[:max(remaining, 0)])
+		limitReached = !c.truncated
+		c.truncated = true
+	} else {
+		c.buf.Write(p[:n])
+	}
+
+	c.mu.Unlock()
+
+	if err != nil {
+		c.finish()
+	} else if limitReached {
+		c.timer.Stop()
+		go c.flush()
+	}
+
+	return n, err
+}
+
+func (c *captureBody) Close() error {
+	c.finish()
+	return c.ReadCloser.Close()
+}
+
+// flush stores the body captured so far, if the body isn't done yet and it
+// changed since it was last stored. Until the limit is reached, the next flush
+// is scheduled after twice the current interval.
+func (c *captureBody) flush() {
+	c.storeMu.Lock()
+	defer c.storeMu.Unlock()
+
+	c.mu.Lock()
+
+	if c.done || c.finalStored {
+		c.mu.Unlock()
+		return
+	}
+
+	// The captured body only grows, so its length tells if it changed.
+	changed := c.buf.Len() != c.storedLen
+	body, truncated := bytes.Clone(c.buf.Bytes()), c.truncated
+	c.storedLen = c.buf.Len()
+	c.finalStored = truncated
+
+	if !truncated {
+		c.interval = min(2*c.interval, maxResponseLogInterval)
+		c.timer.Reset(c.interval)
+	}
+
+	c.mu.Unlock()
+
+	if changed {
+		c.store(body, truncated)
+	}
+}
+
+// finish stores the captured body. It's only effective on the first call.
+func (c *captureBody) finish() {
+	c.mu.Lock()
+
+	if c.done {
+		c.mu.Unlock()
+		return
+	}
+
+	c.done = true
+	body, truncated, finalStored := bytes.Clone(c.buf.Bytes()), c.truncated, c.finalStored
+
+	c.mu.Unlock()
+
+	c.timer.Stop()
+
+	if finalStored {
+		return
+	}
+
+	// Storing is done asynchronously, so the final read (or close) of the body
+	// isn't delayed. Acquiring `storeMu` guarantees it's stored after any
+	// in-flight flush.
+	go func() {
+		c.storeMu.Lock()
+		defer c.storeMu.Unlock()
+
+		c.store(body, truncated)
+	}()
+}
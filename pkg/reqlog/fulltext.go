@@ -0,0 +1,225 @@
+package reqlog
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"mime"
+	"net/url"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/net/html"
+)
+
+// BodySearchPrefix is the prefix of free-text search terms that match tokens
+// of request and response bodies, e.g. `body:token` or `"body:access token"`.
+// All tokens of the terms must occur in the request or response body. When a
+// project has a full-text index, these terms are looked up in the index
+// instead of tokenizing every body. Only these terms use the index: other
+// free-text terms also match headers and URLs, and `req.body` and `res.body`
+// comparisons match raw bytes (e.g. a substring of a token), so they're always
+// evaluated against each request log.
+const BodySearchPrefix = "body:"
+
+// MaxTokenSize is the maximum size of a token in bytes. Longer tokens are
+// truncated on a rune boundary.
+const MaxTokenSize = 64
+
+// BodyTokens returns the unique tokens of the request and response bodies.
+func (reqLog RequestLog) BodyTokens() []string {
+	tokens := make(map[string]struct{})
+
+	tokenizeBody(tokens, reqLog.Body, reqLog.Header.Get("Content-Type"))
+
+	if reqLog.Response != nil {
+		tokenizeBody(tokens, reqLog.Response.Body, reqLog.Response.Header.Get("Content-Type"))
+	}
+
+	return tokenSlice(tokens)
+}
+
+// TokenizeBody returns the unique, lower cased tokens of a body. Depending on
+// the content type, JSON and form bodies are tokenized by their keys and
+// values, and HTML bodies by their text and attribute values, so that syntax
+// isn't indexed. Binary bodies have no tokens.
+func TokenizeBody(body []byte, contentType string) []string {
+	tokens := make(map[string]struct{})
+	tokenizeBody(tokens, body, contentType)
+
+	return tokenSlice(tokens)
+}
+
+// TokenizeQuery returns the unique tokens of free-text search terms.
+func TokenizeQuery(s string) []string {
+	tokens := make(map[string]struct{})
+	tokenizeText(tokens, s)
+
+	return tokenSlice(tokens)
+}
+
+func (reqLog RequestLog) matchBodyTerms(terms string) bool {
+	queryTokens := TokenizeQuery(terms)
+	if len(queryTokens) == 0 {
+		return true
+	}
+
+	bodyTokens := make(map[string]struct{})
+	for _, token := range reqLog.BodyTokens() {
+		bodyTokens[token] = struct{}{}
+	}
+
+	for _, token := range queryTokens {
+		if _, ok := bodyTokens[token]; !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+func tokenizeBody(tokens map[string]struct{}, body []byte, contentType string) {
+	if len(body) == 0 {
+		return
+	}
+
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+
+	switch {
+	case strings.Contains(mediaType, "json"), mediaType == "" && json.Valid(body):
+		if tokenizeJSON(tokens, body) == nil {
+			return
+		}
+	case mediaType == "application/x-www-form-urlencoded":
+		if tokenizeForm(tokens, body) == nil {
+			return
+		}
+	case mediaType == "text/html", mediaType == "application/xhtml+xml":
+		tokenizeHTML(tokens, body)
+		return
+	}
+
+	if !utf8.Valid(body) || bytes.IndexByte(body, 0) != -1 {
+		return
+	}
+
+	tokenizeText(tokens, string(body))
+}
+
+func tokenizeJSON(tokens map[string]struct{}, body []byte) error {
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.UseNumber()
+
+	for {
+		tok, err := dec.Token()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		switch v := tok.(type) {
+		case string:
+			tokenizeText(tokens, v)
+		case json.Number:
+			tokenizeText(tokens, v.String())
+		case bool:
+			if v {
+				addToken(tokens, "true")
+			} else {
+				addToken(tokens, "false")
+			}
+		}
+	}
+}
+
+func tokenizeForm(tokens map[string]struct{}, body []byte) error {
+	values, err := url.ParseQuery(string(body))
+	if err != nil {
+		return err
+	}
+
+	for key, vals := range values {
+		tokenizeText(tokens, key)
+
+		for _, val := range vals {
+			tokenizeText(tokens, val)
+		}
+	}
+
+	return nil
+}
+
+func tokenizeHTML(tokens map[string]struct{}, body []byte) {
+	z := html.NewTokenizer(bytes.NewReader(body))
+
+	for {
+		switch z.Next() {
+		case html.ErrorToken:
+			return
+		case html.TextToken:
+			tokenizeText(tokens, string(z.Text()))
+		case html.StartTagToken, html.SelfClosingTagToken:
+			for {
+				_, val, more := z.TagAttr()
+				tokenizeText(tokens, string(val))
+
+				if !more {
+					break
+				}
+			}
+		}
+	}
+}
+
+// tokenizeText splits text into tokens of letters and digits.
+func tokenizeText(tokens map[string]struct{}, s string) {
+	start := -1
+
+	for i, r := range s {
+		isTokenRune := unicode.IsLetter(r) || unicode.IsDigit(r)
+
+		switch {
+		case isTokenRune && start == -1:
+			start = i
+		case !isTokenRune && start != -1:
+			addToken(tokens, s[start:i])
+			start = -1
+		}
+	}
+
+	if start != -1 {
+		addToken(tokens, s[start:])
+	}
+}
+
+func addToken(tokens map[string]struct{}, token string) {
+	token = strings.ToLower(token)
+
+	if len(token) > MaxTokenSize {
+		n := MaxTokenSize
+		for n > 0 && !utf8.RuneStart(token[n]) {
+			n--
+		}
+
+		token = token[:n]
+	}
+
+	tokens[token] = struct{}{}
+}
+
+func tokenSlice(tokens map[string]struct{}) []string {
+	s := make([]string, 0, len(tokens))
+	for token := range tokens {
+		s = append(s, token)
+	}
+
+	sort.Strings(s)
+
+	return s
+}
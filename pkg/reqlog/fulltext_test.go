@@ -0,0 +1,86 @@
+package reqlog_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/dstotijn/hetty/pkg/reqlog"
+)
+
+func TestTokenizeBody(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		body        string
+		contentType string
+		exp         []string
+	}{
+		{
+			name:        "JSON keys and values",
+			body:        `{"userName": "Alice Smith", "age": 42, "admin": true, "tags": ["a-b"], "x": null}`,
+			contentType: "application/json; charset=utf-8",
+			exp:         []string{"42", "a", "admin", "age", "alice", "b", "smith", "tags", "true", "username", "x"},
+		},
+		{
+			name: "JSON without content type",
+			body: `["foo_bar"]`,
+			exp:  []string{"bar", "foo"},
+		},
+		{
+			name:        "invalid JSON falls back to text",
+			body:        `{"foo": bar`,
+			contentType: "application/json",
+			exp:         []string{"bar", "foo"},
+		},
+		{
+			name:        "form keys and decoded values",
+			body:        "q=caf%C3%A9+latte&redirect=%2Fhome",
+			contentType: "application/x-www-form-urlencoded",
+			exp:         []string{"café", "home", "latte", "q", "redirect"},
+		},
+		{
+			name:        "HTML text and attribute values",
+			body:        `<html><body><a href="/login" class="btn">Sign &amp; in</a><br/></body></html>`,
+			contentType: "text/html",
+			exp:         []string{"btn", "in", "login", "sign"},
+		},
+		{
+			name:        "plain text",
+			body:        "Hello, World! Ünïcode 123",
+			contentType: "text/plain",
+			exp:         []string{"123", "hello", "world", "ünïcode"},
+		},
+		{
+			name:        "binary",
+			body:        "foo\x00bar",
+			contentType: "application/octet-stream",
+			exp:         []string{},
+		},
+		{
+			name: "long token is truncated",
+			body: strings.Repeat("a", 100),
+			exp:  []string{strings.Repeat("a", reqlog.MaxTokenSize)},
+		},
+		{
+			name: "long token is truncated on a rune boundary",
+			body: "a" + strings.Repeat("é", 50),
+			exp:  []string{"a" + strings.Repeat("é", (reqlog.MaxTokenSize-1)/2)},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := reqlog.TokenizeBody([]byte(tt.body), tt.contentType)
+
+			if diff := cmp.Diff(tt.exp, got); diff != "" {
+				t.Fatalf("tokens not equal (-exp, +got):\n%v", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,384 @@
+package reqlog
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/oklog/ulid"
+
+	"github.com/dstotijn/hetty/pkg/har"
+	"github.com/dstotijn/hetty/pkg/proxy/timing"
+)
+
+var (
+	//nolint:gosec
+	ulidEntropy   = ulid.Monotonic(rand.New(rand.NewSource(time.Now().UnixNano())), 0)
+	ulidEntropyMu sync.Mutex
+)
+
+// ExportHAR returns a HAR of request logs in the active project. When `ids` is
+// empty, the request logs that match the active filter are exported.
+func (svc *Service) ExportHAR(ctx context.Context, ids []ulid.ULID, creator har.Creator) (har.HAR, error) {
+	if svc.activeProjectID.Compare(ulid.ULID{}) == 0 {
+		return har.HAR{}, ErrProjectIDMustBeSet
+	}
+
+	var reqLogs []RequestLog
+
+	if len(ids) == 0 {
+		var err error
+
+		reqLogs, err = svc.FindRequests(ctx)
+		if err != nil {
+			return har.HAR{}, fmt.Errorf("reqlog: failed to find request logs: %w", err)
+		}
+	}
+
+	for _, id := range ids {
+		reqLog, err := svc.FindRequestLogByID(ctx, id)
+		if err != nil {
+			return har.HAR{}, fmt.Errorf("reqlog: failed to find request log (id: %v): %w", id, err)
+		}
+
+		reqLogs = append(reqLogs, reqLog)
+	}
+
+	// HAR entries are sorted by start time, oldest first.
+	sort.Slice(reqLogs, func(i, j int) bool {
+		return reqLogs[i].ID.Compare(reqLogs[j].ID) < 0
+	})
+
+	entries := make([]har.Entry, len(reqLogs))
+	for i, reqLog := range reqLogs {
+		entries[i] = reqLog.HAREntry()
+	}
+
+	return har.New(creator, entries), nil
+}
+
+// ImportHAR creates request logs in the active project for the entries of a
+// HAR. The IDs of the request logs are based on the start times of the
+// entries. It returns the created request logs.
+func (svc *Service) ImportHAR(ctx context.Context, h har.HAR) ([]RequestLog, error) {
+	if svc.activeProjectID.Compare(ulid.ULID{}) == 0 {
+		return nil, ErrProjectIDMustBeSet
+	}
+
+	reqLogs := make([]RequestLog, len(h.Log.Entries))
+
+	for i, entry := range h.Log.Entries {
+		reqLog, err := RequestLogFromHAREntry(entry)
+		if err != nil {
+			return nil, fmt.Errorf("reqlog: failed to parse HAR entry %d: %w", i, err)
+		}
+
+		reqLog.ProjectID = svc.activeProjectID
+		reqLog.Body, reqLog.BodyTruncated = truncateHARBody(reqLog.Body, svc.MaxBodySize())
+
+		if reqLog.Response != nil {
+			reqLog.Response.Body, reqLog.Response.BodyTruncated = truncateHARBody(reqLog.Response.Body,
+				svc.MaxBodySize())
+		}
+
+		reqLogs[i] = reqLog
+	}
+
+	// Entries are stored in a single transaction after all of them were parsed,
+	// so an invalid HAR isn't partially imported.
+	if err := svc.repo.StoreRequestLogs(ctx, reqLogs); err != nil {
+		return nil, fmt.Errorf("reqlog: failed to store request logs: %w", err)
+	}
+
+	return reqLogs, nil
+}
+
+func truncateHARBody(body []byte, limit int64) ([]byte, bool) {
+	return truncateBody(body, limit), int64(len(body)) > limit
+}
+
+// HAREntry returns the request log as a HAR entry. Annotations are added as
+// the entry's comment, one per line.
+func (reqLog RequestLog) HAREntry() har.Entry {
+	entry := har.Entry{
+		StartedDateTime: ulid.Time(reqLog.ID.Time()),
+		Request: har.Request{
+			Method:      reqLog.Method,
+			HTTPVersion: reqLog.Proto,
+			Cookies:     harRequestCookies(reqLog.Header),
+			Headers:     harHeaders(reqLog.Header),
+			QueryString: []har.NameValue{},
+			HeadersSize: -1,
+			BodySize:    int64(len(reqLog.Body)),
+		},
+		Response: har.Response{
+			Cookies:     []har.Cookie{},
+			Headers:     []har.NameValue{},
+			HeadersSize: -1,
+			BodySize:    -1,
+		},
+		Timings: har.Timings{Blocked: -1, DNS: -1, Connect: -1, SSL: -1},
+		Comment: strings.Join(reqLog.Annotations, "\n"),
+	}
+
+	if reqLog.URL != nil {
+		entry.Request.URL = reqLog.URL.String()
+		entry.Request.QueryString = harQueryString(reqLog.URL.Query())
+	}
+
+	if len(reqLog.Body) > 0 {
+		entry.Request.PostData = &har.PostData{
+			MimeType: reqLog.Header.Get("Content-Type"),
+			Text:     string(reqLog.Body),
+		}
+	}
+
+	res := reqLog.Response
+	if res == nil {
+		return entry
+	}
+
+	entry.Response = har.Response{
+		Status:      res.StatusCode,
+		StatusText:  strings.TrimPrefix(res.Status, strconv.Itoa(res.StatusCode)+" "),
+		HTTPVersion: res.Proto,
+		Cookies:     harResponseCookies(res.Header),
+		Headers:     harHeaders(res.Header),
+		Content: har.Content{
+			Size:     int64(len(res.Body)),
+			MimeType: res.Header.Get("Content-Type"),
+		},
+		RedirectURL: res.Header.Get("Location"),
+		HeadersSize: -1,
+		BodySize:    int64(len(res.Body)),
+	}
+
+	if res.EncodedBody != nil {
+		entry.Response.BodySize = int64(len(res.EncodedBody))
+		entry.Response.Content.Compression = int64(len(res.Body)) - int64(len(res.EncodedBody))
+	}
+
+	if utf8.Valid(res.Body) {
+		entry.Response.Content.Text = string(res.Body)
+	} else {
+		entry.Response.Content.Text = base64.StdEncoding.EncodeToString(res.Body)
+		entry.Response.Content.Encoding = "base64"
+	}
+
+	if res.Timing != nil {
+		t := res.Timing
+		entry.Time = har.Milliseconds(t.Total)
+		entry.Timings = harTimings(*t)
+	}
+
+	return entry
+}
+
+// harTimings converts timing to HAR timings. In HAR, `connect` includes the
+// TLS handshake (`ssl`).
+func harTimings(t timing.Timing) har.Timings {
+	wait := t.TimeToFirstByte - t.DNS - t.Connect - t.TLSHandshake
+	if wait < 0 {
+		wait = 0
+	}
+
+	receive := t.Total - t.TimeToFirstByte
+	if receive < 0 {
+		receive = 0
+	}
+
+	timings := har.Timings{
+		Blocked: -1,
+		DNS:     -1,
+		Connect: -1,
+		SSL:     -1,
+		Wait:    har.Milliseconds(wait),
+		Receive: har.Milliseconds(receive),
+	}
+
+	if t.DNS > 0 {
+		timings.DNS = har.Milliseconds(t.DNS)
+	}
+
+	if t.Connect > 0 {
+		timings.Connect = har.Milliseconds(t.Connect + t.TLSHandshake)
+	}
+
+	if t.TLSHandshake > 0 {
+		timings.SSL = har.Milliseconds(t.TLSHandshake)
+	}
+
+	return timings
+}
+
+// RequestLogFromHAREntry returns a request log for a HAR entry, with an ID
+// based on the entry's start time. The project ID isn't set.
+func RequestLogFromHAREntry(entry har.Entry) (RequestLog, error) {
+	u, err := url.Parse(entry.Request.URL)
+	if err != nil {
+		return RequestLog{}, fmt.Errorf("invalid URL: %w", err)
+	}
+
+	ulidEntropyMu.Lock()
+	id, err := ulid.New(ulid.Timestamp(entry.StartedDateTime), ulidEntropy)
+	ulidEntropyMu.Unlock()
+
+	if err != nil {
+		return RequestLog{}, fmt.Errorf("invalid start time: %w", err)
+	}
+
+	reqLog := RequestLog{
+		ID:     id,
+		URL:    u,
+		Method: entry.Request.Method,
+		Proto:  entry.Request.HTTPVersion,
+		Header: headerFromHAR(entry.Request.Headers),
+	}
+
+	if entry.Request.PostData != nil {
+		reqLog.Body = []byte(entry.Request.PostData.Text)
+	}
+
+	for _, line := range strings.Split(entry.Comment, "\n") {
+		if line != "" {
+			reqLog.Annotations = append(reqLog.Annotations, line)
+		}
+	}
+
+	// Entries of requests that failed without a response have status 0.
+	if entry.Response.Status == 0 {
+		return reqLog, nil
+	}
+
+	res := &ResponseLog{
+		Proto:      entry.Response.HTTPVersion,
+		StatusCode: entry.Response.Status,
+		Status:     strings.TrimSpace(fmt.Sprintf("%d %s", entry.Response.Status, entry.Response.StatusText)),
+		Header:     headerFromHAR(entry.Response.Headers),
+	}
+
+	if entry.Response.Content.Encoding == "base64" {
+		res.Body, err = base64.StdEncoding.DecodeString(entry.Response.Content.Text)
+		if err != nil {
+			return RequestLog{}, fmt.Errorf("invalid base64 response content: %w", err)
+		}
+	} else {
+		res.Body = []byte(entry.Response.Content.Text)
+	}
+
+	if entry.Time > 0 {
+		t := entry.Timings
+		res.Timing = &timing.Timing{
+			DNS:          har.Duration(t.DNS),
+			TLSHandshake: har.Duration(t.SSL),
+			TimeToFirstByte: har.Duration(t.Blocked) + har.Duration(t.DNS) + har.Duration(t.Connect) +
+				har.Duration(t.Send) + har.Duration(t.Wait),
+			Total: har.Duration(entry.Time),
+		}
+
+		// In HAR, `connect` includes the TLS handshake.
+		if connect := har.Duration(t.Connect) - har.Duration(t.SSL); connect > 0 {
+			res.Timing.Connect = connect
+		}
+	}
+
+	reqLog.Response = res
+
+	return reqLog, nil
+}
+
+func harHeaders(header http.Header) []har.NameValue {
+	keys := make([]string, 0, len(header))
+	for key := range header {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	headers := []har.NameValue{}
+
+	for _, key := range keys {
+		for _, value := range header[key] {
+			headers = append(headers, har.NameValue{Name: key, Value: value})
+		}
+	}
+
+	return headers
+}
+
+func harQueryString(query url.Values) []har.NameValue {
+	keys := make([]string, 0, len(query))
+	for key := range query {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	params := []har.NameValue{}
+
+	for _, key := range keys {
+		for _, value := range query[key] {
+			params = append(params, har.NameValue{Name: key, Value: value})
+		}
+	}
+
+	return params
+}
+
+func harRequestCookies(header http.Header) []har.Cookie {
+	cookies := []har.Cookie{}
+
+	for _, c := range (&http.Request{Header: header}).Cookies() {
+		cookies = append(cookies, har.Cookie{Name: c.Name, Value: c.Value})
+	}
+
+	return cookies
+}
+
+func harResponseCookies(header http.Header) []har.Cookie {
+	cookies := []har.Cookie{}
+
+	for _, c := range (&http.Response{Header: header}).Cookies() {
+		cookie := har.Cookie{
+			Name:     c.Name,
+			Value:    c.Value,
+			Path:     c.Path,
+			Domain:   c.Domain,
+			HTTPOnly: c.HttpOnly,
+			Secure:   c.Secure,
+		}
+
+		if !c.Expires.IsZero() {
+			expires := c.Expires
+			cookie.Expires = &expires
+		}
+
+		cookies = append(cookies, cookie)
+	}
+
+	return cookies
+}
+
+func headerFromHAR(headers []har.NameValue) http.Header {
+	header := make(http.Header)
+
+	for _, h := range headers {
+		// HTTP/2 pseudo headers (e.g. `:authority`) aren't actual headers.
+		if strings.HasPrefix(h.Name, ":") {
+			continue
+		}
+
+		header.Add(h.Name, h.Value)
+	}
+
+	return header
+}
@@ -0,0 +1,202 @@
+package reqlog_test
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/oklog/ulid"
+	"go.etcd.io/bbolt"
+
+	"github.com/dstotijn/hetty/pkg/db/bolt"
+	"github.com/dstotijn/hetty/pkg/har"
+	"github.com/dstotijn/hetty/pkg/proj"
+	"github.com/dstotijn/hetty/pkg/proxy/timing"
+	"github.com/dstotijn/hetty/pkg/reqlog"
+	"github.com/dstotijn/hetty/pkg/scope"
+)
+
+func newHARTestService(t *testing.T) (*reqlog.Service, *bolt.Database) {
+	t.Helper()
+
+	path := t.TempDir() + "bolt.db"
+
+	boltDB, err := bbolt.Open(path, 0o600, nil)
+	if err != nil {
+		t.Fatalf("failed to open bolt database: %v", err)
+	}
+
+	t.Cleanup(func() { boltDB.Close() })
+
+	db, err := bolt.DatabaseFromBoltDB(boltDB)
+	if err != nil {
+		t.Fatalf("failed to create database: %v", err)
+	}
+
+	svc := reqlog.NewService(reqlog.Config{
+		Repository: db,
+		Scope:      &scope.Scope{},
+	})
+
+	return svc, db
+}
+
+func setActiveProject(t *testing.T, svc *reqlog.Service, db *bolt.Database) ulid.ULID {
+	t.Helper()
+
+	projectID := ulid.MustNew(ulid.Timestamp(time.Now()), ulidEntropy)
+
+	if err := db.UpsertProject(context.Background(), proj.Project{ID: projectID}); err != nil {
+		t.Fatalf("unexpected error upserting project: %v", err)
+	}
+
+	svc.SetActiveProjectID(projectID)
+	svc.SetFindReqsFilter(reqlog.FindRequestsFilter{ProjectID: projectID})
+
+	return projectID
+}
+
+func TestHARExportImport(t *testing.T) {
+	t.Parallel()
+
+	svc, db := newHARTestService(t)
+	projectID := setActiveProject(t, svc, db)
+
+	startedAt := time.Date(2021, 2, 3, 4, 5, 6, 7_000_000, time.UTC)
+
+	reqLog := reqlog.RequestLog{
+		ID:        ulid.MustNew(ulid.Timestamp(startedAt), ulidEntropy),
+		ProjectID: projectID,
+		URL:       &url.URL{Scheme: "https", Host: "example.com", Path: "/foo", RawQuery: "a=1&b=2"},
+		Method:    http.MethodPost,
+		Proto:     "HTTP/1.1",
+		Header: http.Header{
+			"Content-Type": []string{"application/json"},
+			"Cookie":       []string{"session=foobar"},
+		},
+		Body:        []byte(`{"foo":"bar"}`),
+		Annotations: []string{"foo", "bar"},
+		Response: &reqlog.ResponseLog{
+			Proto:      "HTTP/1.1",
+			StatusCode: http.StatusOK,
+			Status:     "200 OK",
+			Header: http.Header{
+				"Content-Type": []string{"application/octet-stream"},
+				"Set-Cookie":   []string{"session=baz; Path=/; HttpOnly"},
+			},
+			Body: []byte{0xff, 0x00, 0xfe},
+			Timing: &timing.Timing{
+				DNS:             10 * time.Millisecond,
+				Connect:         20 * time.Millisecond,
+				TLSHandshake:    30 * time.Millisecond,
+				TimeToFirstByte: 100 * time.Millisecond,
+				Total:           150 * time.Millisecond,
+			},
+		},
+	}
+
+	if err := db.StoreRequestLog(context.Background(), reqLog); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	h, err := svc.ExportHAR(context.Background(), nil, har.Creator{Name: "Hetty", Version: "1.0.0"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(h.Log.Entries) != 1 {
+		t.Fatalf("expected 1 entry, got: %v", len(h.Log.Entries))
+	}
+
+	entry := h.Log.Entries[0]
+
+	if !entry.StartedDateTime.Equal(startedAt) {
+		t.Errorf("expected start time %v, got: %v", startedAt, entry.StartedDateTime)
+	}
+
+	expTimings := har.Timings{Blocked: -1, DNS: 10, Connect: 50, SSL: 30, Wait: 40, Receive: 50}
+	if diff := cmp.Diff(expTimings, entry.Timings); diff != "" {
+		t.Errorf("timings not equal (-exp, +got):\n%v", diff)
+	}
+
+	if entry.Response.Content.Encoding != "base64" {
+		t.Errorf("expected binary content to be base64 encoded, got: %q", entry.Response.Content.Encoding)
+	}
+
+	expCookies := []har.Cookie{{Name: "session", Value: "baz", Path: "/", HTTPOnly: true}}
+	if diff := cmp.Diff(expCookies, entry.Response.Cookies); diff != "" {
+		t.Errorf("response cookies not equal (-exp, +got):\n%v", diff)
+	}
+
+	// Import into another project.
+	otherProjectID := setActiveProject(t, svc, db)
+
+	imported, err := svc.ImportHAR(context.Background(), h)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(imported) != 1 {
+		t.Fatalf("expected 1 imported request log, got: %v", len(imported))
+	}
+
+	got, err := svc.FindRequestLogByID(context.Background(), imported[0].ID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got.ID.Time() != reqLog.ID.Time() {
+		t.Errorf("expected ID with timestamp %v, got: %v", reqLog.ID.Time(), got.ID.Time())
+	}
+
+	exp := reqLog
+	exp.ID = got.ID
+	exp.ProjectID = otherProjectID
+
+	if diff := cmp.Diff(exp, got); diff != "" {
+		t.Errorf("request log not equal (-exp, +got):\n%v", diff)
+	}
+}
+
+func TestHARExportByID(t *testing.T) {
+	t.Parallel()
+
+	svc, db := newHARTestService(t)
+	projectID := setActiveProject(t, svc, db)
+
+	var ids []ulid.ULID
+
+	for i := 0; i < 3; i++ {
+		reqLog := reqlog.RequestLog{
+			ID:        ulid.MustNew(ulid.Timestamp(time.Now()), ulidEntropy),
+			ProjectID: projectID,
+			URL:       &url.URL{Scheme: "https", Host: "example.com", Path: "/"},
+			Method:    http.MethodGet,
+			Proto:     "HTTP/1.1",
+			Header:    http.Header{},
+		}
+
+		if err := db.StoreRequestLog(context.Background(), reqLog); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		ids = append(ids, reqLog.ID)
+	}
+
+	h, err := svc.ExportHAR(context.Background(), []ulid.ULID{ids[2], ids[0]}, har.Creator{Name: "Hetty"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(h.Log.Entries) != 2 {
+		t.Fatalf("expected 2 entries, got: %v", len(h.Log.Entries))
+	}
+
+	// Entries of requests without a response have status 0.
+	if h.Log.Entries[0].Response.Status != 0 {
+		t.Errorf("expected status 0, got: %v", h.Log.Entries[0].Response.Status)
+	}
+}
@@ -10,8 +10,15 @@ import (
 
 type Repository interface {
 	FindRequestLogs(ctx context.Context, filter FindRequestsFilter, scope *scope.Scope) ([]RequestLog, error)
+	FindRequestLogsPage(ctx context.Context, filter FindRequestsFilter, scope *scope.Scope, opts PageOptions) (Page, error)
 	FindRequestLogByID(ctx context.Context, projectID, id ulid.ULID) (RequestLog, error)
 	StoreRequestLog(ctx context.Context, reqLog RequestLog) error
+	StoreRequestLogs(ctx context.Context, reqLogs []RequestLog) error
 	StoreResponseLog(ctx context.Context, projectID, reqLogID ulid.ULID, resLog ResponseLog) error
 	ClearRequestLogs(ctx context.Context, projectID ulid.ULID) error
+	SetFullTextIndexEnabled(ctx context.Context, projectID ulid.ULID, enabled bool) error
+	FindWebSocketMessageLogs(ctx context.Context, projectID, reqLogID ulid.ULID) ([]WebSocketMessageLog, error)
+	StoreWebSocketMessageLog(ctx context.Context, msgLog WebSocketMessageLog) error
+	FindTunnelLogs(ctx context.Context, projectID ulid.ULID) ([]TunnelLog, error)
+	StoreTunnelLog(ctx context.Context, tunnelLog TunnelLog) error
 }
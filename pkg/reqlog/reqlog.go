@@ -3,18 +3,25 @@ package reqlog
 import (
 	"bytes"
 	"context"
+	"crypto/tls"
 	"errors"
 	"fmt"
 	"io"
-	"io/ioutil"
 	"net/http"
 	"net/url"
+	"time"
 
 	"github.com/oklog/ulid"
 
 	"github.com/dstotijn/hetty/pkg/filter"
 	"github.com/dstotijn/hetty/pkg/log"
 	"github.com/dstotijn/hetty/pkg/proxy"
+	"github.com/dstotijn/hetty/pkg/proxy/clientcert"
+	"github.com/dstotijn/hetty/pkg/proxy/maprule"
+	"github.com/dstotijn/hetty/pkg/proxy/matchreplace"
+	"github.com/dstotijn/hetty/pkg/proxy/plugin"
+	"github.com/dstotijn/hetty/pkg/proxy/script"
+	"github.com/dstotijn/hetty/pkg/proxy/timing"
 	"github.com/dstotijn/hetty/pkg/scope"
 )
 
@@ -39,6 +46,17 @@ type RequestLog struct {
 	Proto  string
 	Header http.Header
 	Body   []byte
+	// BodyTruncated is true if only part of the body was logged, because it
+	// exceeded the maximum body size.
+	BodyTruncated bool
+	// MapRule is the Map Remote or Map Local rule that was applied, if any.
+	MapRule *maprule.Match
+	// Original is the request before match and replace rules modified it.
+	Original *matchreplace.Request
+	// Annotations were added by scripts and plugins.
+	Annotations []string
+	// Findings were reported by plugins.
+	Findings []plugin.Finding
 
 	Response *ResponseLog
 }
@@ -49,10 +67,60 @@ type ResponseLog struct {
 	Status     string
 	Header     http.Header
 	Body       []byte
+	// BodyTruncated is true if only part of the body was logged, because it
+	// exceeded the maximum body size. While a response is being streamed, its
+	// log contains the part of the body received so far.
+	BodyTruncated bool
+
+	// EncodedBody is the body as it was received, if it was decoded based on
+	// the `Content-Encoding` header, which is stored as ContentEncoding.
+	EncodedBody     []byte
+	ContentEncoding string
+
+	// ClientCertName is the name of the client certificate that was presented
+	// to the server, if any.
+	ClientCertName string
+
+	// Error is set if the request couldn't be proxied, in which case the
+	// response is the error response that was returned to the client.
+	Error *proxy.Error
+	// Original is the response before match and replace rules modified it.
+	Original *matchreplace.Response
+	// Annotations were added by scripts and plugins.
+	Annotations []string
+	// Findings were reported by plugins.
+	Findings []plugin.Finding
+
+	// Timing is nil if no timing was recorded for the round trip.
+	Timing *timing.Timing
+	// TLS is nil for plaintext connections.
+	TLS *TLSInfo
+}
+
+// TLSInfo contains details of the TLS connection to the server.
+type TLSInfo struct {
+	Version     string
+	CipherSuite string
+	// ALPN is the negotiated application protocol, e.g. `h2`.
+	ALPN       string
+	ServerName string
+	// PeerCertificates contains the certificate chain presented by the
+	// server, leaf first.
+	PeerCertificates []CertificateSummary
+}
+
+type CertificateSummary struct {
+	Subject      string
+	Issuer       string
+	SerialNumber string
+	DNSNames     []string
+	NotBefore    time.Time
+	NotAfter     time.Time
 }
 
 type Service struct {
 	bypassOutOfScopeRequests bool
+	maxBodySize              int64
 	findReqsFilter           FindRequestsFilter
 	activeProjectID          ulid.ULID
 	scope                    *scope.Scope
@@ -66,6 +134,49 @@ type FindRequestsFilter struct {
 	SearchExpr  filter.Expression
 }
 
+const (
+	// DefaultPageLimit is the number of request logs on a page if no limit
+	// was given.
+	DefaultPageLimit = 100
+	// MaxPageLimit is the maximum number of request logs on a page.
+	MaxPageLimit = 1000
+)
+
+// Direction is the order in which request logs are paged through.
+type Direction int
+
+const (
+	// DirectionDesc pages from newest to oldest request log.
+	DirectionDesc Direction = iota
+	// DirectionAsc pages from oldest to newest request log.
+	DirectionAsc
+)
+
+// PageOptions select a page of request logs. Request logs are ordered by their
+// ID, which is time based.
+type PageOptions struct {
+	// After is the ID of the request log after which the page starts, in the
+	// paging direction. It's typically the last ID of the previous page. The
+	// zero value starts at the first request log.
+	After ulid.ULID
+	// Limit is the maximum number of request logs on the page. A repository
+	// returns all remaining request logs if Limit is zero or less.
+	Limit     int
+	Direction Direction
+	// CountTotal causes the total number of request logs that match the
+	// filter to be counted, which means all of them must be iterated.
+	CountTotal bool
+}
+
+type Page struct {
+	RequestLogs []RequestLog
+	// HasNextPage is true if more request logs match the filter after the
+	// last one on this page.
+	HasNextPage bool
+	// TotalCount is only set if PageOptions.CountTotal was true.
+	TotalCount int
+}
+
 type Config struct {
 	ActiveProjectID ulid.ULID
 	Scope           *scope.Scope
@@ -92,6 +203,19 @@ func (svc *Service) FindRequests(ctx context.Context) ([]RequestLog, error) {
 	return svc.repo.FindRequestLogs(ctx, svc.findReqsFilter, svc.scope)
 }
 
+// FindRequestsPage returns a page of request logs that match the active
+// filter. The limit defaults to DefaultPageLimit and is capped at MaxPageLimit.
+func (svc *Service) FindRequestsPage(ctx context.Context, opts PageOptions) (Page, error) {
+	switch {
+	case opts.Limit <= 0:
+		opts.Limit = DefaultPageLimit
+	case opts.Limit > MaxPageLimit:
+		opts.Limit = MaxPageLimit
+	}
+
+	return svc.repo.FindRequestLogsPage(ctx, svc.findReqsFilter, svc.scope, opts)
+}
+
 func (svc *Service) FindRequestLogByID(ctx context.Context, id ulid.ULID) (RequestLog, error) {
 	return svc.repo.FindRequestLogByID(ctx, svc.activeProjectID, id)
 }
@@ -100,13 +224,10 @@ func (svc *Service) ClearRequests(ctx context.Context, projectID ulid.ULID) erro
 	return svc.repo.ClearRequestLogs(ctx, projectID)
 }
 
-func (svc *Service) storeResponse(ctx context.Context, reqLogID ulid.ULID, res *http.Response) error {
-	resLog, err := ParseHTTPResponse(res)
-	if err != nil {
-		return err
-	}
-
-	return svc.repo.StoreResponseLog(ctx, svc.activeProjectID, reqLogID, resLog)
+// SetFullTextIndex builds or deletes the full-text index of a project's request
+// logs, which is used to search bodies with `body:` terms.
+func (svc *Service) SetFullTextIndex(ctx context.Context, projectID ulid.ULID, enabled bool) error {
+	return svc.repo.SetFullTextIndexEnabled(ctx, projectID, enabled)
 }
 
 func (svc *Service) RequestModifier(next proxy.RequestModifyFunc) proxy.RequestModifyFunc {
@@ -115,21 +236,24 @@ func (svc *Service) RequestModifier(next proxy.RequestModifyFunc) proxy.RequestM
 
 		clone := req.Clone(req.Context())
 
-		var body []byte
+		var (
+			body      []byte
+			truncated bool
+		)
 
-		if req.Body != nil {
-			// TODO: Use io.LimitReader.
+		if req.Body != nil && req.Body != http.NoBody {
+			// Only the logged part of the body is buffered, the remainder is
+			// streamed to the server.
 			var err error
 
-			body, err = ioutil.ReadAll(req.Body)
+			body, truncated, req.Body, err = readBody(req.Body, svc.MaxBodySize())
 			if err != nil {
 				svc.logger.Errorw("Failed to read request body for logging.",
 					"error", err)
 				return
 			}
 
-			req.Body = ioutil.NopCloser(bytes.NewBuffer(body))
-			clone.Body = ioutil.NopCloser(bytes.NewBuffer(body))
+			clone.Body = io.NopCloser(bytes.NewReader(body))
 		}
 
 		// Bypass logging if no project is active.
@@ -162,13 +286,30 @@ func (svc *Service) RequestModifier(next proxy.RequestModifyFunc) proxy.RequestM
 		}
 
 		reqLog := RequestLog{
-			ID:        reqID,
-			ProjectID: svc.activeProjectID,
-			Method:    clone.Method,
-			URL:       clone.URL,
-			Proto:     clone.Proto,
-			Header:    clone.Header,
-			Body:      body,
+			ID:            reqID,
+			ProjectID:     svc.activeProjectID,
+			Method:        clone.Method,
+			URL:           clone.URL,
+			Proto:         clone.Proto,
+			Header:        clone.Header,
+			Body:          body,
+			BodyTruncated: truncated,
+		}
+
+		if match, ok := maprule.FromContext(req.Context()); ok {
+			reqLog.MapRule = &match
+		}
+
+		if orig, ok := matchreplace.OriginalRequestFromContext(req.Context()); ok {
+			orig.Body = truncateBody(orig.Body, svc.MaxBodySize())
+			reqLog.Original = &orig
+		}
+
+		reqLog.Annotations = script.RequestAnnotationsFromContext(req.Context())
+
+		if result, ok := plugin.RequestResultFromContext(req.Context()); ok {
+			reqLog.Annotations = append(reqLog.Annotations, result.Annotations...)
+			reqLog.Findings = result.Findings
 		}
 
 		err := svc.repo.StoreRequestLog(req.Context(), reqLog)
@@ -203,32 +344,86 @@ func (svc *Service) ResponseModifier(next proxy.ResponseModifyFunc) proxy.Respon
 		}
 
 		clone := *res
+		clone.Header = res.Header.Clone()
+		projectID := svc.activeProjectID
+		maxBodySize := svc.MaxBodySize()
+
+		storeResponse := func(body []byte, truncated bool) {
+			res := clone
+			res.Body = io.NopCloser(bytes.NewReader(body))
 
-		if res.Body != nil {
-			// TODO: Use io.LimitReader.
-			body, err := io.ReadAll(res.Body)
+			resLog, err := ParseHTTPResponse(&res)
 			if err != nil {
-				return fmt.Errorf("reqlog: could not read response body: %w", err)
+				svc.logger.Errorw("Failed to parse response log.",
+					"error", err)
+				return
 			}
 
-			res.Body = io.NopCloser(bytes.NewBuffer(body))
-			clone.Body = io.NopCloser(bytes.NewBuffer(body))
-		}
+			resLog.BodyTruncated = truncated
+
+			if resLog.Original != nil {
+				resLog.Original.Body = truncateBody(resLog.Original.Body, maxBodySize)
+			}
 
-		go func() {
-			if err := svc.storeResponse(context.Background(), reqLogID, &clone); err != nil {
+			err = svc.repo.StoreResponseLog(context.Background(), projectID, reqLogID, resLog)
+			if err != nil {
 				svc.logger.Errorw("Failed to store response log.",
 					"error", err)
-			} else {
-				svc.logger.Debugw("Stored response log.",
-					"reqLogID", reqLogID.String())
+				return
 			}
-		}()
+
+			svc.logger.Debugw("Stored response log.",
+				"reqLogID", reqLogID.String())
+		}
+
+		// Bodies of protocol switch responses (e.g. WebSocket) aren't logged.
+		if res.Body == nil || res.StatusCode == http.StatusSwitchingProtocols {
+			go storeResponse(nil, false)
+			return nil
+		}
+
+		// The body is streamed to the client while it's being captured, so
+		// large and never-ending (e.g. server-sent events) responses aren't
+		// buffered. The response log is stored once the body is read, and at a
+		// growing interval while it's being read.
+		res.Body = newCaptureBody(res.Body, maxBodySize, storeResponse)
 
 		return nil
 	}
 }
 
+// ErrorResponseLogger is a proxy.ErrorResponseFunc for logging the responses
+// of requests that couldn't be proxied.
+func (svc *Service) ErrorResponseLogger(res *http.Response) {
+	if bypassed, _ := res.Request.Context().Value(LogBypassedKey).(bool); bypassed {
+		return
+	}
+
+	// Requests that failed before they were logged (e.g. without an active
+	// project) are ignored.
+	reqLogID, ok := res.Request.Context().Value(ReqLogIDKey).(ulid.ULID)
+	if !ok {
+		return
+	}
+
+	resLog, err := ParseHTTPResponse(res)
+	if err != nil {
+		svc.logger.Errorw("Failed to parse error response log.",
+			"error", err)
+		return
+	}
+
+	err = svc.repo.StoreResponseLog(context.Background(), svc.activeProjectID, reqLogID, resLog)
+	if err != nil {
+		svc.logger.Errorw("Failed to store error response log.",
+			"error", err)
+		return
+	}
+
+	svc.logger.Debugw("Stored error response log.",
+		"reqLogID", reqLogID.String())
+}
+
 func (svc *Service) SetActiveProjectID(id ulid.ULID) {
 	svc.activeProjectID = id
 }
@@ -253,17 +448,88 @@ func (svc *Service) BypassOutOfScopeRequests() bool {
 	return svc.bypassOutOfScopeRequests
 }
 
+// SetMaxBodySize sets the maximum number of bytes of request and response
+// bodies that are logged. When `size` is zero, DefaultMaxBodySize is used.
+func (svc *Service) SetMaxBodySize(size int64) {
+	svc.maxBodySize = size
+}
+
+func (svc *Service) MaxBodySize() int64 {
+	if svc.maxBodySize <= 0 {
+		return DefaultMaxBodySize
+	}
+
+	return svc.maxBodySize
+}
+
 func ParseHTTPResponse(res *http.Response) (ResponseLog, error) {
 	body, err := io.ReadAll(res.Body)
 	if err != nil {
 		return ResponseLog{}, fmt.Errorf("reqlog: could not read body: %w", err)
 	}
 
-	return ResponseLog{
+	resLog := ResponseLog{
 		Proto:      res.Proto,
 		StatusCode: res.StatusCode,
 		Status:     res.Status,
 		Header:     res.Header,
 		Body:       body,
-	}, nil
+	}
+
+	if res.Request != nil {
+		resLog.ClientCertName, _ = clientcert.CertNameFromContext(res.Request.Context())
+
+		if encodedBody, ok := proxy.EncodedBodyFromContext(res.Request.Context()); ok {
+			resLog.EncodedBody = encodedBody.Body
+			resLog.ContentEncoding = encodedBody.ContentEncoding
+		}
+
+		if proxyErr, ok := proxy.ErrorFromContext(res.Request.Context()); ok {
+			resLog.Error = &proxyErr
+		}
+
+		if t, ok := timing.FromContext(res.Request.Context()); ok {
+			resLog.Timing = &t
+		}
+
+		if orig, ok := matchreplace.OriginalResponseFromContext(res.Request.Context()); ok {
+			resLog.Original = &orig
+		}
+
+		resLog.Annotations = script.ResponseAnnotationsFromContext(res.Request.Context())
+
+		if result, ok := plugin.ResponseResultFromContext(res.Request.Context()); ok {
+			resLog.Annotations = append(resLog.Annotations, result.Annotations...)
+			resLog.Findings = result.Findings
+		}
+	}
+
+	if res.TLS != nil {
+		resLog.TLS = parseTLSConnectionState(*res.TLS)
+	}
+
+	return resLog, nil
+}
+
+func parseTLSConnectionState(state tls.ConnectionState) *TLSInfo {
+	tlsInfo := &TLSInfo{
+		Version:          tls.VersionName(state.Version),
+		CipherSuite:      tls.CipherSuiteName(state.CipherSuite),
+		ALPN:             state.NegotiatedProtocol,
+		ServerName:       state.ServerName,
+		PeerCertificates: make([]CertificateSummary, 0, len(state.PeerCertificates)),
+	}
+
+	for _, cert := range state.PeerCertificates {
+		tlsInfo.PeerCertificates = append(tlsInfo.PeerCertificates, CertificateSummary{
+			Subject:      cert.Subject.String(),
+			Issuer:       cert.Issuer.String(),
+			SerialNumber: cert.SerialNumber.String(),
+			DNSNames:     cert.DNSNames,
+			NotBefore:    cert.NotBefore,
+			NotAfter:     cert.NotAfter,
+		})
+	}
+
+	return tlsInfo
 }
@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -17,6 +18,7 @@ import (
 	"github.com/dstotijn/hetty/pkg/db/bolt"
 	"github.com/dstotijn/hetty/pkg/proj"
 	"github.com/dstotijn/hetty/pkg/proxy"
+	"github.com/dstotijn/hetty/pkg/proxy/matchreplace"
 	"github.com/dstotijn/hetty/pkg/reqlog"
 	"github.com/dstotijn/hetty/pkg/scope"
 )
@@ -85,6 +87,81 @@ func TestRequestModifier(t *testing.T) {
 	})
 }
 
+//nolint:paralleltest
+func TestRequestModifierMatchReplace(t *testing.T) {
+	path := t.TempDir() + "bolt.db"
+	boltDB, err := bbolt.Open(path, 0o600, nil)
+	if err != nil {
+		t.Fatalf("failed to open bolt database: %v", err)
+	}
+	defer boltDB.Close()
+
+	db, err := bolt.DatabaseFromBoltDB(boltDB)
+	if err != nil {
+		t.Fatalf("failed to create database: %v", err)
+	}
+	defer db.Close()
+
+	projectID := ulid.MustNew(ulid.Timestamp(time.Now()), ulidEntropy)
+	err = db.UpsertProject(context.Background(), proj.Project{
+		ID: projectID,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error upserting project: %v", err)
+	}
+
+	svc := reqlog.NewService(reqlog.Config{
+		Repository: db,
+		Scope:      &scope.Scope{},
+	})
+	svc.SetActiveProjectID(projectID)
+
+	ruleID := ulid.MustNew(ulid.Timestamp(time.Now()), ulidEntropy)
+	matchReplaceSvc := matchreplace.NewService(matchreplace.Config{})
+
+	err = matchReplaceSvc.SetRules([]matchreplace.Rule{
+		{
+			ID:      ruleID,
+			Enabled: true,
+			Target:  matchreplace.TargetRequestHeader,
+			Match:   "User-Agent: curl",
+			Replace: "User-Agent: hetty",
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	reqModFn := svc.RequestModifier(matchReplaceSvc.RequestModifier(func(*http.Request) {}))
+	req := httptest.NewRequest("GET", "https://example.com/", strings.NewReader("bar"))
+	req.Header.Set("User-Agent", "curl")
+	reqID := ulid.MustNew(ulid.Timestamp(time.Now()), ulidEntropy)
+	req = req.WithContext(proxy.WithRequestID(req.Context(), reqID))
+
+	reqModFn(req)
+
+	got, err := svc.FindRequestLogByID(context.Background(), reqID)
+	if err != nil {
+		t.Fatalf("failed to find request by id: %v", err)
+	}
+
+	if ua := got.Header.Get("User-Agent"); ua != "hetty" {
+		t.Errorf("expected rewritten user agent to be logged, got: %v", ua)
+	}
+
+	exp := &matchreplace.Request{
+		Method:  req.Method,
+		URL:     req.URL,
+		Proto:   req.Proto,
+		Header:  http.Header{"User-Agent": []string{"curl"}},
+		RuleIDs: []ulid.ULID{ruleID},
+	}
+
+	if diff := cmp.Diff(exp, got.Original); diff != "" {
+		t.Fatalf("original request not equal (-exp, +got):\n%v", diff)
+	}
+}
+
 //nolint:paralleltest
 func TestResponseModifier(t *testing.T) {
 	path := t.TempDir() + "bolt.db"
@@ -140,6 +217,12 @@ func TestResponseModifier(t *testing.T) {
 		t.Fatalf("unexpected error (expected: nil, got: %v)", err)
 	}
 
+	// The response log is stored once the body is streamed to the client.
+	if _, err := io.ReadAll(res.Body); err != nil {
+		t.Fatalf("unexpected error reading response body: %v", err)
+	}
+	res.Body.Close()
+
 	t.Run("request log was stored in repository", func(t *testing.T) {
 		// Dirty (but simple) wait for other goroutine to finish calling repository.
 		time.Sleep(10 * time.Millisecond)
@@ -156,3 +239,217 @@ func TestResponseModifier(t *testing.T) {
 		})
 	})
 }
+
+//nolint:paralleltest
+func TestResponseModifierMaxBodySize(t *testing.T) {
+	path := t.TempDir() + "bolt.db"
+	boltDB, err := bbolt.Open(path, 0o600, nil)
+	if err != nil {
+		t.Fatalf("failed to open bolt database: %v", err)
+	}
+	defer boltDB.Close()
+
+	db, err := bolt.DatabaseFromBoltDB(boltDB)
+	if err != nil {
+		t.Fatalf("failed to create database: %v", err)
+	}
+	defer db.Close()
+
+	projectID := ulid.MustNew(ulid.Timestamp(time.Now()), ulidEntropy)
+	err = db.UpsertProject(context.Background(), proj.Project{
+		ID: projectID,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error upserting project: %v", err)
+	}
+
+	svc := reqlog.NewService(reqlog.Config{
+		Repository: db,
+	})
+	svc.SetActiveProjectID(projectID)
+	svc.SetMaxBodySize(3)
+
+	resModFn := svc.ResponseModifier(func(res *http.Response) error { return nil })
+
+	req := httptest.NewRequest("GET", "https://example.com/", nil)
+	reqLogID := ulid.MustNew(ulid.Timestamp(time.Now()), ulidEntropy)
+	req = req.WithContext(context.WithValue(req.Context(), reqlog.ReqLogIDKey, reqLogID))
+
+	err = db.StoreRequestLog(context.Background(), reqlog.RequestLog{
+		ID:        reqLogID,
+		ProjectID: projectID,
+	})
+	if err != nil {
+		t.Fatalf("failed to store request log: %v", err)
+	}
+
+	res := &http.Response{
+		Request: req,
+		Body:    io.NopCloser(strings.NewReader("foobar")),
+	}
+
+	if err := resModFn(res); err != nil {
+		t.Fatalf("unexpected error (expected: nil, got: %v)", err)
+	}
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		t.Fatalf("unexpected error reading response body: %v", err)
+	}
+	res.Body.Close()
+
+	if exp := "foobar"; exp != string(body) {
+		t.Fatalf("incorrect response body (expected: %v, got: %v)", exp, string(body))
+	}
+
+	// Dirty (but simple) wait for other goroutine to finish calling repository.
+	time.Sleep(10 * time.Millisecond)
+
+	got, err := svc.FindRequestLogByID(context.Background(), reqLogID)
+	if err != nil {
+		t.Fatalf("failed to find request by id: %v", err)
+	}
+
+	if exp := "foo"; exp != string(got.Response.Body) {
+		t.Errorf("incorrect `ResponseLog.Body` value (expected: %v, got: %v)", exp, string(got.Response.Body))
+	}
+
+	if !got.Response.BodyTruncated {
+		t.Error("expected `ResponseLog.BodyTruncated` to be true")
+	}
+}
+
+// countingRepo counts stored response logs.
+type countingRepo struct {
+	*bolt.Database
+
+	mu          sync.Mutex
+	resLogCount int
+}
+
+func (r *countingRepo) StoreResponseLog(ctx context.Context, projectID, reqLogID ulid.ULID, resLog reqlog.ResponseLog) error {
+	r.mu.Lock()
+	r.resLogCount++
+	r.mu.Unlock()
+
+	return r.Database.StoreResponseLog(ctx, projectID, reqLogID, resLog)
+}
+
+func (r *countingRepo) count() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.resLogCount
+}
+
+//nolint:paralleltest
+func TestResponseModifierStreamedBody(t *testing.T) {
+	path := t.TempDir() + "bolt.db"
+	boltDB, err := bbolt.Open(path, 0o600, nil)
+	if err != nil {
+		t.Fatalf("failed to open bolt database: %v", err)
+	}
+	defer boltDB.Close()
+
+	db, err := bolt.DatabaseFromBoltDB(boltDB)
+	if err != nil {
+		t.Fatalf("failed to create database: %v", err)
+	}
+	defer db.Close()
+
+	projectID := ulid.MustNew(ulid.Timestamp(time.Now()), ulidEntropy)
+	err = db.UpsertProject(context.Background(), proj.Project{
+		ID: projectID,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error upserting project: %v", err)
+	}
+
+	repo := &countingRepo{Database: db}
+
+	svc := reqlog.NewService(reqlog.Config{
+		Repository: repo,
+	})
+	svc.SetActiveProjectID(projectID)
+	svc.SetMaxBodySize(4)
+
+	resModFn := svc.ResponseModifier(func(res *http.Response) error { return nil })
+
+	req := httptest.NewRequest("GET", "https://example.com/events", nil)
+	reqLogID := ulid.MustNew(ulid.Timestamp(time.Now()), ulidEntropy)
+	req = req.WithContext(context.WithValue(req.Context(), reqlog.ReqLogIDKey, reqLogID))
+
+	err = db.StoreRequestLog(context.Background(), reqlog.RequestLog{
+		ID:        reqLogID,
+		ProjectID: projectID,
+	})
+	if err != nil {
+		t.Fatalf("failed to store request log: %v", err)
+	}
+
+	pr, pw := io.Pipe()
+	res := &http.Response{
+		Request: req,
+		Body:    pr,
+	}
+
+	if err := resModFn(res); err != nil {
+		t.Fatalf("unexpected error (expected: nil, got: %v)", err)
+	}
+
+	readDone := make(chan struct{})
+
+	go func() {
+		defer close(readDone)
+		_, _ = io.Copy(io.Discard, res.Body)
+		res.Body.Close()
+	}()
+
+	waitForCount := func(exp int) {
+		t.Helper()
+
+		deadline := time.Now().Add(5 * time.Second)
+		for repo.count() < exp && time.Now().Before(deadline) {
+			time.Sleep(10 * time.Millisecond)
+		}
+
+		if got := repo.count(); got != exp {
+			t.Fatalf("expected %v stored response logs, got: %v", exp, got)
+		}
+	}
+
+	// The response log is stored periodically while the body is streamed.
+	_, _ = io.WriteString(pw, "a")
+	waitForCount(1)
+
+	_, _ = io.WriteString(pw, "b")
+	waitForCount(2)
+
+	// And once when the limit is reached.
+	_, _ = io.WriteString(pw, "cdef")
+	waitForCount(3)
+
+	// Reading beyond the limit doesn't store the response log, because the
+	// captured body doesn't change.
+	for i := 0; i < 10; i++ {
+		_, _ = io.WriteString(pw, "gh")
+	}
+
+	pw.Close()
+	<-readDone
+
+	time.Sleep(1500 * time.Millisecond)
+
+	if got := repo.count(); got != 3 {
+		t.Errorf("expected 3 stored response logs, got: %v", got)
+	}
+
+	got, err := svc.FindRequestLogByID(context.Background(), reqLogID)
+	if err != nil {
+		t.Fatalf("failed to find request by id: %v", err)
+	}
+
+	if exp := "abcd"; exp != string(got.Response.Body) || !got.Response.BodyTruncated {
+		t.Errorf("expected truncated body %q, got: %q (truncated: %v)", exp, got.Response.Body, got.Response.BodyTruncated)
+	}
+}
@@ -1,229 +1,141 @@
 package reqlog
 
 import (
-	"errors"
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/oklog/ulid"
 
 	"github.com/dstotijn/hetty/pkg/filter"
+	"github.com/dstotijn/hetty/pkg/proxy/timing"
 	"github.com/dstotijn/hetty/pkg/scope"
 )
 
 var reqLogSearchKeyFns = map[string]func(rl RequestLog) string{
-	"req.id":    func(rl RequestLog) string { return rl.ID.String() },
-	"req.proto": func(rl RequestLog) string { return rl.Proto },
-	"req.url": func(rl RequestLog) string {
-		if rl.URL == nil {
-			return ""
-		}
-		return rl.URL.String()
-	},
-	"req.method":    func(rl RequestLog) string { return rl.Method },
-	"req.body":      func(rl RequestLog) string { return string(rl.Body) },
+	"req.id":        func(rl RequestLog) string { return rl.ID.String() },
 	"req.timestamp": func(rl RequestLog) string { return ulid.Time(rl.ID.Time()).String() },
 }
 
-var ResLogSearchKeyFns = map[string]func(rl ResponseLog) string{
-	"res.proto":        func(rl ResponseLog) string { return rl.Proto },
-	"res.statusCode":   func(rl ResponseLog) string { return strconv.Itoa(rl.StatusCode) },
-	"res.statusReason": func(rl ResponseLog) string { return rl.Status },
-	"res.body":         func(rl ResponseLog) string { return string(rl.Body) },
-}
-
-// TODO: Request and response headers search key functions.
-
-// Matches returns true if the supplied search expression evaluates to true.
-func (reqLog RequestLog) Matches(expr filter.Expression) (bool, error) {
-	switch e := expr.(type) {
-	case filter.PrefixExpression:
-		return reqLog.matchPrefixExpr(e)
-	case filter.InfixExpression:
-		return reqLog.matchInfixExpr(e)
-	case filter.StringLiteral:
-		return reqLog.matchStringLiteral(e)
-	default:
-		return false, fmt.Errorf("expression type (%T) not supported", expr)
-	}
-}
-
-func (reqLog RequestLog) matchPrefixExpr(expr filter.PrefixExpression) (bool, error) {
-	switch expr.Operator {
-	case filter.TokOpNot:
-		match, err := reqLog.Matches(expr.Right)
-		if err != nil {
-			return false, err
-		}
-
-		return !match, nil
-	default:
-		return false, errors.New("operator is not supported")
-	}
-}
-
-func (reqLog RequestLog) matchInfixExpr(expr filter.InfixExpression) (bool, error) {
-	switch expr.Operator {
-	case filter.TokOpAnd:
-		left, err := reqLog.Matches(expr.Left)
-		if err != nil {
-			return false, err
+var resLogSearchKeyFns = map[string]func(rl ResponseLog) string{
+	"res.error": func(rl ResponseLog) string {
+		if rl.Error == nil {
+			return ""
 		}
-
-		right, err := reqLog.Matches(expr.Right)
-		if err != nil {
-			return false, err
+		return fmt.Sprintf("%v: %v", rl.Error.Kind, rl.Error.Message)
+	},
+	"res.tls.version": func(rl ResponseLog) string {
+		if rl.TLS == nil {
+			return ""
 		}
-
-		return left && right, nil
-	case filter.TokOpOr:
-		left, err := reqLog.Matches(expr.Left)
-		if err != nil {
-			return false, err
+		return rl.TLS.Version
+	},
+	"res.tls.cipherSuite": func(rl ResponseLog) string {
+		if rl.TLS == nil {
+			return ""
 		}
-
-		right, err := reqLog.Matches(expr.Right)
-		if err != nil {
-			return false, err
+		return rl.TLS.CipherSuite
+	},
+	"res.tls.alpn": func(rl ResponseLog) string {
+		if rl.TLS == nil {
+			return ""
 		}
+		return rl.TLS.ALPN
+	},
+}
 
-		return left || right, nil
-	}
-
-	left, ok := expr.Left.(filter.StringLiteral)
-	if !ok {
-		return false, errors.New("left operand must be a string literal")
-	}
-
-	leftVal := reqLog.getMappedStringLiteral(left.Value)
+// resLogTimingSearchKeyFns map search keys to response timing values, in
+// milliseconds. Because these are numeric, they're not used for matching
+// string literal expressions.
+var resLogTimingSearchKeyFns = map[string]func(rl ResponseLog) string{
+	"res.duration":       timingSearchKeyFn(func(t timing.Timing) time.Duration { return t.Total }),
+	"res.timing.dns":     timingSearchKeyFn(func(t timing.Timing) time.Duration { return t.DNS }),
+	"res.timing.connect": timingSearchKeyFn(func(t timing.Timing) time.Duration { return t.Connect }),
+	"res.timing.tls":     timingSearchKeyFn(func(t timing.Timing) time.Duration { return t.TLSHandshake }),
+	"res.timing.ttfb":    timingSearchKeyFn(func(t timing.Timing) time.Duration { return t.TimeToFirstByte }),
+}
 
-	if leftVal == "req.headers" {
-		match, err := filter.MatchHTTPHeaders(expr.Operator, expr.Right, reqLog.Header)
-		if err != nil {
-			return false, fmt.Errorf("failed to match request HTTP headers: %w", err)
+func timingSearchKeyFn(fn func(t timing.Timing) time.Duration) func(rl ResponseLog) string {
+	return func(rl ResponseLog) string {
+		if rl.Timing == nil {
+			return ""
 		}
 
-		return match, nil
-	}
-
-	if leftVal == "res.headers" && reqLog.Response != nil {
-		match, err := filter.MatchHTTPHeaders(expr.Operator, expr.Right, reqLog.Response.Header)
-		if err != nil {
-			return false, fmt.Errorf("failed to match response HTTP headers: %w", err)
-		}
+		ms := float64(fn(*rl.Timing)) / float64(time.Millisecond)
 
-		return match, nil
+		return strconv.FormatFloat(ms, 'f', -1, 64)
 	}
+}
 
-	if expr.Operator == filter.TokOpRe || expr.Operator == filter.TokOpNotRe {
-		right, ok := expr.Right.(filter.RegexpLiteral)
-		if !ok {
-			return false, errors.New("right operand must be a regular expression")
-		}
-
-		switch expr.Operator {
-		case filter.TokOpRe:
-			return right.MatchString(leftVal), nil
-		case filter.TokOpNotRe:
-			return !right.MatchString(leftVal), nil
-		}
+// FilterResponse returns the response log as a filter.HTTPResponse, and adds
+// its TLS, error and timing fields to `extra`. It returns nil if `resLog` is
+// nil.
+func FilterResponse(resLog *ResponseLog, extra map[string]filter.ExtraField) *filter.HTTPResponse {
+	if resLog == nil {
+		return nil
 	}
 
-	right, ok := expr.Right.(filter.StringLiteral)
-	if !ok {
-		return false, errors.New("right operand must be a string literal")
+	for key, fn := range resLogSearchKeyFns {
+		fn := fn
+		extra[key] = filter.ExtraField{Value: func() string { return fn(*resLog) }}
 	}
 
-	rightVal := reqLog.getMappedStringLiteral(right.Value)
+	for key, fn := range resLogTimingSearchKeyFns {
+		fn := fn
+		extra[key] = filter.ExtraField{Value: func() string { return fn(*resLog) }, Numeric: true}
+	}
 
-	switch expr.Operator {
-	case filter.TokOpEq:
-		return leftVal == rightVal, nil
-	case filter.TokOpNotEq:
-		return leftVal != rightVal, nil
-	case filter.TokOpGt:
-		// TODO(?) attempt to parse as int.
-		return leftVal > rightVal, nil
-	case filter.TokOpLt:
-		// TODO(?) attempt to parse as int.
-		return leftVal < rightVal, nil
-	case filter.TokOpGtEq:
-		// TODO(?) attempt to parse as int.
-		return leftVal >= rightVal, nil
-	case filter.TokOpLtEq:
-		// TODO(?) attempt to parse as int.
-		return leftVal <= rightVal, nil
-	default:
-		return false, errors.New("unsupported operator")
+	return &filter.HTTPResponse{
+		Proto:      resLog.Proto,
+		StatusCode: resLog.StatusCode,
+		Status:     resLog.Status,
+		Header:     resLog.Header,
+		Body:       func() ([]byte, error) { return resLog.Body, nil },
 	}
 }
 
-func (reqLog RequestLog) getMappedStringLiteral(s string) string {
-	switch {
-	case strings.HasPrefix(s, "req."):
-		fn, ok := reqLogSearchKeyFns[s]
-		if ok {
-			return fn(reqLog)
-		}
-	case strings.HasPrefix(s, "res."):
-		if reqLog.Response == nil {
-			return ""
-		}
-
-		fn, ok := ResLogSearchKeyFns[s]
-		if ok {
-			return fn(*reqLog.Response)
-		}
-	}
+// Matches returns true if the supplied search expression evaluates to true.
+func (reqLog RequestLog) Matches(expr filter.Expression) (bool, error) {
+	return filter.Evaluate(expr, reqLog.filterFields())
+}
 
-	return s
+// SearchValue returns the value of search key `key` (e.g. `req.method` or
+// `req.header.Authorization`) for the request log. The boolean is false if the
+// key is unknown.
+func (reqLog RequestLog) SearchValue(key string) (string, bool) {
+	// Request logs are in memory, so resolving fields can't fail.
+	value, ok, _ := reqLog.filterFields().Field(key)
+	return value, ok
 }
 
-func (reqLog RequestLog) matchStringLiteral(strLiteral filter.StringLiteral) (bool, error) {
-	for key, values := range reqLog.Header {
-		for _, value := range values {
-			if strings.Contains(
-				strings.ToLower(fmt.Sprintf("%v: %v", key, value)),
-				strings.ToLower(strLiteral.Value),
-			) {
-				return true, nil
-			}
-		}
-	}
+func (reqLog RequestLog) filterFields() filter.HTTPFields {
+	extra := make(map[string]filter.ExtraField, len(reqLogSearchKeyFns))
 
-	for _, fn := range reqLogSearchKeyFns {
-		if strings.Contains(
-			strings.ToLower(fn(reqLog)),
-			strings.ToLower(strLiteral.Value),
-		) {
-			return true, nil
-		}
+	for key, fn := range reqLogSearchKeyFns {
+		fn := fn
+		extra[key] = filter.ExtraField{Value: func() string { return fn(reqLog) }}
 	}
 
-	if reqLog.Response != nil {
-		for key, values := range reqLog.Response.Header {
-			for _, value := range values {
-				if strings.Contains(
-					strings.ToLower(fmt.Sprintf("%v: %v", key, value)),
-					strings.ToLower(strLiteral.Value),
-				) {
-					return true, nil
-				}
+	return filter.HTTPFields{
+		Request: &filter.HTTPRequest{
+			Proto:  reqLog.Proto,
+			Method: reqLog.Method,
+			URL:    reqLog.URL,
+			Header: reqLog.Header,
+			Body:   func() ([]byte, error) { return reqLog.Body, nil },
+		},
+		Response: FilterResponse(reqLog.Response, extra),
+		Extra:    extra,
+		MatchTermFn: func(literal string) (bool, bool) {
+			terms, ok := strings.CutPrefix(literal, BodySearchPrefix)
+			if !ok {
+				return false, false
 			}
-		}
 
-		for _, fn := range ResLogSearchKeyFns {
-			if strings.Contains(
-				strings.ToLower(fn(*reqLog.Response)),
-				strings.ToLower(strLiteral.Value),
-			) {
-				return true, nil
-			}
-		}
+			return reqLog.matchBodyTerms(terms), true
+		},
 	}
-
-	return false, nil
 }
 
 func (reqLog RequestLog) MatchScope(s *scope.Scope) bool {
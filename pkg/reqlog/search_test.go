@@ -1,9 +1,13 @@
 package reqlog_test
 
 import (
+	"net/http"
 	"testing"
+	"time"
 
 	"github.com/dstotijn/hetty/pkg/filter"
+	"github.com/dstotijn/hetty/pkg/proxy"
+	"github.com/dstotijn/hetty/pkg/proxy/timing"
 	"github.com/dstotijn/hetty/pkg/reqlog"
 )
 
@@ -169,6 +173,158 @@ func TestRequestLogMatch(t *testing.T) {
 			expectedMatch: true,
 			expectedError: nil,
 		},
+		{
+			name:  "infix expression, greater than operator, numeric match",
+			query: "res.duration > 2000",
+			requestLog: reqlog.RequestLog{
+				Response: &reqlog.ResponseLog{
+					Timing: &timing.Timing{Total: 10 * time.Second},
+				},
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "infix expression, greater than operator, numeric no match",
+			query: "res.duration > 2000",
+			requestLog: reqlog.RequestLog{
+				Response: &reqlog.ResponseLog{
+					Timing: &timing.Timing{Total: 300 * time.Millisecond},
+				},
+			},
+			expectedMatch: false,
+			expectedError: nil,
+		},
+		{
+			name:  "infix expression, equal operator, match status reason",
+			query: "res.statusReason = OK",
+			requestLog: reqlog.RequestLog{
+				Response: &reqlog.ResponseLog{
+					StatusCode: http.StatusOK,
+					Status:     "200 OK",
+				},
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "infix expression, equal operator, status reason excludes status code",
+			query: `res.statusReason = "200 OK"`,
+			requestLog: reqlog.RequestLog{
+				Response: &reqlog.ResponseLog{
+					StatusCode: http.StatusOK,
+					Status:     "200 OK",
+				},
+			},
+			expectedMatch: false,
+			expectedError: nil,
+		},
+		{
+			name:  "infix expression, regular expression operator, match proxy error",
+			query: `res.error =~ "^tls"`,
+			requestLog: reqlog.RequestLog{
+				Response: &reqlog.ResponseLog{
+					Error: &proxy.Error{
+						Kind:    proxy.ErrorKindTLS,
+						Message: "tls: failed to verify certificate",
+					},
+				},
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "infix expression, equal operator, match TLS version",
+			query: `res.tls.version = "TLS 1.3"`,
+			requestLog: reqlog.RequestLog{
+				Response: &reqlog.ResponseLog{
+					TLS: &reqlog.TLSInfo{Version: "TLS 1.3"},
+				},
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "header field, case-insensitive name, match",
+			query: `req.header.authorization = "Bearer foo"`,
+			requestLog: reqlog.RequestLog{
+				Header: http.Header{"Authorization": []string{"Bearer foo"}},
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "header field, existence check, no match",
+			query: `req.header.X-Foo != ""`,
+			requestLog: reqlog.RequestLog{
+				Header: http.Header{"X-Bar": []string{"baz"}},
+			},
+			expectedMatch: false,
+			expectedError: nil,
+		},
+		{
+			name:  "response header field, regular expression operator, match",
+			query: `res.header.Content-Type =~ "^application/json"`,
+			requestLog: reqlog.RequestLog{
+				Response: &reqlog.ResponseLog{
+					Header: http.Header{"Content-Type": []string{"application/json; charset=utf-8"}},
+				},
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "cookie field, match",
+			query: "req.cookie.session = abc",
+			requestLog: reqlog.RequestLog{
+				Header: http.Header{"Cookie": []string{"theme=dark; session=abc"}},
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "response cookie field, match",
+			query: "res.cookie.session = abc",
+			requestLog: reqlog.RequestLog{
+				Response: &reqlog.ResponseLog{
+					Header: http.Header{"Set-Cookie": []string{"session=abc; Path=/; HttpOnly"}},
+				},
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "headers, equal operator, case-insensitive key, match",
+			query: `req.headers = "x-foo: bar"`,
+			requestLog: reqlog.RequestLog{
+				Header: http.Header{"X-Foo": []string{"bar"}},
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "body search terms, match in request and response body",
+			query: `"body:Access token"`,
+			requestLog: reqlog.RequestLog{
+				Header: http.Header{"Content-Type": []string{"application/x-www-form-urlencoded"}},
+				Body:   []byte("grant_type=access"),
+				Response: &reqlog.ResponseLog{
+					Header: http.Header{"Content-Type": []string{"application/json"}},
+					Body:   []byte(`{"token":"foo"}`),
+				},
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "body search terms, no match on partial token",
+			query: "body:tok",
+			requestLog: reqlog.RequestLog{
+				Body: []byte("token"),
+			},
+			expectedMatch: false,
+			expectedError: nil,
+		},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,57 @@
+package reqlog
+
+import (
+	"context"
+	"time"
+
+	"github.com/oklog/ulid"
+
+	"github.com/dstotijn/hetty/pkg/proxy"
+)
+
+// TunnelLog represents a logged passthrough tunnel. Because tunnel traffic
+// isn't intercepted, only connection-level details are logged.
+type TunnelLog struct {
+	ID        ulid.ULID
+	ProjectID ulid.ULID
+
+	Host          string
+	Port          string
+	BytesSent     int64
+	BytesReceived int64
+	StartedAt     time.Time
+	Duration      time.Duration
+}
+
+func (svc *Service) FindTunnelLogs(ctx context.Context) ([]TunnelLog, error) {
+	return svc.repo.FindTunnelLogs(ctx, svc.activeProjectID)
+}
+
+// PassthroughTunnelLogger is a proxy.PassthroughTunnelFunc for logging
+// passthrough tunnels.
+func (svc *Service) PassthroughTunnelLogger(ctx context.Context, tunnel proxy.PassthroughTunnel) {
+	if svc.activeProjectID.Compare(ulid.ULID{}) == 0 {
+		return
+	}
+
+	tunnelLog := TunnelLog{
+		ID:            tunnel.ID,
+		ProjectID:     svc.activeProjectID,
+		Host:          tunnel.Host,
+		Port:          tunnel.Port,
+		BytesSent:     tunnel.BytesSent,
+		BytesReceived: tunnel.BytesReceived,
+		StartedAt:     tunnel.StartedAt,
+		Duration:      tunnel.Duration,
+	}
+
+	if err := svc.repo.StoreTunnelLog(ctx, tunnelLog); err != nil {
+		svc.logger.Errorw("Failed to store tunnel log.",
+			"error", err)
+		return
+	}
+
+	svc.logger.Debugw("Stored tunnel log.",
+		"tunnelLogID", tunnel.ID.String(),
+		"host", tunnel.Host)
+}
@@ -0,0 +1,66 @@
+package reqlog
+
+import (
+	"context"
+
+	"github.com/oklog/ulid"
+
+	"github.com/dstotijn/hetty/pkg/proxy"
+)
+
+// WebSocketMessageLog represents a logged WebSocket message, relayed over the
+// connection that was established by the handshake of a request log.
+type WebSocketMessageLog struct {
+	ID           ulid.ULID
+	ProjectID    ulid.ULID
+	RequestLogID ulid.ULID
+
+	Opcode     proxy.WebSocketOpcode
+	Payload    []byte
+	FromClient bool
+}
+
+func (svc *Service) FindWebSocketMessages(ctx context.Context, reqLogID ulid.ULID) ([]WebSocketMessageLog, error) {
+	return svc.repo.FindWebSocketMessageLogs(ctx, svc.activeProjectID, reqLogID)
+}
+
+// WebSocketModifier is a proxy.WebSocketModifyMiddleware for logging WebSocket
+// messages of logged requests.
+func (svc *Service) WebSocketModifier(next proxy.WebSocketModifyFunc) proxy.WebSocketModifyFunc {
+	return func(ctx context.Context, msg *proxy.WebSocketMessage) error {
+		if err := next(ctx, msg); err != nil {
+			return err
+		}
+
+		if bypassed, _ := ctx.Value(LogBypassedKey).(bool); bypassed {
+			return nil
+		}
+
+		reqLogID, ok := ctx.Value(ReqLogIDKey).(ulid.ULID)
+		if !ok {
+			svc.logger.Errorw("Bypassed logging: websocket handshake request is missing ID.")
+			return nil
+		}
+
+		msgLog := WebSocketMessageLog{
+			ID:           msg.ID,
+			ProjectID:    svc.activeProjectID,
+			RequestLogID: reqLogID,
+			Opcode:       msg.Opcode,
+			Payload:      append([]byte(nil), msg.Payload...),
+			FromClient:   msg.FromClient,
+		}
+
+		if err := svc.repo.StoreWebSocketMessageLog(ctx, msgLog); err != nil {
+			svc.logger.Errorw("Failed to store websocket message log.",
+				"error", err)
+			return nil
+		}
+
+		svc.logger.Debugw("Stored websocket message log.",
+			"reqLogID", reqLogID.String(),
+			"messageID", msg.ID.String())
+
+		return nil
+	}
+}
@@ -0,0 +1,418 @@
+package sender
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/dstotijn/hetty/pkg/reqlog"
+)
+
+var ErrUnsupportedCodeFormat = errors.New("sender: unsupported code format")
+
+// CodeFormat is a format that requests can be converted to.
+type CodeFormat string
+
+const (
+	CodeFormatCurl           CodeFormat = "curl"
+	CodeFormatRaw            CodeFormat = "raw"
+	CodeFormatFetch          CodeFormat = "fetch"
+	CodeFormatPythonRequests CodeFormat = "python_requests"
+	CodeFormatGo             CodeFormat = "go"
+)
+
+// RequestFromRequestLog returns a (not stored) request for a request log, e.g.
+// for generating code.
+func RequestFromRequestLog(reqLog reqlog.RequestLog) Request {
+	return Request{
+		SourceRequestLogID: reqLog.ID,
+		URL:                reqLog.URL,
+		Method:             reqLog.Method,
+		Proto:              reqLog.Proto,
+		Header:             reqLog.Header,
+		Body:               reqLog.Body,
+	}
+}
+
+// GenerateCode converts a request to a curl command, raw HTTP request text,
+// or code that sends the request: JavaScript (`fetch`), Python (`requests`)
+// or Go (`net/http`).
+func GenerateCode(req Request, format CodeFormat) (string, error) {
+	if req.URL == nil {
+		return "", errors.New("sender: request URL must be set")
+	}
+
+	switch format {
+	case CodeFormatCurl:
+		return generateCurl(req), nil
+	case CodeFormatRaw:
+		return generateRaw(req), nil
+	case CodeFormatFetch:
+		return generateFetch(req), nil
+	case CodeFormatPythonRequests:
+		return generatePythonRequests(req), nil
+	case CodeFormatGo:
+		return generateGo(req), nil
+	default:
+		return "", fmt.Errorf("%w: %q", ErrUnsupportedCodeFormat, format)
+	}
+}
+
+type headerField struct {
+	key   string
+	value string
+}
+
+// headerFields returns header fields sorted by key, without `Content-Length`,
+// which is set by the client, and (optionally) without `Host`.
+func headerFields(header http.Header, withHost bool) []headerField {
+	keys := make([]string, 0, len(header))
+
+	for key := range header {
+		if strings.EqualFold(key, "Content-Length") || !withHost && strings.EqualFold(key, "Host") {
+			continue
+		}
+
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	var fields []headerField
+
+	for _, key := range keys {
+		for _, value := range header[key] {
+			fields = append(fields, headerField{key: key, value: value})
+		}
+	}
+
+	return fields
+}
+
+func method(req Request) string {
+	if req.Method == "" {
+		return http.MethodGet
+	}
+
+	return req.Method
+}
+
+// isText returns true if the body can be represented as a string literal.
+func isText(body []byte) bool {
+	return utf8.Valid(body) && !bytes.ContainsRune(body, 0)
+}
+
+func generateCurl(req Request) string {
+	args := []string{"curl", shellQuote(req.URL.String())}
+
+	switch m := method(req); {
+	case m == http.MethodHead:
+		args = append(args, "--head")
+	case m != http.MethodGet || len(req.Body) > 0:
+		args = append(args, "-X", shellQuote(m))
+	}
+
+	if req.Proto == HTTPProto10 {
+		args = append(args, "--http1.0")
+	} else if req.Proto == HTTPProto11 {
+		args = append(args, "--http1.1")
+	}
+
+	for _, field := range headerFields(req.Header, true) {
+		if field.value == "" {
+			args = append(args, "-H", shellQuote(field.key+";"))
+			continue
+		}
+
+		args = append(args, "-H", shellQuote(field.key+": "+field.value))
+	}
+
+	if len(req.Body) > 0 {
+		args = append(args, "--data-binary", shellQuote(string(req.Body)))
+	}
+
+	// Options are put on separate lines, with their values.
+	var b strings.Builder
+
+	for i, arg := range args {
+		switch {
+		case i == 0:
+		case strings.HasPrefix(arg, "-"):
+			b.WriteString(" \\\n  ")
+		default:
+			b.WriteString(" ")
+		}
+
+		b.WriteString(arg)
+	}
+
+	return b.String()
+}
+
+// shellQuote quotes a string for POSIX shells. Strings with control
+// characters or invalid UTF-8 use ANSI-C quoting (`$'...'`).
+func shellQuote(s string) string {
+	if s != "" && strings.IndexFunc(s, func(r rune) bool {
+		return !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || strings.ContainsRune("-_./:=@%+,", r))
+	}) == -1 {
+		return s
+	}
+
+	needsANSIC := !utf8.ValidString(s) || strings.IndexFunc(s, func(r rune) bool {
+		return r < 0x20 && r != '\n' && r != '\t' || r == 0x7f
+	}) != -1
+
+	if !needsANSIC {
+		return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+	}
+
+	var b strings.Builder
+
+	b.WriteString("$'")
+
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+
+		switch {
+		case c == '\'' || c == '\\':
+			b.WriteByte('\\')
+			b.WriteByte(c)
+		case c == '\n':
+			b.WriteString(`\n`)
+		case c == '\r':
+			b.WriteString(`\r`)
+		case c == '\t':
+			b.WriteString(`\t`)
+		case c < 0x20 || c >= 0x7f:
+			fmt.Fprintf(&b, `\x%02x`, c)
+		default:
+			b.WriteByte(c)
+		}
+	}
+
+	b.WriteString("'")
+
+	return b.String()
+}
+
+func generateRaw(req Request) string {
+	var b strings.Builder
+
+	proto := req.Proto
+	if proto == "" || proto == HTTPProto20 {
+		// Raw request text is HTTP/1.x, HTTP/2 is a binary protocol.
+		proto = HTTPProto11
+	}
+
+	fmt.Fprintf(&b, "%s %s %s\r\n", method(req), req.URL.RequestURI(), proto)
+
+	host := req.Header.Get("Host")
+	if host == "" {
+		host = req.URL.Host
+	}
+
+	fmt.Fprintf(&b, "Host: %s\r\n", host)
+
+	for _, field := range headerFields(req.Header, false) {
+		fmt.Fprintf(&b, "%s: %s\r\n", field.key, field.value)
+	}
+
+	if len(req.Body) > 0 {
+		fmt.Fprintf(&b, "Content-Length: %d\r\n", len(req.Body))
+	}
+
+	b.WriteString("\r\n")
+	b.Write(req.Body)
+
+	return b.String()
+}
+
+// jsonString returns a string literal, which is valid in JavaScript and
+// Python.
+func jsonString(s string) string {
+	buf := bytes.Buffer{}
+
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	_ = enc.Encode(s)
+
+	return strings.TrimSuffix(buf.String(), "\n")
+}
+
+func generateFetch(req Request) string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "fetch(%s, {\n", jsonString(req.URL.String()))
+	fmt.Fprintf(&b, "  method: %s,\n", jsonString(method(req)))
+
+	// Browsers don't allow setting the `Host` header.
+	if fields := headerFields(req.Header, false); len(fields) > 0 {
+		b.WriteString("  headers: [\n")
+
+		for _, field := range fields {
+			fmt.Fprintf(&b, "    [%s, %s],\n", jsonString(field.key), jsonString(field.value))
+		}
+
+		b.WriteString("  ],\n")
+	}
+
+	if len(req.Body) > 0 {
+		if isText(req.Body) {
+			fmt.Fprintf(&b, "  body: %s,\n", jsonString(string(req.Body)))
+		} else {
+			values := make([]string, len(req.Body))
+			for i, c := range req.Body {
+				values[i] = strconv.Itoa(int(c))
+			}
+
+			fmt.Fprintf(&b, "  body: new Uint8Array([%s]),\n", strings.Join(values, ", "))
+		}
+	}
+
+	b.WriteString("});\n")
+
+	return b.String()
+}
+
+func generatePythonRequests(req Request) string {
+	var b strings.Builder
+
+	b.WriteString("import requests\n\n")
+
+	args := []string{jsonString(req.URL.String())}
+
+	if fields := headerFields(req.Header, true); len(fields) > 0 {
+		b.WriteString("headers = {\n")
+
+		// Headers are a dict, so values of repeated headers are joined.
+		for i := 0; i < len(fields); i++ {
+			value := fields[i].value
+			sep := ", "
+
+			if strings.EqualFold(fields[i].key, "Cookie") {
+				sep = "; "
+			}
+
+			for i+1 < len(fields) && fields[i+1].key == fields[i].key {
+				i++
+				value += sep + fields[i].value
+			}
+
+			fmt.Fprintf(&b, "    %s: %s,\n", jsonString(fields[i].key), jsonString(value))
+		}
+
+		b.WriteString("}\n\n")
+
+		args = append(args, "headers=headers")
+	}
+
+	if len(req.Body) > 0 {
+		// Requests encodes `str` bodies as Latin-1, so text is encoded as UTF-8
+		// explicitly, to send the same bytes.
+		if isText(req.Body) {
+			fmt.Fprintf(&b, "data = %s.encode(\"utf-8\")\n\n", jsonString(string(req.Body)))
+		} else {
+			fmt.Fprintf(&b, "data = %s\n\n", pythonBytes(req.Body))
+		}
+
+		args = append(args, "data=data")
+	}
+
+	m := method(req)
+
+	switch m {
+	case http.MethodGet, http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete,
+		http.MethodHead, http.MethodOptions:
+		fmt.Fprintf(&b, "response = requests.%s(%s)\n", strings.ToLower(m), strings.Join(args, ", "))
+	default:
+		fmt.Fprintf(&b, "response = requests.request(%s, %s)\n", jsonString(m), strings.Join(args, ", "))
+	}
+
+	b.WriteString("\nprint(response.status_code)\nprint(response.text)\n")
+
+	return b.String()
+}
+
+func pythonBytes(body []byte) string {
+	var b strings.Builder
+
+	b.WriteString(`b"`)
+
+	for _, c := range body {
+		switch {
+		case c == '"' || c == '\\':
+			b.WriteByte('\\')
+			b.WriteByte(c)
+		case c < 0x20 || c >= 0x7f:
+			fmt.Fprintf(&b, `\x%02x`, c)
+		default:
+			b.WriteByte(c)
+		}
+	}
+
+	b.WriteString(`"`)
+
+	return b.String()
+}
+
+func generateGo(req Request) string {
+	var b strings.Builder
+
+	imports := []string{"fmt", "io", "net/http"}
+	if len(req.Body) > 0 {
+		imports = append(imports, "strings")
+	}
+
+	b.WriteString("package main\n\nimport (\n")
+
+	for _, imp := range imports {
+		fmt.Fprintf(&b, "\t%q\n", imp)
+	}
+
+	b.WriteString(")\n\nfunc main() {\n")
+
+	body := "nil"
+
+	if len(req.Body) > 0 {
+		fmt.Fprintf(&b, "\tbody := strings.NewReader(%s)\n\n", strconv.Quote(string(req.Body)))
+
+		body = "body"
+	}
+
+	fmt.Fprintf(&b, "\treq, err := http.NewRequest(%q, %q, %s)\n", method(req), req.URL.String(), body)
+	b.WriteString("\tif err != nil {\n\t\tpanic(err)\n\t}\n\n")
+
+	// In Go, the `Host` header is set via `Request.Host`.
+	if host := req.Header.Get("Host"); host != "" && host != req.URL.Host {
+		fmt.Fprintf(&b, "\treq.Host = %q\n", host)
+	}
+
+	for _, field := range headerFields(req.Header, false) {
+		fmt.Fprintf(&b, "\treq.Header.Add(%q, %q)\n", field.key, field.value)
+	}
+
+	b.WriteString(`
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		panic(err)
+	}
+	defer res.Body.Close()
+
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(res.Status)
+	fmt.Println(string(resBody))
+}
+`)
+
+	return b.String()
+}
@@ -0,0 +1,134 @@
+package sender_test
+
+import (
+	"errors"
+	"go/format"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/dstotijn/hetty/pkg/sender"
+)
+
+func TestGenerateCode(t *testing.T) {
+	t.Parallel()
+
+	req := sender.Request{
+		URL:    mustParseURL(t, "https://example.com/foo?bar=baz"),
+		Method: http.MethodPost,
+		Proto:  sender.HTTPProto11,
+		Header: http.Header{
+			"Content-Type":   []string{"application/json"},
+			"Content-Length": []string{"20"},
+			"X-Quote":        []string{`it's "quoted"`},
+		},
+		Body: []byte("{\"foo\":\"bar\\n\"}\n\x00"),
+	}
+
+	t.Run("curl round trip", func(t *testing.T) {
+		t.Parallel()
+
+		code, err := sender.GenerateCode(req, sender.CodeFormatCurl)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		got, err := sender.ParseCurl(code)
+		if err != nil {
+			t.Fatalf("unexpected error parsing %q: %v", code, err)
+		}
+
+		exp := req
+		exp.Header = req.Header.Clone()
+		exp.Header.Del("Content-Length")
+
+		if diff := cmp.Diff(exp, got); diff != "" {
+			t.Errorf("request not equal (-exp, +got):\n%v", diff)
+		}
+	})
+
+	t.Run("raw round trip", func(t *testing.T) {
+		t.Parallel()
+
+		code, err := sender.GenerateCode(req, sender.CodeFormatRaw)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if !strings.HasPrefix(code, "POST /foo?bar=baz HTTP/1.1\r\nHost: example.com\r\n") {
+			t.Errorf("unexpected raw request: %q", code)
+		}
+
+		got, err := sender.ParseRawRequest(code, true)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if string(got.Body) != string(req.Body) || got.URL.String() != req.URL.String() {
+			t.Errorf("unexpected request: %+v", got)
+		}
+	})
+
+	t.Run("go", func(t *testing.T) {
+		t.Parallel()
+
+		code, err := sender.GenerateCode(req, sender.CodeFormatGo)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		formatted, err := format.Source([]byte(code))
+		if err != nil {
+			t.Fatalf("expected valid Go code, got error: %v\n%v", err, code)
+		}
+
+		if string(formatted) != code {
+			t.Errorf("expected gofmt formatted code, got:\n%v", code)
+		}
+	})
+
+	t.Run("fetch and python", func(t *testing.T) {
+		t.Parallel()
+
+		fetch, err := sender.GenerateCode(req, sender.CodeFormatFetch)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if !strings.Contains(fetch, `["X-Quote", "it's \"quoted\""]`) || strings.Contains(fetch, "Content-Length") {
+			t.Errorf("unexpected fetch code:\n%v", fetch)
+		}
+
+		if !strings.Contains(fetch, "new Uint8Array([") {
+			t.Errorf("expected binary body as Uint8Array, got:\n%v", fetch)
+		}
+
+		python, err := sender.GenerateCode(req, sender.CodeFormatPythonRequests)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if !strings.Contains(python, `response = requests.post("https://example.com/foo?bar=baz", headers=headers, data=data)`) ||
+			!strings.Contains(python, `data = b"{\"foo\":\"bar\\n\"}\x0a\x00"`) {
+			t.Errorf("unexpected python code:\n%v", python)
+		}
+
+		textReq := req
+		textReq.Body = []byte(`{"name":"Zoë"}`)
+
+		python, err = sender.GenerateCode(textReq, sender.CodeFormatPythonRequests)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if !strings.Contains(python, `data = "{\"name\":\"Zoë\"}".encode("utf-8")`) {
+			t.Errorf("expected text body encoded as UTF-8, got:\n%v", python)
+		}
+	})
+
+	if _, err := sender.GenerateCode(req, "foobar"); !errors.Is(err, sender.ErrUnsupportedCodeFormat) {
+		t.Errorf("expected `sender.ErrUnsupportedCodeFormat`, got: %v", err)
+	}
+}
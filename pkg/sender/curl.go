@@ -0,0 +1,474 @@
+package sender
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+var ErrInvalidCurlCommand = errors.New("sender: invalid curl command")
+
+// curlBoolOptions are curl options without a value that are ignored, as they
+// don't affect the request itself.
+var curlBoolOptions = map[string]bool{
+	"-s": true, "--silent": true, "-S": true, "--show-error": true, "-k": true, "--insecure": true,
+	"-L": true, "--location": true, "-v": true, "--verbose": true, "-i": true, "--include": true,
+	"-f": true, "--fail": true, "-g": true, "--globoff": true, "--compressed": true, "-N": true,
+	"--no-buffer": true, "-#": true, "--progress-bar": true, "-O": true, "--remote-name": true,
+	"--path-as-is": true, "--fail-with-body": true,
+}
+
+// curlValueOptions are curl options with a value that are ignored.
+var curlValueOptions = map[string]bool{
+	"-o": true, "--output": true, "-m": true, "--max-time": true, "--connect-timeout": true,
+	"-x": true, "--proxy": true, "--retry": true, "-w": true, "--write-out": true, "--cacert": true,
+	"-E": true, "--cert": true, "--key": true, "--resolve": true, "--max-redirs": true, "-c": true,
+	"--cookie-jar": true, "--connect-to": true, "--interface": true,
+}
+
+// curlAliases maps short options with a value to their long form.
+var curlAliases = map[string]string{
+	"-X": "--request",
+	"-H": "--header",
+	"-d": "--data",
+	"-A": "--user-agent",
+	"-e": "--referer",
+	"-b": "--cookie",
+	"-u": "--user",
+	"-F": "--form",
+	"-T": "--upload-file",
+}
+
+// ParseCurl parses a curl command line (e.g. copied from browser developer
+// tools as "Copy as cURL") into a request. Options that only affect how curl
+// itself behaves (e.g. `--silent`) are ignored. Options that can't be
+// represented (e.g. reading data from files) return an error.
+func ParseCurl(command string) (Request, error) {
+	args, err := splitShellWords(command)
+	if err != nil {
+		return Request{}, fmt.Errorf("%w: %v", ErrInvalidCurlCommand, err)
+	}
+
+	if len(args) == 0 || args[0] != "curl" {
+		return Request{}, fmt.Errorf("%w: command must start with `curl`", ErrInvalidCurlCommand)
+	}
+
+	var (
+		rawURL  string
+		method  string
+		data    []string
+		getData bool
+		head    bool
+		req     = Request{Header: make(http.Header)}
+	)
+
+	for i := 1; i < len(args); i++ {
+		arg := args[i]
+
+		if !strings.HasPrefix(arg, "-") || arg == "-" {
+			rawURL = arg
+			continue
+		}
+
+		// Combined short options without a value, e.g. `-sSL`.
+		if len(arg) > 2 && arg[1] != '-' && curlAliases[arg[:2]] == "" {
+			for _, c := range arg[1:] {
+				if !curlBoolOptions["-"+string(c)] && c != 'G' && c != 'I' {
+					return Request{}, fmt.Errorf("%w: unsupported option: %v", ErrInvalidCurlCommand, arg)
+				}
+
+				getData = getData || c == 'G'
+				head = head || c == 'I'
+			}
+
+			continue
+		}
+
+		name := arg
+		value := ""
+		hasValue := false
+
+		// Short options with an attached value, e.g. `-XPOST`.
+		if len(arg) > 2 && arg[1] != '-' {
+			name, value, hasValue = arg[:2], arg[2:], true
+		}
+
+		if long, ok := curlAliases[name]; ok {
+			name = long
+		}
+
+		switch {
+		case curlBoolOptions[name]:
+			continue
+		case name == "-G" || name == "--get":
+			getData = true
+			continue
+		case name == "-I" || name == "--head":
+			head = true
+			continue
+		case name == "--http1.0":
+			req.Proto = HTTPProto10
+			continue
+		case name == "--http1.1":
+			req.Proto = HTTPProto11
+			continue
+		case name == "--http2" || name == "--http2-prior-knowledge":
+			req.Proto = HTTPProto20
+			continue
+		}
+
+		if !hasValue {
+			if i+1 >= len(args) {
+				return Request{}, fmt.Errorf("%w: option %v requires a value", ErrInvalidCurlCommand, arg)
+			}
+
+			i++
+			value = args[i]
+		}
+
+		switch name {
+		case "--url":
+			rawURL = value
+		case "--request":
+			method = value
+		case "--header":
+			addCurlHeader(req.Header, value)
+		case "--data", "--data-ascii", "--data-binary":
+			if strings.HasPrefix(value, "@") {
+				return Request{}, fmt.Errorf("%w: reading data from files isn't supported", ErrInvalidCurlCommand)
+			}
+
+			if name != "--data-binary" {
+				value = strings.NewReplacer("\r", "", "\n", "").Replace(value)
+			}
+
+			data = append(data, value)
+		case "--data-raw":
+			data = append(data, value)
+		case "--data-urlencode":
+			encoded, err := curlURLEncode(value)
+			if err != nil {
+				return Request{}, err
+			}
+
+			data = append(data, encoded)
+		case "--json":
+			if strings.HasPrefix(value, "@") {
+				return Request{}, fmt.Errorf("%w: reading data from files isn't supported", ErrInvalidCurlCommand)
+			}
+
+			data = append(data, value)
+
+			if req.Header.Get("Content-Type") == "" {
+				req.Header.Set("Content-Type", "application/json")
+			}
+
+			if req.Header.Get("Accept") == "" {
+				req.Header.Set("Accept", "application/json")
+			}
+		case "--user-agent":
+			req.Header.Set("User-Agent", value)
+		case "--referer":
+			req.Header.Set("Referer", value)
+		case "--cookie":
+			// Without a `=`, the value is a file to read cookies from.
+			if !strings.Contains(value, "=") {
+				return Request{}, fmt.Errorf("%w: reading cookies from files isn't supported", ErrInvalidCurlCommand)
+			}
+
+			req.Header.Add("Cookie", value)
+		case "--user":
+			req.Header.Set("Authorization", "Basic "+base64.StdEncoding.EncodeToString([]byte(value)))
+		default:
+			if curlValueOptions[name] {
+				continue
+			}
+
+			return Request{}, fmt.Errorf("%w: unsupported option: %v", ErrInvalidCurlCommand, name)
+		}
+	}
+
+	if rawURL == "" {
+		return Request{}, fmt.Errorf("%w: missing URL", ErrInvalidCurlCommand)
+	}
+
+	// Like curl, assume HTTP when the URL has no scheme.
+	if !strings.Contains(rawURL, "://") {
+		rawURL = "http://" + rawURL
+	}
+
+	req.URL, err = url.Parse(rawURL)
+	if err != nil {
+		return Request{}, fmt.Errorf("%w: invalid URL: %v", ErrInvalidCurlCommand, err)
+	}
+
+	body := strings.Join(data, "&")
+
+	switch {
+	case getData && len(data) > 0:
+		if req.URL.RawQuery != "" {
+			req.URL.RawQuery += "&"
+		}
+
+		req.URL.RawQuery += body
+	case len(data) > 0:
+		req.Body = []byte(body)
+		method = defaultString(method, http.MethodPost)
+
+		if req.Header.Get("Content-Type") == "" {
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		}
+	}
+
+	if head {
+		method = defaultString(method, http.MethodHead)
+	}
+
+	req.Method = defaultString(method, http.MethodGet)
+
+	return req, nil
+}
+
+// addCurlHeader adds a header like curl does: `Foo;` adds an empty header, and
+// `Foo:` (without a value) removes a header.
+func addCurlHeader(header http.Header, value string) {
+	if key, ok := strings.CutSuffix(value, ";"); ok && !strings.Contains(key, ":") {
+		header.Add(key, "")
+		return
+	}
+
+	key, v, _ := strings.Cut(value, ":")
+	key = strings.TrimSpace(key)
+	v = strings.TrimSpace(v)
+
+	if v == "" {
+		header.Del(key)
+		return
+	}
+
+	header.Add(key, v)
+}
+
+// curlURLEncode encodes a `--data-urlencode` value: `content`, `=content` or
+// `name=content`.
+func curlURLEncode(value string) (string, error) {
+	if strings.HasPrefix(value, "@") || strings.Contains(value, "@") && !strings.Contains(value, "=") {
+		return "", fmt.Errorf("%w: reading data from files isn't supported", ErrInvalidCurlCommand)
+	}
+
+	name, content, ok := strings.Cut(value, "=")
+	if !ok {
+		return url.QueryEscape(value), nil
+	}
+
+	if name == "" {
+		return url.QueryEscape(content), nil
+	}
+
+	return name + "=" + url.QueryEscape(content), nil
+}
+
+func defaultString(s, def string) string {
+	if s == "" {
+		return def
+	}
+
+	return s
+}
+
+// splitShellWords splits a (POSIX shell) command line into words. It supports
+// single and double quotes, ANSI-C quoting (`$'...'`), backslash escapes and
+// line continuations.
+func splitShellWords(s string) ([]string, error) {
+	var (
+		words  []string
+		word   strings.Builder
+		inWord bool
+	)
+
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			if inWord {
+				words = append(words, word.String())
+				word.Reset()
+				inWord = false
+			}
+		case c == '\\':
+			inWord = true
+
+			if i+1 < len(s) {
+				i++
+				// A backslash followed by a newline is a line continuation.
+				if s[i] == '\n' {
+					inWord = word.Len() > 0
+					continue
+				}
+
+				if s[i] == '\r' && i+1 < len(s) && s[i+1] == '\n' {
+					i++
+					inWord = word.Len() > 0
+
+					continue
+				}
+
+				word.WriteByte(s[i])
+			}
+		case c == '\'':
+			inWord = true
+
+			end := strings.IndexByte(s[i+1:], '\'')
+			if end == -1 {
+				return nil, errors.New("unterminated single quote")
+			}
+
+			word.WriteString(s[i+1 : i+1+end])
+			i += end + 1
+		case c == '$' && i+1 < len(s) && s[i+1] == '\'':
+			inWord = true
+
+			n, err := readANSICQuoted(s[i+2:], &word)
+			if err != nil {
+				return nil, err
+			}
+
+			i += n + 1
+		case c == '"':
+			inWord = true
+
+			n, err := readDoubleQuoted(s[i+1:], &word)
+			if err != nil {
+				return nil, err
+			}
+
+			i += n
+		default:
+			inWord = true
+
+			word.WriteByte(c)
+		}
+	}
+
+	if inWord {
+		words = append(words, word.String())
+	}
+
+	return words, nil
+}
+
+// readDoubleQuoted writes the contents of a double quoted string, up to and
+// excluding the closing quote, to `w`. It returns the number of bytes read,
+// including the closing quote.
+func readDoubleQuoted(s string, w *strings.Builder) (int, error) {
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '"':
+			return i + 1, nil
+		case '\\':
+			// Within double quotes, a backslash only escapes these characters.
+			if i+1 < len(s) && strings.IndexByte("$`\"\\\n", s[i+1]) != -1 {
+				i++
+
+				if s[i] != '\n' {
+					w.WriteByte(s[i])
+				}
+
+				continue
+			}
+
+			w.WriteByte('\\')
+		default:
+			w.WriteByte(s[i])
+		}
+	}
+
+	return 0, errors.New("unterminated double quote")
+}
+
+// readANSICQuoted writes the contents of an ANSI-C quoted string (`$'...'`),
+// up to and excluding the closing quote, to `w`. It returns the number of bytes
+// read, including the closing quote.
+func readANSICQuoted(s string, w *strings.Builder) (int, error) {
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+
+		if c == '\'' {
+			return i + 1, nil
+		}
+
+		if c != '\\' || i+1 >= len(s) {
+			w.WriteByte(c)
+			continue
+		}
+
+		i++
+
+		switch s[i] {
+		case 'n':
+			w.WriteByte('\n')
+		case 'r':
+			w.WriteByte('\r')
+		case 't':
+			w.WriteByte('\t')
+		case 'a':
+			w.WriteByte('\a')
+		case 'b':
+			w.WriteByte('\b')
+		case 'e', 'E':
+			w.WriteByte(0x1b)
+		case 'f':
+			w.WriteByte('\f')
+		case 'v':
+			w.WriteByte('\v')
+		case 'x', 'u', 'U':
+			maxDigits := map[byte]int{'x': 2, 'u': 4, 'U': 8}[s[i]]
+
+			j := i + 1
+			for j < len(s) && j-i-1 < maxDigits && isHexDigit(s[j]) {
+				j++
+			}
+
+			if j == i+1 {
+				w.WriteByte('\\')
+				w.WriteByte(s[i])
+
+				continue
+			}
+
+			n, _ := strconv.ParseUint(s[i+1:j], 16, 32)
+
+			if s[i] == 'x' {
+				w.WriteByte(byte(n))
+			} else {
+				w.WriteRune(rune(n))
+			}
+
+			i = j - 1
+		case '0', '1', '2', '3', '4', '5', '6', '7':
+			j := i
+			for j < len(s) && j-i < 3 && s[j] >= '0' && s[j] <= '7' {
+				j++
+			}
+
+			n, _ := strconv.ParseUint(s[i:j], 8, 8)
+			w.WriteByte(byte(n))
+
+			i = j - 1
+		default:
+			// E.g. `\\`, `\'` and `\"`.
+			w.WriteByte(s[i])
+		}
+	}
+
+	return 0, errors.New("unterminated ANSI-C quote")
+}
+
+func isHexDigit(c byte) bool {
+	return c >= '0' && c <= '9' || c >= 'a' && c <= 'f' || c >= 'A' && c <= 'F'
+}
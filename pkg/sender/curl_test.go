@@ -0,0 +1,174 @@
+package sender_test
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/dstotijn/hetty/pkg/sender"
+)
+
+func mustParseURL(t *testing.T, rawURL string) *url.URL {
+	t.Helper()
+
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	return u
+}
+
+func TestParseCurl(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		command string
+		exp     sender.Request
+	}{
+		{
+			name: "copied from browser",
+			command: `curl 'https://example.com/api?x=1' \
+  -H 'accept: application/json' \
+  -H 'cookie: session=foo' \
+  -H $'x-quote: it\'s' \
+  --data-raw '{"foo":"bar"}' \
+  --compressed`,
+			exp: sender.Request{
+				URL:    mustParseURL(t, "https://example.com/api?x=1"),
+				Method: http.MethodPost,
+				Header: http.Header{
+					"Accept":       []string{"application/json"},
+					"Cookie":       []string{"session=foo"},
+					"X-Quote":      []string{"it's"},
+					"Content-Type": []string{"application/x-www-form-urlencoded"},
+				},
+				Body: []byte(`{"foo":"bar"}`),
+			},
+		},
+		{
+			name:    "method, user, proto and double quotes",
+			command: `curl -sSL -XPUT --http1.1 -u "foo:b\"ar" "example.com/foo" -A hetty --json '{}'`,
+			exp: sender.Request{
+				URL:    mustParseURL(t, "http://example.com/foo"),
+				Method: http.MethodPut,
+				Proto:  sender.HTTPProto11,
+				Header: http.Header{
+					"Authorization": []string{"Basic Zm9vOmIiYXI="},
+					"User-Agent":    []string{"hetty"},
+					"Content-Type":  []string{"application/json"},
+					"Accept":        []string{"application/json"},
+				},
+				Body: []byte(`{}`),
+			},
+		},
+		{
+			name:    "get with data",
+			command: `curl -G https://example.com/ -d a=1 --data-urlencode 'b=c d' -H 'X-Empty;'`,
+			exp: sender.Request{
+				URL:    mustParseURL(t, "https://example.com/?a=1&b=c+d"),
+				Method: http.MethodGet,
+				Header: http.Header{"X-Empty": []string{""}},
+			},
+		},
+		{
+			name:    "head",
+			command: `curl -I --url https://example.com/ -o /dev/null`,
+			exp: sender.Request{
+				URL:    mustParseURL(t, "https://example.com/"),
+				Method: http.MethodHead,
+				Header: http.Header{},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := sender.ParseCurl(tt.command)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if diff := cmp.Diff(tt.exp, got); diff != "" {
+				t.Errorf("request not equal (-exp, +got):\n%v", diff)
+			}
+		})
+	}
+}
+
+func TestParseCurlInvalid(t *testing.T) {
+	t.Parallel()
+
+	commands := []string{
+		`wget https://example.com/`,
+		`curl`,
+		`curl 'https://example.com/`,
+		`curl https://example.com/ -d @body.json`,
+		`curl https://example.com/ --foobar`,
+		`curl https://example.com/ -H`,
+	}
+
+	for _, command := range commands {
+		if _, err := sender.ParseCurl(command); !errors.Is(err, sender.ErrInvalidCurlCommand) {
+			t.Errorf("expected `sender.ErrInvalidCurlCommand` for %q, got: %v", command, err)
+		}
+	}
+}
+
+func TestParseRawRequest(t *testing.T) {
+	t.Parallel()
+
+	t.Run("origin form", func(t *testing.T) {
+		t.Parallel()
+
+		raw := "POST /login?next=%2F HTTP/1.1\nHost: example.com:8443\nContent-Type: text/plain\nContent-Length: 3\n\nfoo\r\n\r\nbar"
+
+		got, err := sender.ParseRawRequest(raw, true)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		exp := sender.Request{
+			URL:    mustParseURL(t, "https://example.com:8443/login?next=%2F"),
+			Method: http.MethodPost,
+			Proto:  sender.HTTPProto11,
+			Header: http.Header{
+				"Host":           []string{"example.com:8443"},
+				"Content-Type":   []string{"text/plain"},
+				"Content-Length": []string{"3"},
+			},
+			Body: []byte("foo\r\n\r\nbar"),
+		}
+
+		if diff := cmp.Diff(exp, got); diff != "" {
+			t.Errorf("request not equal (-exp, +got):\n%v", diff)
+		}
+	})
+
+	t.Run("absolute form", func(t *testing.T) {
+		t.Parallel()
+
+		got, err := sender.ParseRawRequest("GET http://example.com/foo HTTP/1.0\r\n\r\n", true)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if got.URL.String() != "http://example.com/foo" || got.Proto != sender.HTTPProto10 || got.Body != nil {
+			t.Errorf("unexpected request: %+v", got)
+		}
+	})
+
+	for _, raw := range []string{"foobar", "GET / HTTP/1.1\r\n\r\n"} {
+		if _, err := sender.ParseRawRequest(raw, true); !errors.Is(err, sender.ErrInvalidRawRequest) {
+			t.Errorf("expected `sender.ErrInvalidRawRequest` for %q, got: %v", raw, err)
+		}
+	}
+}
@@ -0,0 +1,96 @@
+package sender
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+var ErrInvalidRawRequest = errors.New("sender: invalid raw HTTP request")
+
+// ParseRawRequest parses raw HTTP/1.x request text, e.g.:
+//
+//	POST /login HTTP/1.1
+//	Host: example.com
+//	Content-Type: application/x-www-form-urlencoded
+//
+//	user=foo
+//
+// Lines may end with CRLF or LF. The body is everything after the empty line
+// that ends the headers, regardless of a `Content-Length` header. When the
+// request target isn't an absolute URL, the URL is based on the `Host` header,
+// with scheme `https` if `useTLS` is true, and `http` otherwise.
+func ParseRawRequest(raw string, useTLS bool) (Request, error) {
+	// Leading empty lines are ignored, like servers do.
+	head, body := splitRawRequest(strings.TrimLeft(raw, "\r\n"))
+
+	httpReq, err := http.ReadRequest(bufio.NewReader(strings.NewReader(head + "\r\n")))
+	if err != nil {
+		return Request{}, fmt.Errorf("%w: %v", ErrInvalidRawRequest, err)
+	}
+
+	req := Request{
+		Method: httpReq.Method,
+		Header: httpReq.Header,
+	}
+
+	switch {
+	case httpReq.ProtoMajor == 2:
+		req.Proto = HTTPProto20
+	case httpReq.ProtoMajor == 1 && httpReq.ProtoMinor == 0:
+		req.Proto = HTTPProto10
+	default:
+		req.Proto = HTTPProto11
+	}
+
+	// `http.ReadRequest` moves the `Host` header to `Request.Host`.
+	if httpReq.Host != "" {
+		req.Header.Set("Host", httpReq.Host)
+	}
+
+	if httpReq.URL.IsAbs() {
+		req.URL = httpReq.URL
+	} else {
+		if httpReq.Host == "" {
+			return Request{}, fmt.Errorf("%w: missing Host header", ErrInvalidRawRequest)
+		}
+
+		scheme := "http"
+		if useTLS {
+			scheme = "https"
+		}
+
+		req.URL, err = url.Parse(scheme + "://" + httpReq.Host + httpReq.RequestURI)
+		if err != nil {
+			return Request{}, fmt.Errorf("%w: invalid URL: %v", ErrInvalidRawRequest, err)
+		}
+	}
+
+	if body != "" {
+		req.Body = []byte(body)
+	}
+
+	return req, nil
+}
+
+// splitRawRequest splits raw request text at the empty line that ends the
+// headers. The returned head includes the line ending of the last header.
+func splitRawRequest(raw string) (head, body string) {
+	for i := 0; i < len(raw); {
+		j := strings.IndexByte(raw[i:], '\n')
+		if j == -1 {
+			break
+		}
+
+		if line := raw[i : i+j]; i > 0 && strings.TrimSuffix(line, "\r") == "" {
+			return raw[:i], raw[i+j+1:]
+		}
+
+		i += j + 1
+	}
+
+	return raw + "\r\n", ""
+}
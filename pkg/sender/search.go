@@ -1,10 +1,6 @@
 package sender
 
 import (
-	"errors"
-	"fmt"
-	"strings"
-
 	"github.com/oklog/ulid"
 
 	"github.com/dstotijn/hetty/pkg/filter"
@@ -13,210 +9,43 @@ import (
 )
 
 var senderReqSearchKeyFns = map[string]func(req Request) string{
-	"req.id":    func(req Request) string { return req.ID.String() },
-	"req.proto": func(req Request) string { return req.Proto },
-	"req.url": func(req Request) string {
-		if req.URL == nil {
-			return ""
-		}
-		return req.URL.String()
-	},
-	"req.method":    func(req Request) string { return req.Method },
-	"req.body":      func(req Request) string { return string(req.Body) },
+	"req.id":        func(req Request) string { return req.ID.String() },
 	"req.timestamp": func(req Request) string { return ulid.Time(req.ID.Time()).String() },
 }
 
-// TODO: Request and response headers search key functions.
-
 // Matches returns true if the supplied search expression evaluates to true.
 func (req Request) Matches(expr filter.Expression) (bool, error) {
-	switch e := expr.(type) {
-	case filter.PrefixExpression:
-		return req.matchPrefixExpr(e)
-	case filter.InfixExpression:
-		return req.matchInfixExpr(e)
-	case filter.StringLiteral:
-		return req.matchStringLiteral(e)
-	default:
-		return false, fmt.Errorf("expression type (%T) not supported", expr)
-	}
-}
-
-func (req Request) matchPrefixExpr(expr filter.PrefixExpression) (bool, error) {
-	switch expr.Operator {
-	case filter.TokOpNot:
-		match, err := req.Matches(expr.Right)
-		if err != nil {
-			return false, err
-		}
-
-		return !match, nil
-	default:
-		return false, errors.New("operator is not supported")
-	}
-}
-
-func (req Request) matchInfixExpr(expr filter.InfixExpression) (bool, error) {
-	switch expr.Operator {
-	case filter.TokOpAnd:
-		left, err := req.Matches(expr.Left)
-		if err != nil {
-			return false, err
-		}
-
-		right, err := req.Matches(expr.Right)
-		if err != nil {
-			return false, err
-		}
-
-		return left && right, nil
-	case filter.TokOpOr:
-		left, err := req.Matches(expr.Left)
-		if err != nil {
-			return false, err
-		}
-
-		right, err := req.Matches(expr.Right)
-		if err != nil {
-			return false, err
-		}
-
-		return left || right, nil
-	}
-
-	left, ok := expr.Left.(filter.StringLiteral)
-	if !ok {
-		return false, errors.New("left operand must be a string literal")
-	}
-
-	leftVal := req.getMappedStringLiteral(left.Value)
-
-	if leftVal == "req.headers" {
-		match, err := filter.MatchHTTPHeaders(expr.Operator, expr.Right, req.Header)
-		if err != nil {
-			return false, fmt.Errorf("failed to match request HTTP headers: %w", err)
-		}
-
-		return match, nil
-	}
-
-	if leftVal == "res.headers" && req.Response != nil {
-		match, err := filter.MatchHTTPHeaders(expr.Operator, expr.Right, req.Response.Header)
-		if err != nil {
-			return false, fmt.Errorf("failed to match response HTTP headers: %w", err)
-		}
-
-		return match, nil
-	}
-
-	if expr.Operator == filter.TokOpRe || expr.Operator == filter.TokOpNotRe {
-		right, ok := expr.Right.(filter.RegexpLiteral)
-		if !ok {
-			return false, errors.New("right operand must be a regular expression")
-		}
-
-		switch expr.Operator {
-		case filter.TokOpRe:
-			return right.MatchString(leftVal), nil
-		case filter.TokOpNotRe:
-			return !right.MatchString(leftVal), nil
-		}
-	}
-
-	right, ok := expr.Right.(filter.StringLiteral)
-	if !ok {
-		return false, errors.New("right operand must be a string literal")
-	}
-
-	rightVal := req.getMappedStringLiteral(right.Value)
-
-	switch expr.Operator {
-	case filter.TokOpEq:
-		return leftVal == rightVal, nil
-	case filter.TokOpNotEq:
-		return leftVal != rightVal, nil
-	case filter.TokOpGt:
-		// TODO(?) attempt to parse as int.
-		return leftVal > rightVal, nil
-	case filter.TokOpLt:
-		// TODO(?) attempt to parse as int.
-		return leftVal < rightVal, nil
-	case filter.TokOpGtEq:
-		// TODO(?) attempt to parse as int.
-		return leftVal >= rightVal, nil
-	case filter.TokOpLtEq:
-		// TODO(?) attempt to parse as int.
-		return leftVal <= rightVal, nil
-	default:
-		return false, errors.New("unsupported operator")
-	}
+	return filter.Evaluate(expr, req.filterFields())
 }
 
-func (req Request) getMappedStringLiteral(s string) string {
-	switch {
-	case strings.HasPrefix(s, "req."):
-		fn, ok := senderReqSearchKeyFns[s]
-		if ok {
-			return fn(req)
-		}
-	case strings.HasPrefix(s, "res."):
-		if req.Response == nil {
-			return ""
-		}
-
-		fn, ok := reqlog.ResLogSearchKeyFns[s]
-		if ok {
-			return fn(*req.Response)
-		}
-	}
-
-	return s
+// SearchValue returns the value of search key `key` (e.g. `req.method` or
+// `req.header.Authorization`) for the sender request. The boolean is false if
+// the key is unknown.
+func (req Request) SearchValue(key string) (string, bool) {
+	// Sender requests are in memory, so resolving fields can't fail.
+	value, ok, _ := req.filterFields().Field(key)
+	return value, ok
 }
 
-func (req Request) matchStringLiteral(strLiteral filter.StringLiteral) (bool, error) {
-	for key, values := range req.Header {
-		for _, value := range values {
-			if strings.Contains(
-				strings.ToLower(fmt.Sprintf("%v: %v", key, value)),
-				strings.ToLower(strLiteral.Value),
-			) {
-				return true, nil
-			}
-		}
-	}
+func (req Request) filterFields() filter.HTTPFields {
+	extra := make(map[string]filter.ExtraField, len(senderReqSearchKeyFns))
 
-	for _, fn := range senderReqSearchKeyFns {
-		if strings.Contains(
-			strings.ToLower(fn(req)),
-			strings.ToLower(strLiteral.Value),
-		) {
-			return true, nil
-		}
+	for key, fn := range senderReqSearchKeyFns {
+		fn := fn
+		extra[key] = filter.ExtraField{Value: func() string { return fn(req) }}
 	}
 
-	if req.Response != nil {
-		for key, values := range req.Response.Header {
-			for _, value := range values {
-				if strings.Contains(
-					strings.ToLower(fmt.Sprintf("%v: %v", key, value)),
-					strings.ToLower(strLiteral.Value),
-				) {
-					return true, nil
-				}
-			}
-		}
-
-		for _, fn := range reqlog.ResLogSearchKeyFns {
-			if strings.Contains(
-				strings.ToLower(fn(*req.Response)),
-				strings.ToLower(strLiteral.Value),
-			) {
-				return true, nil
-			}
-		}
+	return filter.HTTPFields{
+		Request: &filter.HTTPRequest{
+			Proto:  req.Proto,
+			Method: req.Method,
+			URL:    req.URL,
+			Header: req.Header,
+			Body:   func() ([]byte, error) { return req.Body, nil },
+		},
+		Response: reqlog.FilterResponse(req.Response, extra),
+		Extra:    extra,
 	}
-
-	return false, nil
 }
 
 func (req Request) MatchScope(s *scope.Scope) bool {
@@ -1,6 +1,7 @@
 package sender_test
 
 import (
+	"net/http"
 	"testing"
 
 	"github.com/dstotijn/hetty/pkg/filter"
@@ -170,6 +171,35 @@ func TestRequestLogMatch(t *testing.T) {
 			expectedMatch: true,
 			expectedError: nil,
 		},
+		{
+			name:  "header field, case-insensitive name, match",
+			query: `req.header.authorization = "Bearer foo"`,
+			senderReq: sender.Request{
+				Header: http.Header{"Authorization": []string{"Bearer foo"}},
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "cookie field, match",
+			query: "req.cookie.session = abc",
+			senderReq: sender.Request{
+				Header: http.Header{"Cookie": []string{"session=abc"}},
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
+		{
+			name:  "response header field, match",
+			query: `res.header.content-type = "text/html"`,
+			senderReq: sender.Request{
+				Response: &reqlog.ResponseLog{
+					Header: http.Header{"Content-Type": []string{"text/html"}},
+				},
+			},
+			expectedMatch: true,
+			expectedError: nil,
+		},
 	}
 
 	for _, tt := range tests {
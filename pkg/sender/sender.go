@@ -13,6 +13,10 @@ import (
 	"github.com/oklog/ulid"
 
 	"github.com/dstotijn/hetty/pkg/filter"
+	"github.com/dstotijn/hetty/pkg/proxy"
+	"github.com/dstotijn/hetty/pkg/proxy/clientcert"
+	"github.com/dstotijn/hetty/pkg/proxy/timing"
+	"github.com/dstotijn/hetty/pkg/proxy/upstream"
 	"github.com/dstotijn/hetty/pkg/reqlog"
 	"github.com/dstotijn/hetty/pkg/scope"
 )
@@ -50,6 +54,12 @@ type Config struct {
 	Repository    Repository
 	ReqLogService *reqlog.Service
 	HTTPClient    *http.Client
+
+	// Upstream is used for sending requests via an upstream proxy, and
+	// ClientCerts for presenting client certificates. These are ignored when
+	// `HTTPClient` is set.
+	Upstream    *upstream.Dialer
+	ClientCerts *clientcert.Store
 }
 
 type SendError struct {
@@ -64,10 +74,21 @@ func NewService(cfg Config) *Service {
 		scope:      cfg.Scope,
 	}
 
-	if cfg.HTTPClient != nil {
+	switch {
+	case cfg.HTTPClient != nil:
 		svc.httpClient = cfg.HTTPClient
+	case cfg.Upstream != nil || cfg.ClientCerts != nil:
+		svc.httpClient = &http.Client{
+			Transport: NewHTTPTransport(cfg.Upstream, cfg.ClientCerts),
+			Timeout:   defaultHTTPClient.Timeout,
+		}
 	}
 
+	// Record timing of round trips, without modifying the configured client.
+	httpClient := *svc.httpClient
+	httpClient.Transport = timing.NewTransport(httpClient.Transport)
+	svc.httpClient = &httpClient
+
 	return svc
 }
 
@@ -214,6 +235,11 @@ func (svc *Service) sendHTTPRequest(httpReq *http.Request) (reqlog.ResponseLog,
 	if err != nil {
 		return reqlog.ResponseLog{}, &SendError{err}
 	}
+
+	if err := proxy.DecodeResponseBody(res); err != nil {
+		res.Body.Close()
+		return reqlog.ResponseLog{}, fmt.Errorf("failed to decode response body: %w", err)
+	}
 	defer res.Body.Close()
 
 	resLog, err := reqlog.ParseHTTPResponse(res)
@@ -299,8 +299,15 @@ func TestSendRequest(t *testing.T) {
 		t.Fatalf("unexpected error sending request: %v", err)
 	}
 
-	diff := cmp.Diff(exp, got.Response, cmpopts.IgnoreFields(sender.Request{}, "ID"))
+	diff := cmp.Diff(exp, got.Response,
+		cmpopts.IgnoreFields(sender.Request{}, "ID"),
+		cmpopts.IgnoreFields(reqlog.ResponseLog{}, "Timing"),
+	)
 	if diff != "" {
 		t.Fatalf("request not equal (-exp, +got):\n%v", diff)
 	}
+
+	if got.Response.Timing == nil || got.Response.Timing.Total <= 0 {
+		t.Errorf("expected response timing to be recorded, got: %+v", got.Response.Timing)
+	}
 }
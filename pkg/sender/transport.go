@@ -5,9 +5,17 @@ import (
 	"net"
 	"net/http"
 	"time"
+
+	"github.com/dstotijn/hetty/pkg/proxy/clientcert"
+	"github.com/dstotijn/hetty/pkg/proxy/upstream"
 )
 
-type HTTPTransport struct{}
+// HTTPTransport implements http.RoundTripper. The zero value uses transports
+// based off `http.DefaultTransport`.
+type HTTPTransport struct {
+	h1Transport http.RoundTripper
+	h2Transport http.RoundTripper
+}
 
 type protoCtxKey struct{}
 
@@ -33,16 +41,49 @@ var h1OnlyTransport = &http.Transport{
 	TLSNextProto: map[string]func(string, *tls.Conn) http.RoundTripper{},
 }
 
+// NewHTTPTransport returns a new HTTPTransport that sends requests via the
+// upstream proxy (if any) configured on `dialer`, and presents client
+// certificates from `certs` to servers that match. Both arguments are optional.
+func NewHTTPTransport(dialer *upstream.Dialer, certs *clientcert.Store) *HTTPTransport {
+	if dialer == nil {
+		dialer = upstream.NewDialer()
+	}
+
+	if certs == nil {
+		certs = &clientcert.Store{}
+	}
+
+	h1 := h1OnlyTransport.Clone()
+	h1.Proxy = dialer.Proxy
+
+	//nolint:forcetypeassert
+	h2 := http.DefaultTransport.(*http.Transport).Clone()
+	h2.Proxy = dialer.Proxy
+
+	return &HTTPTransport{
+		h1Transport: clientcert.NewTransport(h1, certs),
+		h2Transport: clientcert.NewTransport(h2, certs),
+	}
+}
+
 // RountTrip implements http.RoundTripper. Based on a context value on the
-// HTTP request, it switches between using `http.DefaultTransport` (which attempts
-// HTTP/2) and a HTTP/1.1 only transport that's based off `http.DefaultTransport`.
+// HTTP request, it switches between using a transport that attempts HTTP/2,
+// and a HTTP/1.1 only transport. Both are based off `http.DefaultTransport`.
 func (t *HTTPTransport) RoundTrip(req *http.Request) (*http.Response, error) {
 	proto, ok := req.Context().Value(protoCtxKey{}).(string)
 
 	if ok && proto == HTTPProto10 || proto == HTTPProto11 {
+		if t.h1Transport != nil {
+			return t.h1Transport.RoundTrip(req)
+		}
+
 		return h1OnlyTransport.RoundTrip(req)
 	}
 
+	if t.h2Transport != nil {
+		return t.h2Transport.RoundTrip(req)
+	}
+
 	return http.DefaultTransport.RoundTrip(req)
 }
 